@@ -193,6 +193,9 @@ export default {
     hintQuoteText: 'Wrap text in quotes, e.g. "CA" (numbers don\'t need quotes)',
     hintUseInForList: 'This looks like a list — use {op} (not {sym}) to match any of several values',
     hintQuoteContains: 'Wrap text in quotes, e.g. "premium"',
+    hintSemver: 'Must be a quoted semantic version, e.g. "1.2.0" or "2.0.0-rc.1"',
+    hintTime: 'Must be an RFC3339 time, a date, unix epoch seconds or now±duration, e.g. "2026-01-01T00:00:00Z" or "now+7d"',
+    hintCidr: 'Must be a quoted CIDR or a JSON array of them, e.g. ["10.0.0.0/8", "2001:db8::/32"]',
     // key/tag validation
     keyMaxLength: 'Key must be at most {max} characters',
     keyInvalidChars: 'Key must contain only letters, numbers, hyphens, slashes, dots, colons',
@@ -304,7 +307,23 @@ export default {
     IN: 'Value in JSON array',
     NOTIN: 'Value not in JSON array',
     CONTAINS: 'String contains substring',
-    NOTCONTAINS: 'String does not contain'
+    NOTCONTAINS: 'String does not contain',
+    SEMVER_EQ: 'Equals version (semver)',
+    SEMVER_LT: 'Older than version (semver)',
+    SEMVER_LTE: 'Older than or equal to version (semver)',
+    SEMVER_GT: 'Newer than version (semver)',
+    SEMVER_GTE: 'Newer than or equal to version (semver)',
+    BEFORE: 'Time is before (RFC3339, epoch or now±duration)',
+    AFTER: 'Time is after (RFC3339, epoch or now±duration)',
+    IN_LIST: 'Value in uploaded list (list key)',
+    NOT_IN_LIST: 'Value not in uploaded list (list key)',
+    IN_CIDR: 'IP address in CIDR ranges (JSON array)',
+    NOT_IN_CIDR: 'IP address not in CIDR ranges (JSON array)',
+    STARTS_WITH: 'String starts with prefix',
+    ENDS_WITH: 'String ends with suffix',
+    EQ_IGNORE_CASE: 'Equals value, ignoring case',
+    IN_IGNORE_CASE: 'Value in JSON array, ignoring case',
+    CONTAINS_IGNORE_CASE: 'String contains substring, ignoring case'
   },
   docsNav: {
     getStarted: 'Get Started',
@@ -179,6 +179,9 @@ export default {
     hintQuoteText: 'Pon el texto entre comillas, p. ej. "CA" (los números no las necesitan)',
     hintUseInForList: 'Esto parece una lista — usa {op} (no {sym}) para coincidir con varios valores',
     hintQuoteContains: 'Pon el texto entre comillas, p. ej. "premium"',
+    hintSemver: 'Debe ser una versión semántica entre comillas, p. ej. "1.2.0" o "2.0.0-rc.1"',
+    hintTime: 'Debe ser una hora RFC3339, una fecha, segundos epoch unix o now±duración, p. ej. "2026-01-01T00:00:00Z" o "now+7d"',
+    hintCidr: 'Debe ser un CIDR entre comillas o un array JSON de ellos, p. ej. ["10.0.0.0/8", "2001:db8::/32"]',
     keyMaxLength: 'La clave debe tener como máximo {max} caracteres',
     keyInvalidChars: 'La clave solo puede contener letras, números, guiones, barras, puntos y dos puntos',
     tagMaxLength: 'La etiqueta debe tener como máximo {max} caracteres',
@@ -289,7 +292,23 @@ export default {
     IN: 'Valor en un array JSON',
     NOTIN: 'Valor que no está en el array JSON',
     CONTAINS: 'La cadena contiene la subcadena',
-    NOTCONTAINS: 'La cadena no contiene la subcadena'
+    NOTCONTAINS: 'La cadena no contiene la subcadena',
+    SEMVER_EQ: 'Igual a la versión (semver)',
+    SEMVER_LT: 'Anterior a la versión (semver)',
+    SEMVER_LTE: 'Anterior o igual a la versión (semver)',
+    SEMVER_GT: 'Posterior a la versión (semver)',
+    SEMVER_GTE: 'Posterior o igual a la versión (semver)',
+    BEFORE: 'Fecha anterior a (RFC3339, epoch o now±duración)',
+    AFTER: 'Fecha posterior a (RFC3339, epoch o now±duración)',
+    IN_LIST: 'Valor en la lista cargada (clave de la lista)',
+    NOT_IN_LIST: 'Valor no en la lista cargada (clave de la lista)',
+    IN_CIDR: 'Dirección IP en los rangos CIDR (array JSON)',
+    NOT_IN_CIDR: 'Dirección IP fuera de los rangos CIDR (array JSON)',
+    STARTS_WITH: 'La cadena empieza por el prefijo',
+    ENDS_WITH: 'La cadena termina en el sufijo',
+    EQ_IGNORE_CASE: 'Igual al valor, sin distinguir mayúsculas',
+    IN_IGNORE_CASE: 'Valor en el array JSON, sin distinguir mayúsculas',
+    CONTAINS_IGNORE_CASE: 'La cadena contiene la subcadena, sin distinguir mayúsculas'
   },
   docsNav: {
     getStarted: 'Primeros pasos',
@@ -179,6 +179,9 @@ export default {
     hintQuoteText: 'Заключите текст в кавычки, напр. "CA" (для чисел не нужны)',
     hintUseInForList: 'Похоже на список — для нескольких значений используйте {op}, а не {sym}',
     hintQuoteContains: 'Заключите текст в кавычки, напр. "premium"',
+    hintSemver: 'Нужна версия semver в кавычках, напр. "1.2.0" или "2.0.0-rc.1"',
+    hintTime: 'Нужно время RFC3339, дата, unix epoch в секундах или now±интервал, напр. "2026-01-01T00:00:00Z" или "now+7d"',
+    hintCidr: 'Нужен CIDR в кавычках или JSON-массив CIDR, напр. ["10.0.0.0/8", "2001:db8::/32"]',
     keyMaxLength: 'Ключ должен быть не длиннее {max} символов',
     keyInvalidChars: 'Ключ может содержать только буквы, цифры, дефисы, слэши, точки, двоеточия',
     tagMaxLength: 'Тег должен быть не длиннее {max} символов',
@@ -289,7 +292,23 @@ export default {
     IN: 'Значение в JSON-массиве',
     NOTIN: 'Значения нет в JSON-массиве',
     CONTAINS: 'Строка содержит подстроку',
-    NOTCONTAINS: 'Строка не содержит подстроку'
+    NOTCONTAINS: 'Строка не содержит подстроку',
+    SEMVER_EQ: 'Равно версии (semver)',
+    SEMVER_LT: 'Ниже версии (semver)',
+    SEMVER_LTE: 'Ниже или равно версии (semver)',
+    SEMVER_GT: 'Выше версии (semver)',
+    SEMVER_GTE: 'Выше или равно версии (semver)',
+    BEFORE: 'Время раньше (RFC3339, epoch или now±интервал)',
+    AFTER: 'Время позже (RFC3339, epoch или now±интервал)',
+    IN_LIST: 'Значение в загруженном списке (ключ списка)',
+    NOT_IN_LIST: 'Значение не в загруженном списке (ключ списка)',
+    IN_CIDR: 'IP-адрес в диапазонах CIDR (JSON-массив)',
+    NOT_IN_CIDR: 'IP-адрес вне диапазонов CIDR (JSON-массив)',
+    STARTS_WITH: 'Строка начинается с префикса',
+    ENDS_WITH: 'Строка заканчивается суффиксом',
+    EQ_IGNORE_CASE: 'Равно значению без учёта регистра',
+    IN_IGNORE_CASE: 'Значение в JSON-массиве без учёта регистра',
+    CONTAINS_IGNORE_CASE: 'Строка содержит подстроку без учёта регистра'
   },
   docsNav: {
     getStarted: 'Начало работы',
@@ -25,7 +25,7 @@ func main() {
 		fmt.Fprintf(os.Stderr, "Usage: %s <flags.json>\n", os.Args[0])
 		fmt.Fprintf(os.Stderr, "\nValidates a Flagr JSON flag definition file.\n")
 		fmt.Fprintf(os.Stderr, "Checks: valid JSON, required fields, key uniqueness,\n")
-		fmt.Fprintf(os.Stderr, "distribution sums, variant references.\n")
+		fmt.Fprintf(os.Stderr, "distribution sums, variant and list references.\n")
 		os.Exit(2)
 	}
 
@@ -42,7 +42,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	result := handler.ValidateFlags(ecj.Flags)
+	result := handler.ValidateEvalCacheJSON(ecj)
 
 	for _, w := range result.Warnings {
 		fmt.Fprintf(os.Stderr, "WARNING: %s\n", w)
@@ -113,6 +113,44 @@ func BenchmarkEvalBatchLarge(b *testing.B) {
 	benchEval(b, "/api/v1/evaluation/batch", body)
 }
 
+// BenchmarkEvalBatch500Entities compares a server evaluating the batch serially
+// with FLAGR_EVAL_BATCH_CONCURRENCY=1 to one with the default worker pool.
+func BenchmarkEvalBatch500Entities(b *testing.B) {
+	servers := []struct {
+		name string
+		env  []string
+	}{
+		{"serial", []string{"FLAGR_EVAL_BATCH_CONCURRENCY=1"}},
+		{"pooled", nil},
+	}
+	for _, s := range servers {
+		b.Run(s.name, func(b *testing.B) {
+			withLocalServer(b, s.env, func() {
+				if len(seedFlagIDs) < 10 {
+					b.Skip("need at least 10 seeded flags")
+				}
+				entities := make([]map[string]any, 500)
+				regions := []string{"us-west", "us-east", "eu-west", "ap-northeast"}
+				for i := range entities {
+					entities[i] = map[string]any{
+						"entityID":   fmt.Sprintf("bench%d", i),
+						"entityType": "user",
+						"entityContext": map[string]any{
+							"region": regions[i%len(regions)],
+							"age":    20 + i%50,
+						},
+					}
+				}
+				body := map[string]any{
+					"entities": entities,
+					"flagIDs":  seedFlagIDs[:10],
+				}
+				benchEval(b, "/api/v1/evaluation/batch", body)
+			})
+		})
+	}
+}
+
 func BenchmarkEvalEQ(b *testing.B) {
 	body := map[string]any{
 		"flagKey":    "int_flag_EQ_01",
@@ -127,6 +127,19 @@ func findProjectRoot() string {
 }
 
 func startLocalServer() string {
+	url, cmd := startServerProcess()
+	serverCmd = cmd
+	return url
+}
+
+// serverBinPath is the server binary built by buildServer, shared by all the
+// servers started by the tests and benchmarks
+var serverBinPath string
+
+func buildServer() string {
+	if serverBinPath != "" {
+		return serverBinPath
+	}
 	projectRoot := findProjectRoot()
 
 	// Always build fresh to a temp directory to avoid stale-binary bugs.
@@ -141,6 +154,14 @@ func startLocalServer() string {
 	if err := cmd.Run(); err != nil {
 		log.Fatalf("failed to build server binary: %v", err)
 	}
+	serverBinPath = binPath
+	return binPath
+}
+
+// startServerProcess starts a local server on a free port with an in-memory
+// database, and the extra env vars like "FLAGR_EVAL_BATCH_CONCURRENCY=1".
+func startServerProcess(env ...string) (string, *exec.Cmd) {
+	binPath := buildServer()
 
 	// Find a free port
 	lis, err := net.Listen("tcp", "127.0.0.1:0")
@@ -156,28 +177,53 @@ func startLocalServer() string {
 	lis.Close()
 
 	// Start server subprocess
-	serverCmd = exec.Command(binPath,
+	cmd := exec.Command(binPath,
 		"--port", strconv.Itoa(port),
 	)
-	serverCmd.Dir = projectRoot
-	serverCmd.Env = append(os.Environ(),
+	cmd.Dir = findProjectRoot()
+	cmd.Env = append(os.Environ(),
 		"FLAGR_DB_DBDRIVER=sqlite3",
 		"FLAGR_DB_DBCONNECTIONSTR=file::memory:?cache=shared",
 	)
+	cmd.Env = append(cmd.Env, env...)
 	// Redirect server output to a temp file to avoid "I/O incomplete" errors
 	// when the test binary kills the server process.
 	serverLog, err := os.CreateTemp("", "flagr-server-*.log")
 	if err != nil {
 		log.Fatalf("cannot create server log: %v", err)
 	}
-	serverCmd.Stdout = serverLog
-	serverCmd.Stderr = serverLog
-	serverCmd.WaitDelay = 5 * time.Second
-	if err := serverCmd.Start(); err != nil {
+	cmd.Stdout = serverLog
+	cmd.Stderr = serverLog
+	cmd.WaitDelay = 5 * time.Second
+	if err := cmd.Start(); err != nil {
 		log.Fatalf("cannot start server: %v", err)
 	}
 
-	return fmt.Sprintf("http://127.0.0.1:%d", port)
+	return fmt.Sprintf("http://127.0.0.1:%d", port), cmd
+}
+
+// withLocalServer runs fn against a seeded local server started with the env
+// vars, and switches back to the server under test afterwards. It skips the
+// benchmark when the tests run against given servers.
+func withLocalServer(b *testing.B, env []string, fn func()) {
+	b.Helper()
+	if os.Getenv("FLAGR_SERVER_URL") != "" || os.Getenv("FLAGR_SERVER_URLS") != "" {
+		b.Skip("needs to start local servers")
+	}
+
+	url, cmd := startServerProcess(env...)
+	defer func() {
+		cmd.Process.Kill()
+		cmd.Wait()
+	}()
+
+	prevURL, prevIDs, prevKeys := baseURL, seedFlagIDs, seedFlagKeys
+	defer func() {
+		baseURL, seedFlagIDs, seedFlagKeys = prevURL, prevIDs, prevKeys
+	}()
+	baseURL, seedFlagIDs, seedFlagKeys = url, nil, nil
+	prepareServer(url)
+	fn()
 }
 
 func waitForServer(url string, timeout time.Duration) {
@@ -35,10 +35,21 @@ var Config = struct {
 	EvalDebugEnabled bool `env:"FLAGR_EVAL_DEBUG_ENABLED" envDefault:"true"`
 	// EvalLoggingEnabled - to enable the logging for eval results
 	EvalLoggingEnabled bool `env:"FLAGR_EVAL_LOGGING_ENABLED" envDefault:"true"`
+	// EvalEntityContextValidation - validates the entity contexts of the evaluation requests against the
+	// attributes declared for the entity type of the flag. "warn" logs the violations, rate limited like
+	// the eval results logging, and "reject" rejects the requests with a 400. Empty to disable (default).
+	EvalEntityContextValidation string `env:"FLAGR_EVAL_ENTITY_CONTEXT_VALIDATION" envDefault:""`
 	// EvalCacheRefreshTimeout - timeout of getting the flags data from DB into the in-memory evaluation cache
 	EvalCacheRefreshTimeout time.Duration `env:"FLAGR_EVALCACHE_REFRESHTIMEOUT" envDefault:"59s"`
 	// EvalCacheRefreshInterval - time interval of getting the flags data from DB into the in-memory evaluation cache
 	EvalCacheRefreshInterval time.Duration `env:"FLAGR_EVALCACHE_REFRESHINTERVAL" envDefault:"3s"`
+	// EvalCacheFullReloadInterval - time interval of the full reloads of the evaluation cache. In between, a refresh
+	// only fetches the flags that have new flag snapshots since the last refresh. 0 to always reload all the flags.
+	EvalCacheFullReloadInterval time.Duration `env:"FLAGR_EVALCACHE_FULL_RELOAD_INTERVAL" envDefault:"5m"`
+	// RolloutSchedulerEnabled - run the background scheduler that applies the rollout schedules of segments
+	RolloutSchedulerEnabled bool `env:"FLAGR_ROLLOUT_SCHEDULER_ENABLED" envDefault:"true"`
+	// RolloutSchedulerInterval - time interval of applying the rollout schedules of segments
+	RolloutSchedulerInterval time.Duration `env:"FLAGR_ROLLOUT_SCHEDULER_INTERVAL" envDefault:"30s"`
 	// EvalOnlyMode - will only expose the evaluation related endpoints.
 	// This field will be derived from DBDriver
 	EvalOnlyMode bool `env:"FLAGR_EVAL_ONLY_MODE" envDefault:"false"`
@@ -53,6 +64,10 @@ var Config = struct {
 	// - With 2 entities and 2 tags (~100 flags each): 2 * 100 = 200 evaluations
 	// A reasonable limit might be 500-1000 for typical use cases.
 	EvalBatchSize int `env:"FLAGR_EVAL_BATCH_SIZE" envDefault:"0"`
+	// EvalBatchConcurrency - number of workers evaluating the entities and flags of a single batch request.
+	// Results are returned in the same order regardless of this setting.
+	// Set to 0 to use GOMAXPROCS workers (default), or 1 to evaluate serially.
+	EvalBatchConcurrency int `env:"FLAGR_EVAL_BATCH_CONCURRENCY" envDefault:"0"`
 
 	/**
 	DBDriver and DBConnectionStr define how we can write and read flags data.
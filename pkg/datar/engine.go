@@ -22,6 +22,7 @@ type FlushKey struct {
 	FlagID    int64
 	VariantID int64
 	SegmentID int64
+	HoldoutID int64
 	Hour      time.Time // Truncated to the hour so struct equality works as a sync.Map key.
 }
 
@@ -47,6 +48,12 @@ type SegmentEntry struct {
 	Count     int64
 }
 
+// HoldoutEntry is one holdout's aggregated count.
+type HoldoutEntry struct {
+	HoldoutID int64
+	Count     int64
+}
+
 // DayEntry is one calendar day's aggregated count.
 type DayEntry struct {
 	Day   string // YYYY-MM-DD
@@ -58,6 +65,7 @@ type FlagSummaryBreakdown struct {
 	FlagID   int64
 	Variants []VariantEntry
 	Segments []SegmentEntry
+	Holdouts []HoldoutEntry
 	Days     []DayEntry
 }
 
@@ -104,7 +112,7 @@ func New(db *gorm.DB, enabled bool, flushInterval time.Duration) *Engine {
 
 // Record increments the counter for the given EvalResult.
 // Safe to call from concurrent goroutines. Safe on nil receiver.
-func (e *Engine) Record(flagID, variantID, segmentID int64) {
+func (e *Engine) Record(flagID, variantID, segmentID, holdoutID int64) {
 	if e == nil || e.closed.Load() {
 		return
 	}
@@ -113,6 +121,7 @@ func (e *Engine) Record(flagID, variantID, segmentID int64) {
 		FlagID:    flagID,
 		VariantID: variantID,
 		SegmentID: segmentID,
+		HoldoutID: holdoutID,
 		Hour:      time.Now().Truncate(time.Hour),
 	}
 
@@ -203,6 +212,16 @@ func (e *Engine) QueryFlagSummaryBreakdown(flagID int64, from, to time.Time) (*F
 		return nil, err
 	}
 
+	// Holdouts (exclude holdout_id = 0), sorted by count descending.
+	var holdouts []HoldoutEntry
+	if err := e.db.Model(&entity.HourlyEvent{}).
+		Select("holdout_id, SUM(eval_count) AS count").
+		Where(where+" AND holdout_id > 0", args...).Group("holdout_id").Order("count DESC").
+		Scan(&holdouts).Error; err != nil {
+		logrus.WithError(err).Error("Datar: QueryFlagSummaryBreakdown holdouts failed")
+		return nil, err
+	}
+
 	// Days, sorted by date ascending.
 	// DATE() returns a native DATE on MySQL which GORM can't scan into a Go string.
 	// GROUP BY and ORDER BY use the expression directly to avoid alias ambiguity.
@@ -219,6 +238,7 @@ func (e *Engine) QueryFlagSummaryBreakdown(flagID int64, from, to time.Time) (*F
 		FlagID:   flagID,
 		Variants: variants,
 		Segments: segs,
+		Holdouts: holdouts,
 		Days:     days,
 	}, nil
 }
@@ -303,6 +323,7 @@ func (e *Engine) flushAggregates(agg map[FlushKey]int32) error {
 			FlagID:     k.FlagID,
 			VariantID:  k.VariantID,
 			SegmentID:  k.SegmentID,
+			HoldoutID:  k.HoldoutID,
 			BucketHour: k.Hour,
 			EvalCount:  count,
 			UpdatedAt:  now,
@@ -313,6 +334,7 @@ func (e *Engine) flushAggregates(agg map[FlushKey]int32) error {
 			{Name: "flag_id"},
 			{Name: "variant_id"},
 			{Name: "segment_id"},
+			{Name: "holdout_id"},
 			{Name: "bucket_hour"},
 		},
 		DoUpdates: clause.Set{{
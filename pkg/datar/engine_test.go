@@ -54,7 +54,7 @@ func TestNew_Enabled(t *testing.T) {
 func TestNew_NilMethodsAreSafe(t *testing.T) {
 	var e *Engine
 	assert.NotPanics(t, func() {
-		e.Record(1, 1, 1)
+		e.Record(1, 1, 1, 0)
 		assert.Equal(t, 0, e.Len())
 		assert.Nil(t, e.SnapshotAndReset())
 		assert.NoError(t, e.Shutdown())
@@ -72,10 +72,10 @@ func TestRecord_Increments(t *testing.T) {
 	}
 	defer e.Shutdown()
 
-	e.Record(1, 1, 10)
-	e.Record(1, 1, 10)
-	e.Record(1, 2, 10)
-	e.Record(2, 1, 20)
+	e.Record(1, 1, 10, 0)
+	e.Record(1, 1, 10, 0)
+	e.Record(1, 2, 10, 0)
+	e.Record(2, 1, 20, 0)
 
 	assert.Equal(t, 3, e.Len()) // 3 unique (flag,variant,segment) combinations
 
@@ -93,7 +93,7 @@ func TestRecord_AfterCloseIsNoop(t *testing.T) {
 	defer e.Shutdown()
 
 	e.closed.Store(true)
-	e.Record(1, 1, 1)
+	e.Record(1, 1, 1, 0)
 	assert.Equal(t, 0, e.Len())
 }
 
@@ -109,7 +109,7 @@ func TestRecord_Concurrent(t *testing.T) {
 		wg.Add(1)
 		go func(n int) {
 			defer wg.Done()
-			e.Record(1, int64(n%3), int64(n%5))
+			e.Record(1, int64(n%3), int64(n%5), 0)
 		}(i)
 	}
 	wg.Wait()
@@ -141,7 +141,7 @@ func TestLen_AfterRecordAndSnapshot(t *testing.T) {
 	}
 	defer e.Shutdown()
 
-	e.Record(1, 1, 1)
+	e.Record(1, 1, 1, 0)
 	assert.Equal(t, 1, e.Len())
 	e.SnapshotAndReset()
 	assert.Equal(t, 0, e.Len())
@@ -169,10 +169,10 @@ func TestShutdown_FlushesRemaining(t *testing.T) {
 		t.Fatal("expected non-nil engine")
 	}
 
-	e.Record(1, 1, 10)
-	e.Record(1, 1, 10)
-	e.Record(1, 2, 10)
-	e.Record(1, 2, 10)
+	e.Record(1, 1, 10, 0)
+	e.Record(1, 1, 10, 0)
+	e.Record(1, 2, 10, 0)
+	e.Record(1, 2, 10, 0)
 
 	assert.NoError(t, e.Shutdown())
 
@@ -185,6 +185,27 @@ func TestShutdown_FlushesRemaining(t *testing.T) {
 	}
 }
 
+func TestShutdown_FlushesHoldouts(t *testing.T) {
+	db := newTestDB(t)
+	createFlag(t, db, 1, "test", "flag", true)
+
+	e := New(db, true, time.Hour)
+	if e == nil {
+		t.Fatal("expected non-nil engine")
+	}
+
+	e.Record(1, 1, 10, 0)
+	e.Record(1, 1, 0, 3)
+	e.Record(1, 1, 0, 3)
+
+	assert.NoError(t, e.Shutdown())
+
+	summary, err := e.QueryFlagSummaryBreakdown(1, time.Now().Add(-time.Hour), time.Now().Add(time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, []VariantEntry{{VariantID: 1, Count: 3}}, summary.Variants)
+	assert.Equal(t, []HoldoutEntry{{HoldoutID: 3, Count: 2}}, summary.Holdouts)
+}
+
 func TestShutdown_NilEngine(t *testing.T) {
 	var e *Engine
 	assert.NoError(t, e.Shutdown())
@@ -412,7 +433,7 @@ func TestFlush_DBError(t *testing.T) {
 	}
 	defer e.Shutdown()
 
-	e.Record(1, 1, 1)
+	e.Record(1, 1, 1, 0)
 
 	// Drop the events table — flushAggregates will now fail.
 	if err := db.Exec("DROP TABLE datar_hourly_events").Error; err != nil {
@@ -434,7 +455,7 @@ func TestFlushLoop_TickerFires(t *testing.T) {
 	}
 	defer e.Shutdown()
 
-	e.Record(1, 1, 1)
+	e.Record(1, 1, 1, 0)
 
 	// Give the ticker time to fire at least once.
 	time.Sleep(10 * time.Millisecond)
@@ -456,9 +477,9 @@ func TestFlush_Direct(t *testing.T) {
 	e.flush() // flush with no data — no-op
 	assert.Equal(t, 0, e.Len())
 
-	e.Record(1, 1, 1)
-	e.Record(1, 1, 1)
-	e.Record(1, 2, 1)
+	e.Record(1, 1, 1, 0)
+	e.Record(1, 1, 1, 0)
+	e.Record(1, 2, 1, 0)
 
 	e.flush()
 
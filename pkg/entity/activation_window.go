@@ -0,0 +1,177 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/spf13/cast"
+)
+
+// weekdays maps from the weekday names of an ActivationWindow to time.Weekday
+var weekdays = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ActivationWindow limits when a flag or a segment is active. StartAt and
+// EndAt bound the window in absolute time. Weekdays, StartTime and EndTime
+// make it recurring in the given Timezone (UTC if empty), e.g. weekdays
+// 09:00-17:00 in Europe/Berlin. A StartTime after the EndTime spans midnight,
+// and the weekday is the one the window starts on. Empty fields don't limit
+// the window.
+type ActivationWindow struct {
+	StartAt   *time.Time `json:",omitempty"`
+	EndAt     *time.Time `json:",omitempty"`
+	Weekdays  []string   `json:",omitempty"` // sun, mon, tue, wed, thu, fri or sat
+	StartTime string     `json:",omitempty"` // HH:MM
+	EndTime   string     `json:",omitempty"` // HH:MM
+	Timezone  string     `json:",omitempty"` // IANA time zone, e.g. Europe/Berlin
+
+	loc       *time.Location
+	days      []time.Weekday
+	startTime time.Duration // since midnight
+	endTime   time.Duration // since midnight
+}
+
+// Scan implements scanner interface
+func (w *ActivationWindow) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+	s := cast.ToString(value)
+	if s == "" || s == "null" {
+		return nil
+	}
+	*w = ActivationWindow{}
+	if err := json.Unmarshal([]byte(s), w); err != nil {
+		return fmt.Errorf("cannot scan %v into ActivationWindow type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (w ActivationWindow) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// Validate validates the window and prepares it for IsActive
+func (w *ActivationWindow) Validate() error {
+	if w.StartAt != nil && w.EndAt != nil && !w.EndAt.After(*w.StartAt) {
+		return fmt.Errorf("activation window EndAt %s is not after StartAt %s",
+			w.EndAt.Format(time.RFC3339), w.StartAt.Format(time.RFC3339))
+	}
+
+	loc, err := time.LoadLocation(w.Timezone)
+	if err != nil {
+		return fmt.Errorf("invalid activation window Timezone %q: %s", w.Timezone, err)
+	}
+
+	days := make([]time.Weekday, 0, len(w.Weekdays))
+	for _, name := range w.Weekdays {
+		d, ok := weekdays[strings.ToLower(name)]
+		if !ok {
+			return fmt.Errorf("invalid activation window weekday %q: expected sun, mon, tue, wed, thu, fri or sat", name)
+		}
+		days = append(days, d)
+	}
+
+	if (w.StartTime == "") != (w.EndTime == "") {
+		return fmt.Errorf("activation window needs both StartTime and EndTime")
+	}
+	var startTime, endTime time.Duration
+	if w.StartTime != "" {
+		if startTime, err = parseTimeOfDay(w.StartTime); err != nil {
+			return err
+		}
+		if endTime, err = parseTimeOfDay(w.EndTime); err != nil {
+			return err
+		}
+		if startTime == endTime {
+			return fmt.Errorf("activation window StartTime and EndTime are both %s", w.StartTime)
+		}
+	}
+
+	w.loc, w.days, w.startTime, w.endTime = loc, days, startTime, endTime
+	return nil
+}
+
+// IsActive reports whether the window is active at the given time. The
+// window has to be validated first.
+func (w *ActivationWindow) IsActive(t time.Time) bool {
+	if w.StartAt != nil && t.Before(*w.StartAt) {
+		return false
+	}
+	if w.EndAt != nil && !t.Before(*w.EndAt) {
+		return false
+	}
+
+	loc := w.loc
+	if loc == nil {
+		loc = time.UTC
+	}
+	t = t.In(loc)
+
+	day := t.Weekday()
+	if w.StartTime != "" {
+		// wall-clock time of day, not the time elapsed since midnight, which
+		// is an hour off on the days the clocks change
+		tod := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute +
+			time.Duration(t.Second())*time.Second + time.Duration(t.Nanosecond())
+		switch {
+		case w.startTime < w.endTime:
+			if tod < w.startTime || tod >= w.endTime {
+				return false
+			}
+		case tod >= w.startTime:
+		case tod < w.endTime:
+			// the window started the day before
+			day = (day + 6) % 7
+		default:
+			return false
+		}
+	}
+
+	return len(w.days) == 0 || slices.Contains(w.days, day)
+}
+
+func (w *ActivationWindow) String() string {
+	var parts []string
+	if w.StartAt != nil {
+		parts = append(parts, "from "+w.StartAt.Format(time.RFC3339))
+	}
+	if w.EndAt != nil {
+		parts = append(parts, "until "+w.EndAt.Format(time.RFC3339))
+	}
+	if len(w.Weekdays) != 0 {
+		parts = append(parts, "on "+strings.Join(w.Weekdays, ","))
+	}
+	if w.StartTime != "" {
+		parts = append(parts, fmt.Sprintf("between %s and %s", w.StartTime, w.EndTime))
+	}
+	if w.Timezone != "" {
+		parts = append(parts, "in "+w.Timezone)
+	}
+	return strings.Join(parts, " ")
+}
+
+// parseTimeOfDay parses HH:MM into the duration since midnight
+func parseTimeOfDay(s string) (time.Duration, error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid activation window time %q: expected HH:MM", s)
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
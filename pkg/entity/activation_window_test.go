@@ -0,0 +1,111 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestActivationWindowValidate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	assert.NoError(t, (&ActivationWindow{}).Validate())
+	assert.NoError(t, (&ActivationWindow{StartAt: &start, EndAt: new(start.Add(time.Hour))}).Validate())
+	assert.NoError(t, (&ActivationWindow{
+		Weekdays:  []string{"mon", "fri"},
+		StartTime: "22:00",
+		EndTime:   "06:00",
+		Timezone:  "Europe/Berlin",
+	}).Validate())
+
+	assert.Error(t, (&ActivationWindow{StartAt: &start, EndAt: &start}).Validate())
+	assert.Error(t, (&ActivationWindow{Timezone: "Mars/Olympus_Mons"}).Validate())
+	assert.Error(t, (&ActivationWindow{Weekdays: []string{"monday"}}).Validate())
+	assert.Error(t, (&ActivationWindow{StartTime: "09:00"}).Validate())
+	assert.Error(t, (&ActivationWindow{StartTime: "9am", EndTime: "17:00"}).Validate())
+	assert.Error(t, (&ActivationWindow{StartTime: "09:00", EndTime: "09:00"}).Validate())
+}
+
+func TestActivationWindowIsActive(t *testing.T) {
+	// 2026-01-05 is a Monday
+	monday := time.Date(2026, 1, 5, 0, 0, 0, 0, time.UTC)
+
+	t.Run("absolute", func(t *testing.T) {
+		w := &ActivationWindow{StartAt: new(monday), EndAt: new(monday.Add(24 * time.Hour))}
+		assert.NoError(t, w.Validate())
+
+		assert.False(t, w.IsActive(monday.Add(-time.Second)))
+		assert.True(t, w.IsActive(monday))
+		assert.True(t, w.IsActive(monday.Add(23*time.Hour)))
+		assert.False(t, w.IsActive(monday.Add(24*time.Hour)))
+	})
+
+	t.Run("weekdays business hours in a timezone", func(t *testing.T) {
+		w := &ActivationWindow{
+			Weekdays:  []string{"mon", "tue", "wed", "thu", "fri"},
+			StartTime: "09:00",
+			EndTime:   "17:00",
+			Timezone:  "Europe/Berlin",
+		}
+		assert.NoError(t, w.Validate())
+
+		// Berlin is UTC+1 in January
+		assert.False(t, w.IsActive(monday.Add(7*time.Hour+59*time.Minute)))
+		assert.True(t, w.IsActive(monday.Add(8*time.Hour)))
+		assert.True(t, w.IsActive(monday.Add(15*time.Hour+59*time.Minute)))
+		assert.False(t, w.IsActive(monday.Add(16*time.Hour)))
+		assert.False(t, w.IsActive(monday.Add(-2*24*time.Hour+10*time.Hour))) // saturday
+	})
+
+	t.Run("overnight", func(t *testing.T) {
+		w := &ActivationWindow{Weekdays: []string{"fri"}, StartTime: "22:00", EndTime: "02:00"}
+		assert.NoError(t, w.Validate())
+
+		friday := monday.Add(4 * 24 * time.Hour)
+		assert.False(t, w.IsActive(friday.Add(21*time.Hour)))
+		assert.True(t, w.IsActive(friday.Add(23*time.Hour)))
+		assert.True(t, w.IsActive(friday.Add(25*time.Hour))) // saturday 01:00
+		assert.False(t, w.IsActive(friday.Add(26*time.Hour)))
+		assert.False(t, w.IsActive(friday.Add(time.Hour))) // friday 01:00 belongs to thursday
+	})
+
+	t.Run("daylight saving time changes", func(t *testing.T) {
+		w := &ActivationWindow{StartTime: "09:00", EndTime: "17:00", Timezone: "Europe/Berlin"}
+		assert.NoError(t, w.Validate())
+		berlin, err := time.LoadLocation("Europe/Berlin")
+		assert.NoError(t, err)
+
+		// spring forward on 2026-03-29 and fall back on 2026-10-25
+		for _, day := range []time.Time{
+			time.Date(2026, 3, 29, 0, 0, 0, 0, berlin),
+			time.Date(2026, 10, 25, 0, 0, 0, 0, berlin),
+		} {
+			at := func(hour, min int) time.Time {
+				return time.Date(day.Year(), day.Month(), day.Day(), hour, min, 0, 0, berlin)
+			}
+			assert.False(t, w.IsActive(at(8, 59)), day)
+			assert.True(t, w.IsActive(at(9, 0)), day)
+			assert.True(t, w.IsActive(at(9, 30)), day)
+			assert.True(t, w.IsActive(at(16, 59)), day)
+			assert.False(t, w.IsActive(at(17, 0)), day)
+		}
+	})
+}
+
+func TestActivationWindowScanValue(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	w := ActivationWindow{StartAt: &start, Weekdays: []string{"sat", "sun"}, StartTime: "10:00", EndTime: "12:00"}
+
+	v, err := w.Value()
+	assert.NoError(t, err)
+
+	scanned := &ActivationWindow{Timezone: "Europe/Berlin"}
+	assert.NoError(t, scanned.Scan(v))
+	assert.True(t, start.Equal(*scanned.StartAt))
+	assert.Equal(t, []string{"sat", "sun"}, scanned.Weekdays)
+	assert.Empty(t, scanned.Timezone)
+	assert.Equal(t, "from 2026-01-01T00:00:00Z on sat,sun between 10:00 and 12:00", scanned.String())
+
+	assert.Error(t, scanned.Scan("{"))
+}
@@ -0,0 +1,129 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	oaerrors "github.com/go-openapi/errors"
+	"github.com/go-openapi/spec"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+	"github.com/spf13/cast"
+)
+
+// AttachmentSchema is a JSON Schema (draft 4) that the attachments of all the
+// variants of a flag must conform to. An empty schema accepts any attachment.
+type AttachmentSchema map[string]any
+
+// Scan implements scanner interface
+func (s *AttachmentSchema) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+	str := cast.ToString(value)
+	if err := json.Unmarshal([]byte(str), s); err != nil {
+		return fmt.Errorf("cannot scan %v into AttachmentSchema type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (s AttachmentSchema) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// Validate validates that the schema is a valid JSON Schema without remote
+// references
+func (s AttachmentSchema) Validate() error {
+	if len(s) == 0 {
+		return nil
+	}
+	if err := validate.AgainstSchema(spec.MustLoadJSONSchemaDraft04(), map[string]any(s), strfmt.Default); err != nil {
+		return fmt.Errorf("invalid attachment schema: %s", schemaErrorString(err))
+	}
+	if ref, ok := remoteSchemaRef(map[string]any(s)); ok {
+		return fmt.Errorf("invalid attachment schema: only local $ref are supported, got %q", ref)
+	}
+	if _, err := s.schema(); err != nil {
+		return fmt.Errorf("invalid attachment schema: %s", err)
+	}
+	return nil
+}
+
+// ValidateAttachment validates that the attachment conforms to the schema
+func (s AttachmentSchema) ValidateAttachment(a Attachment) error {
+	if len(s) == 0 {
+		return nil
+	}
+	sch, err := s.schema()
+	if err != nil {
+		return fmt.Errorf("invalid attachment schema: %s", err)
+	}
+
+	data := map[string]any(a)
+	if data == nil {
+		data = map[string]any{}
+	}
+	if err := validate.AgainstSchema(sch, data, strfmt.Default); err != nil {
+		return fmt.Errorf("attachment does not conform to the attachment schema of the flag: %s", schemaErrorString(err))
+	}
+	return nil
+}
+
+func (s AttachmentSchema) schema() (*spec.Schema, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	sch := &spec.Schema{}
+	if err := json.Unmarshal(b, sch); err != nil {
+		return nil, err
+	}
+	return sch, nil
+}
+
+// remoteSchemaRef returns the first $ref of the schema that doesn't point
+// into the schema itself, so that validating never fetches a remote schema
+func remoteSchemaRef(v any) (string, bool) {
+	switch t := v.(type) {
+	case map[string]any:
+		if ref, ok := t["$ref"].(string); ok && !strings.HasPrefix(ref, "#") {
+			return ref, true
+		}
+		for _, e := range t {
+			if ref, ok := remoteSchemaRef(e); ok {
+				return ref, true
+			}
+		}
+	case []any:
+		for _, e := range t {
+			if ref, ok := remoteSchemaRef(e); ok {
+				return ref, true
+			}
+		}
+	}
+	return "", false
+}
+
+// schemaErrorString joins the validation errors of a schema into a single line
+func schemaErrorString(err error) string {
+	var composite *oaerrors.CompositeError
+	if !errors.As(err, &composite) || len(composite.Errors) == 0 {
+		return err.Error()
+	}
+	msgs := make([]string, 0, len(composite.Errors))
+	for _, e := range composite.Errors {
+		msgs = append(msgs, schemaErrorString(e))
+	}
+	return strings.Join(msgs, "; ")
+}
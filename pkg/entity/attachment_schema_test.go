@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentSchemaValidate(t *testing.T) {
+	assert.NoError(t, AttachmentSchema(nil).Validate())
+	assert.NoError(t, AttachmentSchema{
+		"type":     "object",
+		"required": []any{"color"},
+		"properties": map[string]any{
+			"color": map[string]any{"$ref": "#/definitions/color"},
+		},
+		"definitions": map[string]any{
+			"color": map[string]any{"type": "string", "enum": []any{"red", "blue"}},
+		},
+	}.Validate())
+
+	assert.Error(t, AttachmentSchema{"type": "objekt"}.Validate())
+	assert.Error(t, AttachmentSchema{"required": "color"}.Validate())
+	assert.Error(t, AttachmentSchema{
+		"properties": map[string]any{
+			"color": map[string]any{"$ref": "https://example.com/color.json"},
+		},
+	}.Validate())
+}
+
+func TestAttachmentSchemaValidateAttachment(t *testing.T) {
+	s := AttachmentSchema{
+		"type":                 "object",
+		"required":             []any{"color"},
+		"additionalProperties": false,
+		"properties": map[string]any{
+			"color": map[string]any{"$ref": "#/definitions/color"},
+			"size":  map[string]any{"type": "integer", "minimum": 1},
+		},
+		"definitions": map[string]any{
+			"color": map[string]any{"type": "string", "enum": []any{"red", "blue"}},
+		},
+	}
+
+	assert.NoError(t, AttachmentSchema(nil).ValidateAttachment(Attachment{"anything": true}))
+	assert.NoError(t, s.ValidateAttachment(Attachment{"color": "red"}))
+	assert.NoError(t, s.ValidateAttachment(Attachment{"color": "blue", "size": float64(3)}))
+
+	for _, a := range []Attachment{
+		nil,
+		{"size": float64(3)},
+		{"color": "green"},
+		{"color": "red", "size": float64(0)},
+		{"color": "red", "size": "3"},
+		{"color": "red", "colour": "red"},
+	} {
+		assert.Error(t, s.ValidateAttachment(a), "attachment %v", a)
+	}
+}
+
+func TestAttachmentSchemaScanValue(t *testing.T) {
+	s := AttachmentSchema{"type": "object"}
+	v, err := s.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `{"type":"object"}`, v)
+
+	v, err = AttachmentSchema(nil).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	scanned := AttachmentSchema{}
+	assert.NoError(t, scanned.Scan(`{"type":"object"}`))
+	assert.Equal(t, s, scanned)
+	assert.Error(t, scanned.Scan(`{`))
+}
@@ -0,0 +1,55 @@
+package entity
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// Audience is a named set of constraints. Segments of different flags can
+// reference the same audience instead of copying its constraints around.
+type Audience struct {
+	gorm.Model
+
+	Key         string `gorm:"type:varchar(64);uniqueIndex:idx_audience_key"`
+	Description string `gorm:"type:text"`
+	Constraints ConstraintArray
+}
+
+// PreloadAudienceConstraints preloads constraints for audience
+func PreloadAudienceConstraints(db *gorm.DB) *gorm.DB {
+	return db.Preload("Constraints", func(db *gorm.DB) *gorm.DB {
+		return db.Order("created_at")
+	})
+}
+
+// Preload preloads the audience
+func (a *Audience) Preload(db *gorm.DB) error {
+	return PreloadAudienceConstraints(db).First(a, a.Model.ID).Error
+}
+
+// Validate validates the constraints of the audience
+func (a *Audience) Validate() error {
+	for _, c := range a.Constraints {
+		if err := c.Validate(); err != nil {
+			return fmt.Errorf("constraint %s %s %s is invalid: %w", c.Property, c.Operator, c.Value, err)
+		}
+	}
+	return nil
+}
+
+// FlagIDs returns the IDs of the flags that have segments referencing the
+// audience. The segments of deleted flags are left in place, so the flags
+// are joined to skip them.
+func (a *Audience) FlagIDs(db *gorm.DB) ([]uint, error) {
+	ids := []uint{}
+	err := db.Model(&Segment{}).
+		Joins("JOIN flags ON flags.id = segments.flag_id AND flags.deleted_at IS NULL").
+		Joins("JOIN segments_audiences ON segments_audiences.segment_id = segments.id").
+		Where("segments_audiences.audience_id = ?", a.ID).
+		Distinct().
+		Order("segments.flag_id").
+		Pluck("segments.flag_id", &ids).
+		Error
+	return ids, err
+}
@@ -0,0 +1,56 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAudienceValidate(t *testing.T) {
+	a := Audience{Key: "eu_users", Constraints: []Constraint{
+		{Property: "country", Operator: models.ConstraintOperatorIN, Value: `["DE", "FR"]`},
+		{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"2.0.0"`},
+	}}
+	assert.NoError(t, a.Validate())
+
+	a.Constraints[1].Value = `"latest"`
+	assert.Error(t, a.Validate())
+}
+
+func TestAudienceFlagIDs(t *testing.T) {
+	db := NewTestDB()
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+
+	a := Audience{Key: "eu_users"}
+	assert.NoError(t, db.Create(&a).Error)
+
+	ids, err := a.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+
+	for _, key := range []string{"flag_1", "flag_2"} {
+		f := Flag{Key: key, Segments: []Segment{
+			{Description: "s1", Audiences: []Audience{a}},
+			{Description: "s2", Audiences: []Audience{a}},
+		}}
+		assert.NoError(t, db.Create(&f).Error)
+	}
+	assert.NoError(t, db.Create(&Flag{Key: "flag_3", Segments: []Segment{{Description: "s3"}}}).Error)
+
+	ids, err = a.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1, 2}, ids)
+
+	// the segments of a deleted flag are left in place
+	assert.NoError(t, db.Delete(&Flag{}, 1).Error)
+	ids, err = a.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{2}, ids)
+}
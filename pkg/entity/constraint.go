@@ -2,6 +2,7 @@ package entity
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/foxdalas/flagr/swagger_gen/models"
@@ -13,15 +14,44 @@ import (
 type Constraint struct {
 	gorm.Model
 
-	SegmentID uint `gorm:"index:idx_constraint_segmentid"`
-	Property  string
-	Operator  string
-	Value     string `gorm:"type:text"`
+	SegmentID  uint `gorm:"index:idx_constraint_segmentid"`
+	AudienceID uint `gorm:"index:idx_constraint_audienceid"` // set instead of SegmentID for constraints of an Audience
+	Property   string
+	Operator   string
+	Value      string `gorm:"type:text"`
+
+	// Group is the constraint group within the segment. Constraints of the
+	// same group are joined by AND, and the groups are joined by OR.
+	Group uint `gorm:"column:constraint_group;not null;default:0"`
 }
 
 // ConstraintArray is an array of Constraint
 type ConstraintArray []Constraint
 
+// Groups splits the constraints by Group, ordered by the group number.
+// The order of the constraints within a group is kept.
+func (cs ConstraintArray) Groups() []ConstraintArray {
+	if len(cs) == 0 {
+		return nil
+	}
+
+	idx := map[uint]int{}
+	groups := []ConstraintArray{}
+	for _, c := range cs {
+		i, ok := idx[c.Group]
+		if !ok {
+			i = len(groups)
+			idx[c.Group] = i
+			groups = append(groups, ConstraintArray{})
+		}
+		groups[i] = append(groups[i], c)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return groups[i][0].Group < groups[j][0].Group
+	})
+	return groups
+}
+
 // OperatorToExprMap maps from the swagger model operator to condition operator
 var OperatorToExprMap = map[string]string{
 	models.ConstraintOperatorEQ:          "==",
@@ -61,6 +91,9 @@ func (c *Constraint) toExprStr() (string, error) {
 			c.Value,
 		)
 	}
+	if IsNativeOperator(c.Operator) {
+		return "", fmt.Errorf("operator %s has no conditions expression, use ToMatcher instead", c.Operator)
+	}
 	o, ok := OperatorToExprMap[c.Operator]
 	if !ok {
 		return "", fmt.Errorf("not supported operator: %s", c.Operator)
@@ -94,20 +127,32 @@ func isQuotedString(s string) bool {
 
 // Validate validates Constraint
 func (c *Constraint) Validate() error {
+	if IsNativeOperator(c.Operator) {
+		_, err := c.ToMatcher()
+		return err
+	}
 	_, err := c.ToExpr()
 	return err
 }
 
-// ToExpr maps ConstraintArray to expr by joining 'AND'
+// ToExpr maps ConstraintArray to expr by joining 'AND'. Constraints with
+// native operators are skipped, see ToMatchers. It returns a nil expr if
+// there's no constraint left.
 func (cs ConstraintArray) ToExpr() (conditions.Expr, error) {
 	strs := make([]string, 0, len(cs))
 	for _, c := range cs {
+		if IsNativeOperator(c.Operator) {
+			continue
+		}
 		s, err := c.toExprStr()
 		if err != nil {
 			return nil, err
 		}
 		strs = append(strs, s)
 	}
+	if len(strs) == 0 {
+		return nil, nil
+	}
 	exprStr := strings.Join(strs, " AND ")
 	p := conditions.NewParser(strings.NewReader(exprStr))
 	expr, err := p.Parse()
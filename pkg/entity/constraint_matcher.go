@@ -0,0 +1,512 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/zhouzhuojie/conditions"
+)
+
+// ConstraintMatcher evaluates a single constraint natively in Go.
+//
+// Most operators are translated into a conditions.Expr, but some of them
+// (e.g. semver comparisons) cannot be expressed in the conditions language.
+// Those are registered in nativeOperators and evaluated by a ConstraintMatcher.
+type ConstraintMatcher interface {
+	Match(entityContext map[string]any) (bool, error)
+	String() string
+}
+
+// matchFunc matches the resolved property value of the entity context
+type matchFunc func(v any) (bool, error)
+
+// nativeOperators maps from the swagger model operator to the builder of its
+// matchFunc. The builder receives the trimmed constraint value and rejects it
+// if it cannot be evaluated.
+var nativeOperators = map[string]func(value string) (matchFunc, error){
+	models.ConstraintOperatorSEMVEREQ:  semverMatchFunc(func(c int) bool { return c == 0 }),
+	models.ConstraintOperatorSEMVERLT:  semverMatchFunc(func(c int) bool { return c < 0 }),
+	models.ConstraintOperatorSEMVERLTE: semverMatchFunc(func(c int) bool { return c <= 0 }),
+	models.ConstraintOperatorSEMVERGT:  semverMatchFunc(func(c int) bool { return c > 0 }),
+	models.ConstraintOperatorSEMVERGTE: semverMatchFunc(func(c int) bool { return c >= 0 }),
+	models.ConstraintOperatorBEFORE:    timeMatchFunc(time.Time.Before),
+	models.ConstraintOperatorAFTER:     timeMatchFunc(time.Time.After),
+	models.ConstraintOperatorINLIST:    listMatchFunc(true),
+	models.ConstraintOperatorNOTINLIST: listMatchFunc(false),
+	models.ConstraintOperatorINCIDR:    cidrMatchFunc(true),
+	models.ConstraintOperatorNOTINCIDR: cidrMatchFunc(false),
+
+	models.ConstraintOperatorSTARTSWITH:         stringsMatchFunc(strings.HasPrefix, false),
+	models.ConstraintOperatorENDSWITH:           stringsMatchFunc(strings.HasSuffix, false),
+	models.ConstraintOperatorEQIGNORECASE:       stringsMatchFunc(func(s, v string) bool { return s == v }, true),
+	models.ConstraintOperatorCONTAINSIGNORECASE: stringsMatchFunc(strings.Contains, true),
+	models.ConstraintOperatorINIGNORECASE:       inIgnoreCaseMatchFunc,
+}
+
+// IsNativeOperator reports whether the operator is evaluated by a
+// ConstraintMatcher instead of a conditions.Expr
+func IsNativeOperator(operator string) bool {
+	_, ok := nativeOperators[operator]
+	return ok
+}
+
+type constraintMatcher struct {
+	ref   propertyRef
+	str   string
+	match matchFunc
+}
+
+// Match resolves the property from the entity context and matches its value
+func (m *constraintMatcher) Match(entityContext map[string]any) (bool, error) {
+	v, err := m.ref.resolve(entityContext)
+	if err != nil {
+		return false, err
+	}
+	return m.match(v)
+}
+
+func (m *constraintMatcher) String() string {
+	return m.str
+}
+
+// ToMatcher transfers the constraint with a native operator to a ConstraintMatcher
+func (c *Constraint) ToMatcher() (ConstraintMatcher, error) {
+	if c.Property == "" || c.Operator == "" || c.Value == "" {
+		return nil, fmt.Errorf(
+			"empty Property/Operator/Value: %s/%s/%s",
+			c.Property,
+			c.Operator,
+			c.Value,
+		)
+	}
+	build, ok := nativeOperators[c.Operator]
+	if !ok {
+		return nil, fmt.Errorf("not supported operator: %s", c.Operator)
+	}
+
+	ref, err := parsePropertyRef(c.Property)
+	if err != nil {
+		return nil, err
+	}
+
+	val := strings.TrimSpace(c.Value)
+	match, err := build(val)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for %s: %s", c.Operator, err)
+	}
+
+	return &constraintMatcher{
+		ref:   ref,
+		str:   fmt.Sprintf("({%s} %s %s)", c.Property, c.Operator, val),
+		match: match,
+	}, nil
+}
+
+// Match matches the single constraint against the entity context, the same
+// way it's matched within the constraint group of its segment
+func (c *Constraint) Match(entityContext map[string]any) (bool, error) {
+	if IsNativeOperator(c.Operator) {
+		m, err := c.ToMatcher()
+		if err != nil {
+			return false, err
+		}
+		return m.Match(entityContext)
+	}
+
+	expr, err := c.ToExpr()
+	if err != nil {
+		return false, err
+	}
+	return conditions.Evaluate(expr, entityContext)
+}
+
+// ResolveProperty returns the value of the property of the constraint in the
+// entity context
+func (c *Constraint) ResolveProperty(entityContext map[string]any) (any, error) {
+	ref, err := parsePropertyRef(c.Property)
+	if err != nil {
+		return nil, err
+	}
+	return ref.resolve(entityContext)
+}
+
+// ToMatchers maps the constraints with native operators to ConstraintMatchers
+func (cs ConstraintArray) ToMatchers() ([]ConstraintMatcher, error) {
+	var ms []ConstraintMatcher
+	for _, c := range cs {
+		if !IsNativeOperator(c.Operator) {
+			continue
+		}
+		m, err := c.ToMatcher()
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, m)
+	}
+	return ms, nil
+}
+
+// propertyRef is the parsed property of a constraint. The property is parsed
+// with the conditions grammar, so nested paths like `user.name` or `users[0]`
+// resolve exactly the same way as they do for expression based operators.
+type propertyRef struct {
+	root  string
+	steps []conditions.PathStep
+}
+
+func parsePropertyRef(property string) (propertyRef, error) {
+	p := conditions.NewParser(strings.NewReader(fmt.Sprintf("({%s} == 0)", property)))
+	expr, err := p.Parse()
+	if err != nil {
+		return propertyRef{}, fmt.Errorf("invalid property %q: %s", property, err)
+	}
+
+	var ref *propertyRef
+	conditions.WalkFunc(expr, func(n conditions.Node) {
+		if ref != nil {
+			return
+		}
+		switch v := n.(type) {
+		case *conditions.VarRef:
+			ref = &propertyRef{root: v.Val}
+		case *conditions.PathRef:
+			ref = &propertyRef{root: v.Root, steps: v.Steps}
+		}
+	})
+	if ref == nil {
+		return propertyRef{}, fmt.Errorf("invalid property %q", property)
+	}
+	return *ref, nil
+}
+
+func (r propertyRef) resolve(entityContext map[string]any) (any, error) {
+	current, ok := entityContext[r.root]
+	if !ok {
+		return nil, fmt.Errorf("argument: %v not found", r.root)
+	}
+
+	for _, step := range r.steps {
+		if step.IsIndex {
+			arr, ok := current.([]any)
+			if !ok {
+				return nil, fmt.Errorf("cannot index non-array value traversing %s", r.root)
+			}
+			idx := step.Index
+			if idx < 0 {
+				idx = len(arr) + idx
+			}
+			if idx < 0 || idx >= len(arr) {
+				return nil, fmt.Errorf("index %d out of bounds traversing %s", step.Index, r.root)
+			}
+			current = arr[idx]
+		} else {
+			m, ok := current.(map[string]any)
+			if !ok {
+				return nil, fmt.Errorf("cannot access key %q on non-map value traversing %s", step.Key, r.root)
+			}
+			v, ok := m[step.Key]
+			if !ok {
+				return nil, fmt.Errorf("key %q not found traversing %s", step.Key, r.root)
+			}
+			current = v
+		}
+	}
+
+	if current == nil {
+		return nil, fmt.Errorf("unsupported argument nil type for %s", r.root)
+	}
+	return current, nil
+}
+
+func (r propertyRef) String() string {
+	return (&conditions.PathRef{Root: r.root, Steps: r.steps}).String()
+}
+
+// ResolvedProperties resolves the properties referenced by a conditions.Expr
+// or a ConstraintMatcher against the entity context, and formats them like
+// `device.os="ios"` for the debug logs of the evaluation
+func ResolvedProperties(constraint fmt.Stringer, entityContext map[string]any) string {
+	var refs []propertyRef
+	switch c := constraint.(type) {
+	case *constraintMatcher:
+		refs = append(refs, c.ref)
+	case conditions.Expr:
+		conditions.WalkFunc(c, func(n conditions.Node) {
+			switch v := n.(type) {
+			case *conditions.VarRef:
+				refs = append(refs, propertyRef{root: v.Val})
+			case *conditions.PathRef:
+				refs = append(refs, propertyRef{root: v.Root, steps: v.Steps})
+			}
+		})
+	}
+
+	seen := make(map[string]bool, len(refs))
+	parts := make([]string, 0, len(refs))
+	for _, ref := range refs {
+		name := ref.String()
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+
+		v, err := ref.resolve(entityContext)
+		if err != nil {
+			parts = append(parts, fmt.Sprintf("%s=<%s>", name, err))
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			parts = append(parts, fmt.Sprintf("%s=%v", name, v))
+			continue
+		}
+		parts = append(parts, fmt.Sprintf("%s=%s", name, b))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// unquoteValue returns the content of a double-quoted constraint value,
+// or the value itself if it isn't quoted
+func unquoteValue(val string) (string, error) {
+	if !isQuotedString(val) {
+		return val, nil
+	}
+	return strconv.Unquote(val)
+}
+
+// stringValue converts a scalar entity context value to its string form
+func stringValue(v any) (string, error) {
+	switch t := v.(type) {
+	case string:
+		return t, nil
+	case json.Number:
+		return t.String(), nil
+	case float64:
+		return strconv.FormatFloat(t, 'f', -1, 64), nil
+	case int:
+		return strconv.Itoa(t), nil
+	case int64:
+		return strconv.FormatInt(t, 10), nil
+	}
+	return "", fmt.Errorf("unsupported type: %T", v)
+}
+
+func semverMatchFunc(cmp func(c int) bool) func(value string) (matchFunc, error) {
+	return func(value string) (matchFunc, error) {
+		s, err := unquoteValue(value)
+		if err != nil {
+			return nil, err
+		}
+		want, err := ParseSemver(s)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v any) (bool, error) {
+			s, err := stringValue(v)
+			if err != nil {
+				return false, err
+			}
+			got, err := ParseSemver(s)
+			if err != nil {
+				return false, err
+			}
+			return cmp(got.Compare(want)), nil
+		}, nil
+	}
+}
+
+func timeMatchFunc(cmp func(t, u time.Time) bool) func(value string) (matchFunc, error) {
+	return func(value string) (matchFunc, error) {
+		s, err := unquoteValue(value)
+		if err != nil {
+			return nil, err
+		}
+		want, err := parseTimeLiteral(s)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v any) (bool, error) {
+			got, err := timeValue(v)
+			if err != nil {
+				return false, err
+			}
+			return cmp(got, want.at(timeNow())), nil
+		}, nil
+	}
+}
+
+// listMatchFunc looks up the list at match time, so that the constraint picks
+// up a new upload without rebuilding the flag. A missing list is an error.
+func listMatchFunc(in bool) func(value string) (matchFunc, error) {
+	return func(value string) (matchFunc, error) {
+		key, err := unquoteValue(value)
+		if err != nil {
+			return nil, err
+		}
+		if key == "" {
+			return nil, fmt.Errorf("empty list key")
+		}
+
+		return func(v any) (bool, error) {
+			set, ok := lookupListSet(key)
+			if !ok {
+				return false, fmt.Errorf("list %s not found", key)
+			}
+			s, err := stringValue(v)
+			if err != nil {
+				return false, err
+			}
+			_, found := set[s]
+			return found == in, nil
+		}, nil
+	}
+}
+
+// stringValues parses a quoted string, or a JSON array of strings
+func stringValues(value string) ([]string, error) {
+	var ss []string
+	if strings.HasPrefix(value, "[") {
+		if err := json.Unmarshal([]byte(value), &ss); err != nil {
+			return nil, fmt.Errorf("invalid JSON array of strings: %s", err)
+		}
+	} else {
+		s, err := unquoteValue(value)
+		if err != nil {
+			return nil, err
+		}
+		ss = []string{s}
+	}
+	if len(ss) == 0 {
+		return nil, fmt.Errorf("empty list of values")
+	}
+	return ss, nil
+}
+
+// parsePrefixes parses a quoted CIDR, or a JSON array of them. A plain IP
+// address is taken as a single address range.
+func parsePrefixes(value string) ([]netip.Prefix, error) {
+	ss, err := stringValues(value)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixes := make([]netip.Prefix, 0, len(ss))
+	for _, s := range ss {
+		s = strings.TrimSpace(s)
+		if !strings.Contains(s, "/") {
+			addr, err := netip.ParseAddr(s)
+			if err != nil {
+				return nil, fmt.Errorf("invalid CIDR %q", s)
+			}
+			addr = addr.Unmap()
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		p, err := netip.ParsePrefix(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q", s)
+		}
+		if p.Addr().Is4In6() && p.Bits() >= 96 {
+			p = netip.PrefixFrom(p.Addr().Unmap(), p.Bits()-96)
+		}
+		prefixes = append(prefixes, p.Masked())
+	}
+	return prefixes, nil
+}
+
+// cidrMatchFunc parses the CIDR ranges once, when the flag is prepared for
+// evaluation. IPv4-mapped IPv6 addresses match the IPv4 ranges.
+func cidrMatchFunc(in bool) func(value string) (matchFunc, error) {
+	return func(value string) (matchFunc, error) {
+		prefixes, err := parsePrefixes(value)
+		if err != nil {
+			return nil, err
+		}
+
+		return func(v any) (bool, error) {
+			s, ok := v.(string)
+			if !ok {
+				return false, fmt.Errorf("unsupported type: %T", v)
+			}
+			addr, err := netip.ParseAddr(strings.TrimSpace(s))
+			if err != nil {
+				return false, fmt.Errorf("invalid IP address %q", s)
+			}
+			addr = addr.Unmap()
+			for _, p := range prefixes {
+				if p.Contains(addr) {
+					return in, nil
+				}
+			}
+			return !in, nil
+		}, nil
+	}
+}
+
+// stringsMatchFunc matches when cmp holds for any of the values, which are a
+// quoted string or a JSON array of strings. Case-insensitive matchers lower
+// the values once, and only the entity context value on every evaluation.
+func stringsMatchFunc(cmp func(s, v string) bool, ignoreCase bool) func(value string) (matchFunc, error) {
+	return func(value string) (matchFunc, error) {
+		values, err := stringValues(value)
+		if err != nil {
+			return nil, err
+		}
+		for i, v := range values {
+			if v == "" {
+				return nil, fmt.Errorf("empty value")
+			}
+			if ignoreCase {
+				values[i] = strings.ToLower(v)
+			}
+		}
+
+		return func(v any) (bool, error) {
+			s, err := stringValue(v)
+			if err != nil {
+				return false, err
+			}
+			if ignoreCase {
+				s = strings.ToLower(s)
+			}
+			for _, want := range values {
+				if cmp(s, want) {
+					return true, nil
+				}
+			}
+			return false, nil
+		}, nil
+	}
+}
+
+// inIgnoreCaseMatchFunc matches the values of a JSON array of strings with a
+// set lookup, so that long arrays don't slow down the evaluation
+func inIgnoreCaseMatchFunc(value string) (matchFunc, error) {
+	if !strings.HasPrefix(value, "[") {
+		return nil, fmt.Errorf("must be a JSON array of strings")
+	}
+	values, err := stringValues(value)
+	if err != nil {
+		return nil, err
+	}
+	set := make(map[string]struct{}, len(values))
+	for _, v := range values {
+		set[strings.ToLower(v)] = struct{}{}
+	}
+
+	return func(v any) (bool, error) {
+		s, err := stringValue(v)
+		if err != nil {
+			return false, err
+		}
+		_, ok := set[strings.ToLower(s)]
+		return ok, nil
+	}, nil
+}
@@ -0,0 +1,424 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConstraintToMatcher(t *testing.T) {
+	t.Run("empty case", func(t *testing.T) {
+		c := Constraint{}
+		m, err := c.ToMatcher()
+		assert.Error(t, err)
+		assert.Nil(t, m)
+	})
+
+	t.Run("not a native operator", func(t *testing.T) {
+		c := Constraint{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`}
+		m, err := c.ToMatcher()
+		assert.Error(t, err)
+		assert.Nil(t, m)
+	})
+
+	t.Run("invalid property", func(t *testing.T) {
+		c := Constraint{Property: "app.", Operator: models.ConstraintOperatorSEMVERGT, Value: `"1.0.0"`}
+		m, err := c.ToMatcher()
+		assert.Error(t, err)
+		assert.Nil(t, m)
+	})
+
+	t.Run("unparsable semver literal", func(t *testing.T) {
+		for _, v := range []string{`"1.x"`, `"latest"`, `abc`, `"1.0.0-"`} {
+			c := Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: v}
+			m, err := c.ToMatcher()
+			assert.Error(t, err, v)
+			assert.Nil(t, m)
+		}
+	})
+
+	t.Run("happy code path", func(t *testing.T) {
+		c := Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: ` "1.9.0" `}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+		assert.Equal(t, `({app_version} SEMVER_GTE "1.9.0")`, m.String())
+	})
+}
+
+func TestSemverMatcher(t *testing.T) {
+	match := func(operator, value string, entityContext map[string]any) (bool, error) {
+		c := Constraint{Property: "app_version", Operator: operator, Value: value}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+		return m.Match(entityContext)
+	}
+
+	t.Run("numeric ordering of components", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorSEMVERGT, `"1.9.0"`, map[string]any{"app_version": "1.10.0"})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = match(models.ConstraintOperatorSEMVERLT, `"1.9.0"`, map[string]any{"app_version": "1.10.0"})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("pre-release ordering", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorSEMVERLT, `"2.0.0"`, map[string]any{"app_version": "2.0.0-rc.1"})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = match(models.ConstraintOperatorSEMVERGTE, `"2.0.0-beta.11"`, map[string]any{"app_version": "2.0.0-beta.2"})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("all operators", func(t *testing.T) {
+		entityContext := map[string]any{"app_version": "v1.2"}
+		for operator, expected := range map[string]bool{
+			models.ConstraintOperatorSEMVEREQ:  true,
+			models.ConstraintOperatorSEMVERLT:  false,
+			models.ConstraintOperatorSEMVERLTE: true,
+			models.ConstraintOperatorSEMVERGT:  false,
+			models.ConstraintOperatorSEMVERGTE: true,
+		} {
+			ok, err := match(operator, `"1.2.0"`, entityContext)
+			assert.NoError(t, err)
+			assert.Equal(t, expected, ok, operator)
+		}
+	})
+
+	t.Run("unquoted literal and numeric context value", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorSEMVEREQ, `2.0`, map[string]any{"app_version": float64(2)})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("nested property", func(t *testing.T) {
+		c := Constraint{Property: "app.versions[0]", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"3.1.0"`}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+		ok, err := m.Match(map[string]any{"app": map[string]any{"versions": []any{"3.1.4"}}})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		_, err := match(models.ConstraintOperatorSEMVEREQ, `"1.0.0"`, map[string]any{})
+		assert.Error(t, err)
+
+		_, err = match(models.ConstraintOperatorSEMVEREQ, `"1.0.0"`, map[string]any{"app_version": "not-a-version"})
+		assert.Error(t, err)
+
+		_, err = match(models.ConstraintOperatorSEMVEREQ, `"1.0.0"`, map[string]any{"app_version": true})
+		assert.Error(t, err)
+	})
+}
+
+func TestTimeMatcher(t *testing.T) {
+	defer gostub.StubFunc(&timeNow, time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)).Reset()
+
+	match := func(operator, value string, v any) (bool, error) {
+		c := Constraint{Property: "created_at", Operator: operator, Value: value}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+		return m.Match(map[string]any{"created_at": v})
+	}
+
+	t.Run("absolute time", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorAFTER, `"2026-01-01T00:00:00Z"`, "2026-02-14T09:30:00+01:00")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = match(models.ConstraintOperatorBEFORE, `"2026-01-01T00:00:00Z"`, "2026-02-14T09:30:00+01:00")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("unix epoch", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorBEFORE, `1767225600`, float64(1767225599))
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("relative to now", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorBEFORE, `"now+7d"`, "2026-03-05T00:00:00Z")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = match(models.ConstraintOperatorBEFORE, `now+7d`, "2026-03-09T00:00:00Z")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = match(models.ConstraintOperatorAFTER, `"now"`, "2026-03-01T12:00:01Z")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("invalid literal", func(t *testing.T) {
+		for _, v := range []string{`"tomorrow"`, `"now+1y"`, `"2026-02-30"`} {
+			c := Constraint{Property: "created_at", Operator: models.ConstraintOperatorAFTER, Value: v}
+			assert.Error(t, c.Validate(), v)
+		}
+	})
+
+	t.Run("invalid entity context value", func(t *testing.T) {
+		_, err := match(models.ConstraintOperatorAFTER, `"now"`, "last week")
+		assert.Error(t, err)
+	})
+}
+
+func TestConstraintArrayToMatchers(t *testing.T) {
+	cs := ConstraintArray{
+		{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`},
+		{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"1.2.0"`},
+	}
+
+	ms, err := cs.ToMatchers()
+	assert.NoError(t, err)
+	assert.Len(t, ms, 1)
+
+	expr, err := cs.ToExpr()
+	assert.NoError(t, err)
+	assert.Equal(t, `(dl_state == "CA")`, expr.String())
+
+	expr, err = cs[1:].ToExpr()
+	assert.NoError(t, err)
+	assert.Nil(t, expr)
+}
+
+func TestListMatcher(t *testing.T) {
+	SetListSets(map[string]ListSet{"beta_users": NewListSet([]string{"u1", "42"})})
+	defer SetListSets(nil)
+
+	match := func(operator, value string, entityContext map[string]any) (bool, error) {
+		c := Constraint{Property: "user_id", Operator: operator, Value: value}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+		return m.Match(entityContext)
+	}
+
+	t.Run("membership", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorINLIST, `"beta_users"`, map[string]any{"user_id": "u1"})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = match(models.ConstraintOperatorINLIST, `beta_users`, map[string]any{"user_id": "u2"})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		ok, err = match(models.ConstraintOperatorNOTINLIST, `"beta_users"`, map[string]any{"user_id": "u2"})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = match(models.ConstraintOperatorNOTINLIST, `"beta_users"`, map[string]any{"user_id": "u1"})
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("numeric context value", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorINLIST, `"beta_users"`, map[string]any{"user_id": float64(42)})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("picks up new sets", func(t *testing.T) {
+		c := Constraint{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+
+		SetListSets(map[string]ListSet{"beta_users": NewListSet([]string{"u2"})})
+		ok, err := m.Match(map[string]any{"user_id": "u2"})
+		assert.NoError(t, err)
+		assert.True(t, ok)
+	})
+
+	t.Run("unknown list", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorNOTINLIST, `"unknown"`, map[string]any{"user_id": "u1"})
+		assert.Error(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("empty list key", func(t *testing.T) {
+		c := Constraint{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `""`}
+		m, err := c.ToMatcher()
+		assert.Error(t, err)
+		assert.Nil(t, m)
+	})
+}
+
+func TestCIDRMatcher(t *testing.T) {
+	match := func(operator, value string, ip any) (bool, error) {
+		c := Constraint{Property: "ip", Operator: operator, Value: value}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+		return m.Match(map[string]any{"ip": ip})
+	}
+
+	t.Run("IPv4 and IPv6 ranges", func(t *testing.T) {
+		offices := `["10.0.0.0/8", "192.168.1.0/24", "2001:db8::/32"]`
+		for ip, want := range map[string]bool{
+			"10.1.2.3":         true,
+			"192.168.1.77":     true,
+			"192.168.2.1":      false,
+			"2001:db8::1":      true,
+			"2001:db9::1":      false,
+			"::ffff:10.0.0.1":  true,
+			"8.8.8.8":          false,
+			" 192.168.1.1 ":    true,
+			"2001:db8:0:0::ff": true,
+		} {
+			ok, err := match(models.ConstraintOperatorINCIDR, offices, ip)
+			assert.NoError(t, err, ip)
+			assert.Equal(t, want, ok, ip)
+
+			ok, err = match(models.ConstraintOperatorNOTINCIDR, offices, ip)
+			assert.NoError(t, err, ip)
+			assert.Equal(t, !want, ok, ip)
+		}
+	})
+
+	t.Run("single range and address", func(t *testing.T) {
+		ok, err := match(models.ConstraintOperatorINCIDR, `"203.0.113.0/24"`, "203.0.113.9")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = match(models.ConstraintOperatorINCIDR, `["203.0.113.9"]`, "203.0.113.9")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = match(models.ConstraintOperatorINCIDR, `["203.0.113.9"]`, "203.0.113.10")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid entity context value", func(t *testing.T) {
+		for _, ip := range []any{"not an ip", "10.0.0.0/8", float64(10)} {
+			ok, err := match(models.ConstraintOperatorNOTINCIDR, `["10.0.0.0/8"]`, ip)
+			assert.Error(t, err, ip)
+			assert.False(t, ok, ip)
+		}
+	})
+
+	t.Run("invalid CIDR list", func(t *testing.T) {
+		for _, v := range []string{`[]`, `["10.0.0.0/33"]`, `["10.0.0.0/8", "office"]`, `[10]`, `"10.0.0.0/8`, `["10.0.0.0/8"`} {
+			c := Constraint{Property: "ip", Operator: models.ConstraintOperatorINCIDR, Value: v}
+			m, err := c.ToMatcher()
+			assert.Error(t, err, v)
+			assert.Nil(t, m)
+		}
+	})
+}
+
+func TestStringMatchers(t *testing.T) {
+	match := func(operator, value string, email any) (bool, error) {
+		c := Constraint{Property: "email", Operator: operator, Value: value}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+		return m.Match(map[string]any{"email": email})
+	}
+
+	for _, tc := range []struct {
+		operator string
+		value    string
+		email    any
+		want     bool
+	}{
+		{models.ConstraintOperatorSTARTSWITH, `"admin@"`, "admin@example.com", true},
+		{models.ConstraintOperatorSTARTSWITH, `"admin@"`, "Admin@example.com", false},
+		{models.ConstraintOperatorSTARTSWITH, `["ops@", "admin@"]`, "ops@example.com", true},
+		{models.ConstraintOperatorENDSWITH, `"@example.com"`, "jane@example.com", true},
+		{models.ConstraintOperatorENDSWITH, `"@example.com"`, "jane@example.com.evil", false},
+		{models.ConstraintOperatorENDSWITH, `["@example.com", "@example.org"]`, "jane@example.org", true},
+		{models.ConstraintOperatorEQIGNORECASE, `"Jane@Example.com"`, "jane@EXAMPLE.com", true},
+		{models.ConstraintOperatorEQIGNORECASE, `"jane@example.com"`, "jane@example.co", false},
+		{models.ConstraintOperatorINIGNORECASE, `["CA", "NY"]`, "ny", true},
+		{models.ConstraintOperatorINIGNORECASE, `["CA", "NY"]`, "TX", false},
+		{models.ConstraintOperatorCONTAINSIGNORECASE, `"@Example."`, "jane@EXAMPLE.com", true},
+		{models.ConstraintOperatorCONTAINSIGNORECASE, `"@Example."`, "jane@test.com", false},
+		{models.ConstraintOperatorEQIGNORECASE, `"42"`, float64(42), true},
+	} {
+		ok, err := match(tc.operator, tc.value, tc.email)
+		assert.NoError(t, err, tc)
+		assert.Equal(t, tc.want, ok, tc)
+	}
+
+	t.Run("invalid entity context value", func(t *testing.T) {
+		_, err := match(models.ConstraintOperatorENDSWITH, `"@example.com"`, []any{"jane@example.com"})
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid values", func(t *testing.T) {
+		for _, tc := range []struct {
+			operator string
+			value    string
+		}{
+			{models.ConstraintOperatorSTARTSWITH, `""`},
+			{models.ConstraintOperatorENDSWITH, `[]`},
+			{models.ConstraintOperatorENDSWITH, `["@example.com", 1]`},
+			{models.ConstraintOperatorCONTAINSIGNORECASE, `"\q"`},
+			{models.ConstraintOperatorINIGNORECASE, `"CA"`},
+			{models.ConstraintOperatorINIGNORECASE, `["CA"`},
+		} {
+			c := Constraint{Property: "email", Operator: tc.operator, Value: tc.value}
+			m, err := c.ToMatcher()
+			assert.Error(t, err, tc)
+			assert.Nil(t, m)
+		}
+	})
+}
+
+func TestResolvedProperties(t *testing.T) {
+	entityContext := map[string]any{
+		"dl_state": "CA",
+		"device":   map[string]any{"os": "ios"},
+		"items":    []any{map[string]any{"sku": "A-1"}},
+	}
+
+	t.Run("expression", func(t *testing.T) {
+		cs := ConstraintArray{
+			{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"NY"`},
+			{Property: "device.os", Operator: models.ConstraintOperatorEQ, Value: `"ios"`},
+			{Property: "device.model", Operator: models.ConstraintOperatorEQ, Value: `"pixel"`},
+		}
+		expr, err := cs.ToExpr()
+		assert.NoError(t, err)
+		assert.Equal(t,
+			`dl_state="CA", device.os="ios", device.model=<key "model" not found traversing device>`,
+			ResolvedProperties(expr, entityContext))
+	})
+
+	t.Run("matcher", func(t *testing.T) {
+		c := Constraint{Property: "items[0].sku", Operator: models.ConstraintOperatorSTARTSWITH, Value: `"B-"`}
+		m, err := c.ToMatcher()
+		assert.NoError(t, err)
+		assert.Equal(t, `items[0].sku="A-1"`, ResolvedProperties(m, entityContext))
+	})
+}
+
+func TestConstraintMatch(t *testing.T) {
+	entityContext := map[string]any{"dl_state": "CA", "device": map[string]any{"os": "ios"}}
+
+	for _, tc := range []struct {
+		c        Constraint
+		resolved any
+		match    bool
+		err      bool
+	}{
+		{Constraint{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`}, "CA", true, false},
+		{Constraint{Property: "dl_state", Operator: models.ConstraintOperatorNEQ, Value: `"CA"`}, "CA", false, false},
+		{Constraint{Property: "device.os", Operator: models.ConstraintOperatorSTARTSWITH, Value: `"io"`}, "ios", true, false},
+		{Constraint{Property: "device.model", Operator: models.ConstraintOperatorEQ, Value: `"pixel"`}, nil, false, true},
+	} {
+		v, err := tc.c.ResolveProperty(entityContext)
+		assert.Equal(t, tc.resolved, v, "%s", tc.c.Property)
+		assert.Equal(t, tc.err, err != nil, "%s", tc.c.Property)
+
+		match, err := tc.c.Match(entityContext)
+		assert.Equal(t, tc.match, match, "%s %s", tc.c.Property, tc.c.Operator)
+		assert.Equal(t, tc.err, err != nil, "%s %s", tc.c.Property, tc.c.Operator)
+	}
+}
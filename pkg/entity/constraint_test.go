@@ -104,6 +104,46 @@ func TestConstraintValidate(t *testing.T) {
 		}
 		assert.NoError(t, c.Validate())
 	})
+
+	t.Run("semver operator", func(t *testing.T) {
+		c := Constraint{
+			Property: "app_version",
+			Operator: models.ConstraintOperatorSEMVERGTE,
+			Value:    `"1.2.0-beta.1"`,
+		}
+		assert.NoError(t, c.Validate())
+
+		c.Value = `"1.2.x"`
+		assert.Error(t, c.Validate())
+	})
+}
+
+func TestConstraintArrayGroups(t *testing.T) {
+	t.Run("empty case", func(t *testing.T) {
+		assert.Nil(t, ConstraintArray{}.Groups())
+	})
+
+	t.Run("ordered by group number, keeps order within group", func(t *testing.T) {
+		cs := ConstraintArray{
+			{Property: "a", Group: 2},
+			{Property: "b", Group: 0},
+			{Property: "c", Group: 2},
+			{Property: "d", Group: 1},
+			{Property: "e", Group: 0},
+		}
+		groups := cs.Groups()
+		assert.Len(t, groups, 3)
+		properties := func(cs ConstraintArray) []string {
+			ret := []string{}
+			for _, c := range cs {
+				ret = append(ret, c.Property)
+			}
+			return ret
+		}
+		assert.Equal(t, []string{"b", "e"}, properties(groups[0]))
+		assert.Equal(t, []string{"d"}, properties(groups[1]))
+		assert.Equal(t, []string{"a", "c"}, properties(groups[2]))
+	})
 }
 
 func TestConstraintArray(t *testing.T) {
@@ -3,7 +3,7 @@ package entity
 import "time"
 
 // HourlyEvent represents one aggregate row of evaluation counts per hour.
-// The natural key is (flag_id, variant_id, segment_id, bucket_hour).
+// The natural key is (flag_id, variant_id, segment_id, holdout_id, bucket_hour).
 // A surrogate auto-increment PK allows adding columns later without table rebuild.
 type HourlyEvent struct {
 	ID         uint      `gorm:"primaryKey;autoIncrement"`
@@ -11,6 +11,7 @@ type HourlyEvent struct {
 	BucketHour time.Time `gorm:"not null;type:datetime(3);uniqueIndex:idx_datar_hourly,priority:2"`
 	VariantID  int64     `gorm:"not null;default:0;uniqueIndex:idx_datar_hourly,priority:3"`
 	SegmentID  int64     `gorm:"not null;default:0;uniqueIndex:idx_datar_hourly,priority:4"`
+	HoldoutID  int64     `gorm:"not null;default:0;uniqueIndex:idx_datar_hourly,priority:5"`
 	EvalCount  int32     `gorm:"not null;default:0"`
 	UpdatedAt  time.Time `gorm:"autoUpdateTime"`
 }
@@ -31,6 +31,14 @@ var AutoMigrateTables = []any{
 	User{},
 	Variant{},
 	Tag{},
+	Audience{},
+	FlagPrerequisite{},
+	FlagTarget{},
+	RolloutSchedule{},
+	Layer{},
+	Holdout{},
+	List{},
+	ListItem{},
 	FlagEntityType{},
 	HourlyEvent{},
 }
@@ -77,7 +85,7 @@ func GetDB() *gorm.DB {
 				logrus.Fatal("failed to connect to db")
 			}
 		}
-		db.AutoMigrate(AutoMigrateTables...)
+		autoMigrate(db)
 		singletonDB = db
 	})
 
@@ -94,11 +102,24 @@ func NewSQLiteDB(filePath string) *gorm.DB {
 		logrus.WithField("err", err).Errorf("failed to connect to db:%s", filePath)
 		panic(err)
 	}
-	db.AutoMigrate(AutoMigrateTables...)
+	autoMigrate(db)
 
 	return db
 }
 
+// autoMigrate migrates the AutoMigrateTables. Unique indexes that gained a
+// column are dropped first, AutoMigrate doesn't alter existing indexes but
+// recreates the missing ones.
+func autoMigrate(db *gorm.DB) {
+	m := db.Migrator()
+	if m.HasTable(&HourlyEvent{}) && !m.HasColumn(&HourlyEvent{}, "holdout_id") && m.HasIndex(&HourlyEvent{}, "idx_datar_hourly") {
+		if err := m.DropIndex(&HourlyEvent{}, "idx_datar_hourly"); err != nil {
+			logrus.WithField("err", err).Error("failed to drop index idx_datar_hourly")
+		}
+	}
+	db.AutoMigrate(AutoMigrateTables...)
+}
+
 // NewTestDB creates a new test db
 func NewTestDB() *gorm.DB {
 	return NewSQLiteDB(":memory:")
@@ -45,3 +45,67 @@ func TestGetDB(t *testing.T) {
 	db := GetDB()
 	assert.NotNil(t, db)
 }
+
+func TestAutoMigrateHourlyEventsHoldout(t *testing.T) {
+	db := NewTestDB()
+	m := db.Migrator()
+
+	// the table as it was before the holdout_id column
+	assert.NoError(t, m.DropTable(&HourlyEvent{}))
+	assert.NoError(t, db.Exec(`CREATE TABLE datar_hourly_events (
+		id integer PRIMARY KEY AUTOINCREMENT,
+		flag_id integer NOT NULL,
+		bucket_hour datetime(3) NOT NULL,
+		variant_id integer NOT NULL DEFAULT 0,
+		segment_id integer NOT NULL DEFAULT 0,
+		eval_count integer NOT NULL DEFAULT 0,
+		updated_at datetime
+	)`).Error)
+	assert.NoError(t, db.Exec(`CREATE UNIQUE INDEX idx_datar_hourly ON datar_hourly_events (flag_id, bucket_hour, variant_id, segment_id)`).Error)
+	assert.NoError(t, db.Exec(`INSERT INTO datar_hourly_events (flag_id, bucket_hour, eval_count) VALUES (1, '2026-01-01 00:00:00', 5)`).Error)
+
+	autoMigrate(db)
+
+	assert.True(t, m.HasColumn(&HourlyEvent{}, "holdout_id"))
+	assert.True(t, m.HasIndex(&HourlyEvent{}, "idx_datar_hourly"))
+	assert.NoError(t, db.Exec(`INSERT INTO datar_hourly_events (flag_id, bucket_hour, holdout_id, eval_count) VALUES (1, '2026-01-01 00:00:00', 1, 3)`).Error)
+
+	var count int64
+	db.Model(&HourlyEvent{}).Count(&count)
+	assert.Equal(t, int64(2), count)
+}
+
+func TestAutoMigrateFractionalPercents(t *testing.T) {
+	db := NewTestDB()
+	m := db.Migrator()
+
+	// the tables as they were with whole percents
+	assert.NoError(t, m.DropTable(&Distribution{}, &Segment{}))
+	assert.NoError(t, db.Exec("CREATE TABLE `segments` (`id` integer,`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,`flag_id` integer,`description` text,`rank` integer,`rollout_percent` integer,`activation_window` text,PRIMARY KEY (`id`))").Error)
+	assert.NoError(t, db.Exec("CREATE TABLE `distributions` (`id` integer,`created_at` datetime,`updated_at` datetime,`deleted_at` datetime,`segment_id` integer,`variant_id` integer,`variant_key` text,`percent` integer,`bitmap` text,PRIMARY KEY (`id`))").Error)
+	assert.NoError(t, db.Exec("INSERT INTO segments (id, flag_id, rollout_percent) VALUES (1, 1, 20)").Error)
+	assert.NoError(t, db.Exec("INSERT INTO distributions (id, segment_id, variant_id, percent) VALUES (1, 1, 1, 50)").Error)
+
+	autoMigrate(db)
+
+	for table, column := range map[any]string{&Segment{}: "rollout_percent", &Distribution{}: "percent"} {
+		cts, err := m.ColumnTypes(table)
+		assert.NoError(t, err)
+		for _, ct := range cts {
+			if ct.Name() == column {
+				assert.Equal(t, "real", ct.DatabaseTypeName())
+			}
+		}
+	}
+
+	s := &Segment{}
+	assert.NoError(t, db.First(s, 1).Error)
+	assert.Equal(t, float64(20), s.RolloutPercent)
+	d := &Distribution{}
+	assert.NoError(t, db.First(d, 1).Error)
+	assert.Equal(t, float64(50), d.Percent)
+
+	assert.NoError(t, db.Model(d).Update("percent", 33.3).Error)
+	assert.NoError(t, db.First(d, 1).Error)
+	assert.Equal(t, 33.3, d.Percent)
+}
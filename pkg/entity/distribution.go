@@ -3,6 +3,7 @@ package entity
 import (
 	"fmt"
 	"hash/crc32"
+	"math"
 	"sort"
 
 	"gorm.io/gorm"
@@ -15,6 +16,9 @@ const (
 
 	// PercentMultiplier implies that the multiplier between percentage (100) and TotalBucketNum
 	PercentMultiplier uint = TotalBucketNum / uint(100)
+
+	// PercentPrecision is the smallest step of a percent, which is one bucket
+	PercentPrecision float64 = 100 / float64(TotalBucketNum)
 )
 
 // Distribution is the struct represents distribution under segment and links to variant
@@ -24,8 +28,28 @@ type Distribution struct {
 	VariantID  uint `gorm:"index:idx_distribution_variantid"`
 	VariantKey string
 
-	Percent uint   // Percent is an uint from 0 to 100, percent is always derived from Bitmap
-	Bitmap  string `gorm:"type:text" json:"-"`
+	Percent float64 // Percent is from 0 to 100 in steps of PercentPrecision, percent is always derived from Bitmap
+	Bitmap  string  `gorm:"type:text" json:"-"`
+}
+
+// PercentBuckets returns the number of buckets the percent stands for
+func PercentBuckets(percent float64) uint {
+	if percent <= 0 {
+		return 0
+	}
+	return uint(math.Round(percent * float64(PercentMultiplier)))
+}
+
+// ValidatePercent checks that the percent is from 0 to 100 and a multiple of
+// PercentPrecision, so that it's a whole number of buckets
+func ValidatePercent(percent float64) error {
+	if math.IsNaN(percent) || percent < 0 || percent > 100 {
+		return fmt.Errorf("percent %v out of range (0-100)", percent)
+	}
+	if math.Abs(percent*float64(PercentMultiplier)-float64(PercentBuckets(percent))) > 1e-6 {
+		return fmt.Errorf("percent %v is not a multiple of %v", percent, PercentPrecision)
+	}
+	return nil
 }
 
 // DistributionArray is useful for faster evaluation
@@ -39,16 +63,16 @@ type DistributionDebugLog struct {
 	BucketNum         uint
 	DistributionArray DistributionArray
 	VariantID         uint
-	RolloutPercent    uint
+	RolloutPercent    float64
 }
 
 // Rollout rolls out the entity based on the rolloutPercent
-func (d DistributionArray) Rollout(entityID string, salt string, rolloutPercent uint) (variantID *uint, msg string) {
+func (d DistributionArray) Rollout(entityID string, salt string, rolloutPercent float64) (variantID *uint, msg string) {
 	if entityID == "" {
 		return nil, "rollout no. empty entityID"
 	}
 
-	if rolloutPercent == uint(0) {
+	if PercentBuckets(rolloutPercent) == 0 {
 		return nil, "rollout no. 0% rolloutPercent"
 	}
 
@@ -76,11 +100,15 @@ func (d DistributionArray) bucketByNum(bucketNum uint) (variantID uint, index in
 	return d.VariantIDs[index], index
 }
 
-func (d DistributionArray) rollout(bucketNum uint, rolloutPercent uint, index int) bool {
-	if rolloutPercent == uint(0) {
+// rollout includes the first rolloutPercent of the buckets of the variant band
+// the bucketNum falls into. The comparison is made in buckets, so that a
+// fractional rolloutPercent is as exact as a whole one.
+func (d DistributionArray) rollout(bucketNum uint, rolloutPercent float64, index int) bool {
+	rolloutBuckets := PercentBuckets(rolloutPercent)
+	if rolloutBuckets == uint(0) {
 		return false
 	}
-	if rolloutPercent == uint(100) {
+	if rolloutBuckets >= TotalBucketNum {
 		return true
 	}
 
@@ -93,7 +121,12 @@ func (d DistributionArray) rollout(bucketNum uint, rolloutPercent uint, index in
 	if max-min-1 > 0 {
 		r = max - min - 1
 	}
-	return 100*(bucketNum-uint(min)) <= uint(r)*rolloutPercent
+	return TotalBucketNum*(bucketNum-uint(min)) <= uint(r)*rolloutBuckets
+}
+
+// BucketNum returns the bucket the entity falls into, out of TotalBucketNum
+func BucketNum(entityID string, salt string) uint {
+	return crc32Num(entityID, salt)
 }
 
 func crc32Num(entityID string, salt string) uint {
@@ -118,24 +118,47 @@ func TestRollout(t *testing.T) {
 		VariantIDs:          []uint{1111, 2222},
 		PercentsAccumulated: []int{500, 1000},
 	}
-	assert.Equal(t, d.rollout(uint(0), uint(100), 0), true)
-	assert.Equal(t, d.rollout(uint(0), uint(50), 0), true)
-	assert.Equal(t, d.rollout(uint(0), uint(1), 0), true)
-	assert.Equal(t, d.rollout(uint(0), uint(0), 0), false)
-
-	assert.Equal(t, d.rollout(uint(0), uint(50), 0), true)
-	assert.Equal(t, d.rollout(uint(249), uint(50), 0), true)
-	assert.Equal(t, d.rollout(uint(250), uint(50), 0), false)
-	assert.Equal(t, d.rollout(uint(499), uint(50), 0), false)
-
-	assert.Equal(t, d.rollout(uint(500), uint(50), 1), true)
-	assert.Equal(t, d.rollout(uint(749), uint(50), 1), true)
-	assert.Equal(t, d.rollout(uint(750), uint(50), 1), false)
-	assert.Equal(t, d.rollout(uint(999), uint(50), 1), false)
-
-	assert.Equal(t, d.rollout(uint(0), uint(34), 0), true)
-	assert.Equal(t, d.rollout(uint(500*0.34-1), uint(34), 0), true)
-	assert.Equal(t, d.rollout(uint(500*0.34), uint(34), 0), false)
+	assert.Equal(t, d.rollout(uint(0), float64(100), 0), true)
+	assert.Equal(t, d.rollout(uint(0), float64(50), 0), true)
+	assert.Equal(t, d.rollout(uint(0), float64(1), 0), true)
+	assert.Equal(t, d.rollout(uint(0), float64(0), 0), false)
+
+	assert.Equal(t, d.rollout(uint(0), float64(50), 0), true)
+	assert.Equal(t, d.rollout(uint(249), float64(50), 0), true)
+	assert.Equal(t, d.rollout(uint(250), float64(50), 0), false)
+	assert.Equal(t, d.rollout(uint(499), float64(50), 0), false)
+
+	assert.Equal(t, d.rollout(uint(500), float64(50), 1), true)
+	assert.Equal(t, d.rollout(uint(749), float64(50), 1), true)
+	assert.Equal(t, d.rollout(uint(750), float64(50), 1), false)
+	assert.Equal(t, d.rollout(uint(999), float64(50), 1), false)
+
+	assert.Equal(t, d.rollout(uint(0), float64(34), 0), true)
+	assert.Equal(t, d.rollout(uint(500*0.34-1), float64(34), 0), true)
+	assert.Equal(t, d.rollout(uint(500*0.34), float64(34), 0), false)
+
+	assert.Equal(t, d.rollout(uint(2), 0.5, 0), true)
+	assert.Equal(t, d.rollout(uint(3), 0.5, 0), false)
+	assert.Equal(t, d.rollout(uint(0), 0.04, 0), false)
+}
+
+func TestPercentBuckets(t *testing.T) {
+	assert.Equal(t, uint(0), PercentBuckets(0))
+	assert.Equal(t, uint(0), PercentBuckets(-1))
+	assert.Equal(t, uint(5), PercentBuckets(0.5))
+	assert.Equal(t, uint(333), PercentBuckets(33.3))
+	assert.Equal(t, uint(334), PercentBuckets(33.4))
+	assert.Equal(t, uint(1000), PercentBuckets(100))
+}
+
+func TestValidatePercent(t *testing.T) {
+	for _, p := range []float64{0, 0.1, 0.5, 33.3, 50, 99.9, 100} {
+		assert.NoError(t, ValidatePercent(p), p)
+	}
+	assert.EqualError(t, ValidatePercent(-0.1), "percent -0.1 out of range (0-100)")
+	assert.EqualError(t, ValidatePercent(100.1), "percent 100.1 out of range (0-100)")
+	assert.EqualError(t, ValidatePercent(0.05), "percent 0.05 is not a multiple of 0.1")
+	assert.EqualError(t, ValidatePercent(33.33), "percent 33.33 is not a multiple of 0.1")
 }
 
 func TestRolloutWithEntity(t *testing.T) {
@@ -147,19 +170,19 @@ func TestRolloutWithEntity(t *testing.T) {
 		var vID *uint
 		var msg string
 
-		vID, msg = d.Rollout("", "salt", uint(0))
+		vID, msg = d.Rollout("", "salt", float64(0))
 		assert.Nil(t, vID)
 		assert.Contains(t, msg, "no")
 
-		vID, msg = d.Rollout("entity123", "salt", uint(0))
+		vID, msg = d.Rollout("entity123", "salt", float64(0))
 		assert.Nil(t, vID)
 		assert.Contains(t, msg, "no")
 
-		vID, msg = d.Rollout("entity123", "salt", uint(100))
+		vID, msg = d.Rollout("entity123", "salt", float64(100))
 		assert.NotNil(t, vID)
 		assert.Contains(t, msg, "yes")
 
-		vID, msg = d.Rollout("entity123", "salt", uint(1))
+		vID, msg = d.Rollout("entity123", "salt", float64(1))
 		assert.Nil(t, vID)
 		assert.Contains(t, msg, "no")
 	})
@@ -172,7 +195,7 @@ func TestRolloutWithEntity(t *testing.T) {
 		var vID *uint
 		var msg string
 
-		vID, msg = d.Rollout("entity123", "salt", uint(100))
+		vID, msg = d.Rollout("entity123", "salt", float64(100))
 		assert.Nil(t, vID)
 		assert.Contains(t, msg, "no")
 	})
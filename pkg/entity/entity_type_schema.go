@@ -0,0 +1,246 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/foxdalas/flagr/swagger_gen/models"
+
+	"github.com/spf13/cast"
+)
+
+// EntityAttribute is an attribute of the entity context of an entity type.
+// Name is a property like the property of a constraint, e.g. `device.os`.
+type EntityAttribute struct {
+	Name          string
+	Type          string `json:",omitempty"` // one of the models.EntityAttributeType, any type if empty
+	Required      bool   `json:",omitempty"`
+	AllowedValues []any  `json:",omitempty"`
+}
+
+// EntityAttributes is the declared schema of the entity context of an entity
+// type. An entity type without attributes accepts any entity context.
+type EntityAttributes []EntityAttribute
+
+// Scan implements scanner interface
+func (as *EntityAttributes) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+	str := cast.ToString(value)
+	if err := json.Unmarshal([]byte(str), as); err != nil {
+		return fmt.Errorf("cannot scan %v into EntityAttributes type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (as EntityAttributes) Value() (driver.Value, error) {
+	if len(as) == 0 {
+		return nil, nil
+	}
+	bytes, err := json.Marshal(as)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// Validate validates the names, the types and the allowed values of the
+// attributes
+func (as EntityAttributes) Validate() error {
+	names := make(map[string]bool, len(as))
+	for i, a := range as {
+		if a.Name == "" {
+			return fmt.Errorf("attribute[%d]: empty name", i)
+		}
+		ref, err := parsePropertyRef(a.Name)
+		if err != nil {
+			return fmt.Errorf("attribute %q: %s", a.Name, err)
+		}
+		if names[ref.String()] {
+			return fmt.Errorf("attribute %q is declared more than once", a.Name)
+		}
+		names[ref.String()] = true
+
+		if a.Type != "" && !slices.Contains(entityAttributeTypes, a.Type) {
+			return fmt.Errorf("attribute %q: invalid type %q, valid types are %v", a.Name, a.Type, entityAttributeTypes)
+		}
+		for _, v := range a.AllowedValues {
+			if !a.hasType(v) {
+				return fmt.Errorf("attribute %q: allowed value %v is not a %s", a.Name, v, a.Type)
+			}
+		}
+	}
+	return nil
+}
+
+var entityAttributeTypes = []string{
+	models.EntityAttributeTypeString,
+	models.EntityAttributeTypeNumber,
+	models.EntityAttributeTypeBoolean,
+	models.EntityAttributeTypeObject,
+	models.EntityAttributeTypeArray,
+}
+
+// ValidateConstraint validates that the property of the constraint is an
+// attribute, or is nested in an object or array attribute, and that the
+// values of EQ, NEQ, IN and NOT_IN constraints are allowed values
+func (as EntityAttributes) ValidateConstraint(c Constraint) error {
+	if len(as) == 0 {
+		return nil
+	}
+	ref, err := parsePropertyRef(c.Property)
+	if err != nil {
+		return err
+	}
+	a, ok := as.lookup(ref)
+	if !ok {
+		return fmt.Errorf("property %q is not a declared attribute, the attributes are %s", c.Property, as)
+	}
+	if len(a.AllowedValues) == 0 || len(ref.steps) != len(a.ref().steps) {
+		return nil
+	}
+
+	var values []any
+	switch c.Operator {
+	case models.ConstraintOperatorEQ, models.ConstraintOperatorNEQ:
+		var v any
+		if err := json.Unmarshal([]byte(c.Value), &v); err != nil {
+			return nil
+		}
+		values = []any{v}
+	case models.ConstraintOperatorIN, models.ConstraintOperatorNOTIN:
+		if err := json.Unmarshal([]byte(c.Value), &values); err != nil {
+			return nil
+		}
+	}
+	for _, v := range values {
+		if !a.allows(v) {
+			return fmt.Errorf("value %s of property %q is not an allowed value %s", jsonString(v), c.Property, jsonString(a.AllowedValues))
+		}
+	}
+	return nil
+}
+
+// ValidateEntityContext validates that the entity context has the required
+// attributes, that its attributes have the declared types and allowed values,
+// and that it has no undeclared attribute, which is usually a misspelling
+func (as EntityAttributes) ValidateEntityContext(entityContext any) error {
+	if len(as) == 0 {
+		return nil
+	}
+	m, ok := entityContext.(map[string]any)
+	if !ok && entityContext != nil {
+		return fmt.Errorf("entity context is not an object")
+	}
+
+	var violations []string
+	for _, a := range as {
+		v, err := a.ref().resolve(m)
+		if err != nil {
+			if a.Required {
+				violations = append(violations, fmt.Sprintf("missing required attribute %q", a.Name))
+			}
+			continue
+		}
+		if !a.hasType(v) {
+			violations = append(violations, fmt.Sprintf("attribute %q is not a %s", a.Name, a.Type))
+			continue
+		}
+		if len(a.AllowedValues) != 0 && !a.allows(v) {
+			violations = append(violations, fmt.Sprintf("value %s of attribute %q is not an allowed value %s", jsonString(v), a.Name, jsonString(a.AllowedValues)))
+		}
+	}
+	for _, k := range slices.Sorted(maps.Keys(m)) {
+		if !as.hasRoot(k) {
+			violations = append(violations, fmt.Sprintf("undeclared attribute %q", k))
+		}
+	}
+
+	if len(violations) != 0 {
+		return fmt.Errorf("entity context does not conform to the attributes of the entity type: %s", strings.Join(violations, "; "))
+	}
+	return nil
+}
+
+// String returns the names of the attributes
+func (as EntityAttributes) String() string {
+	names := make([]string, 0, len(as))
+	for _, a := range as {
+		names = append(names, a.Name)
+	}
+	return fmt.Sprintf("%v", names)
+}
+
+// lookup returns the attribute of the property: the attribute with the same
+// path, or an object or array attribute that the property is nested in
+func (as EntityAttributes) lookup(ref propertyRef) (EntityAttribute, bool) {
+	for _, a := range as {
+		aref := a.ref()
+		if aref.root != ref.root || len(aref.steps) > len(ref.steps) || !slices.Equal(aref.steps, ref.steps[:len(aref.steps)]) {
+			continue
+		}
+		if len(aref.steps) == len(ref.steps) || a.Type == "" || a.Type == models.EntityAttributeTypeObject || a.Type == models.EntityAttributeTypeArray {
+			return a, true
+		}
+	}
+	return EntityAttribute{}, false
+}
+
+// hasRoot reports whether the root key is an attribute, or has attributes
+// nested in it
+func (as EntityAttributes) hasRoot(root string) bool {
+	return slices.ContainsFunc(as, func(a EntityAttribute) bool { return a.ref().root == root })
+}
+
+func (a EntityAttribute) ref() propertyRef {
+	ref, err := parsePropertyRef(a.Name)
+	if err != nil {
+		return propertyRef{root: a.Name}
+	}
+	return ref
+}
+
+func (a EntityAttribute) hasType(v any) bool {
+	switch a.Type {
+	case models.EntityAttributeTypeString:
+		_, ok := v.(string)
+		return ok
+	case models.EntityAttributeTypeNumber:
+		switch v.(type) {
+		case float64, float32, int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64, json.Number:
+			return true
+		}
+		return false
+	case models.EntityAttributeTypeBoolean:
+		_, ok := v.(bool)
+		return ok
+	case models.EntityAttributeTypeObject:
+		_, ok := v.(map[string]any)
+		return ok
+	case models.EntityAttributeTypeArray:
+		_, ok := v.([]any)
+		return ok
+	}
+	return true
+}
+
+// allows reports whether v is one of the allowed values, comparing them as
+// JSON so that numbers of different types are equal
+func (a EntityAttribute) allows(v any) bool {
+	s := jsonString(v)
+	return slices.ContainsFunc(a.AllowedValues, func(av any) bool { return jsonString(av) == s })
+}
+
+func jsonString(v any) string {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Sprintf("%v", v)
+	}
+	return string(b)
+}
@@ -0,0 +1,97 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/foxdalas/flagr/swagger_gen/models"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func genFixtureEntityAttributes() EntityAttributes {
+	return EntityAttributes{
+		{Name: "userId", Type: models.EntityAttributeTypeString, Required: true},
+		{Name: "state", Type: models.EntityAttributeTypeString, AllowedValues: []any{"CA", "NY"}},
+		{Name: "age", Type: models.EntityAttributeTypeNumber},
+		{Name: "device.os", Type: models.EntityAttributeTypeString},
+		{Name: "tags", Type: models.EntityAttributeTypeArray},
+		{Name: "extra"},
+	}
+}
+
+func TestEntityAttributesValidate(t *testing.T) {
+	assert.NoError(t, EntityAttributes(nil).Validate())
+	assert.NoError(t, genFixtureEntityAttributes().Validate())
+
+	assert.Error(t, EntityAttributes{{Name: ""}}.Validate())
+	assert.Error(t, EntityAttributes{{Name: "a b"}}.Validate())
+	assert.Error(t, EntityAttributes{{Name: "state"}, {Name: "state"}}.Validate())
+	assert.Error(t, EntityAttributes{{Name: "state", Type: "text"}}.Validate())
+	assert.Error(t, EntityAttributes{{Name: "age", Type: models.EntityAttributeTypeNumber, AllowedValues: []any{"1"}}}.Validate())
+}
+
+func TestEntityAttributesScanValue(t *testing.T) {
+	v, err := EntityAttributes(nil).Value()
+	assert.NoError(t, err)
+	assert.Nil(t, v)
+
+	v, err = genFixtureEntityAttributes().Value()
+	assert.NoError(t, err)
+	as := EntityAttributes{}
+	assert.NoError(t, as.Scan(v))
+	assert.Equal(t, genFixtureEntityAttributes(), as)
+
+	assert.Error(t, as.Scan("invalid"))
+}
+
+func TestEntityAttributesValidateConstraint(t *testing.T) {
+	as := genFixtureEntityAttributes()
+	constraint := func(property, operator, value string) Constraint {
+		return Constraint{Property: property, Operator: operator, Value: value}
+	}
+
+	assert.NoError(t, EntityAttributes(nil).ValidateConstraint(constraint("uid", models.ConstraintOperatorEQ, `"1"`)))
+	assert.NoError(t, as.ValidateConstraint(constraint("userId", models.ConstraintOperatorEQ, `"1"`)))
+	assert.NoError(t, as.ValidateConstraint(constraint("state", models.ConstraintOperatorIN, `["CA", "NY"]`)))
+	assert.NoError(t, as.ValidateConstraint(constraint("state", models.ConstraintOperatorSTARTSWITH, `"C"`)))
+	assert.NoError(t, as.ValidateConstraint(constraint("device.os", models.ConstraintOperatorEQ, `"ios"`)))
+	assert.NoError(t, as.ValidateConstraint(constraint("tags[0]", models.ConstraintOperatorEQ, `"beta"`)))
+	assert.NoError(t, as.ValidateConstraint(constraint("extra.anything", models.ConstraintOperatorEQ, `"x"`)))
+
+	assert.Error(t, as.ValidateConstraint(constraint("uid", models.ConstraintOperatorEQ, `"1"`)))
+	assert.Error(t, as.ValidateConstraint(constraint("device.osVersion", models.ConstraintOperatorEQ, `"17"`)))
+	assert.Error(t, as.ValidateConstraint(constraint("userId.name", models.ConstraintOperatorEQ, `"x"`)))
+	assert.Error(t, as.ValidateConstraint(constraint("state", models.ConstraintOperatorEQ, `"TX"`)))
+	assert.Error(t, as.ValidateConstraint(constraint("state", models.ConstraintOperatorNOTIN, `["CA", "TX"]`)))
+}
+
+func TestEntityAttributesValidateEntityContext(t *testing.T) {
+	as := genFixtureEntityAttributes()
+
+	assert.NoError(t, EntityAttributes(nil).ValidateEntityContext(map[string]any{"uid": "1"}))
+	assert.NoError(t, as.ValidateEntityContext(map[string]any{"userId": "1"}))
+	assert.NoError(t, as.ValidateEntityContext(map[string]any{
+		"userId": "1",
+		"state":  "CA",
+		"age":    float64(42),
+		"device": map[string]any{"os": "ios"},
+		"tags":   []any{"beta"},
+		"extra":  true,
+	}))
+
+	for name, entityContext := range map[string]any{
+		"not an object":      "userId",
+		"missing required":   map[string]any{"state": "CA"},
+		"misspelled":         map[string]any{"userId": "1", "uid": "1"},
+		"wrong type":         map[string]any{"userId": "1", "age": "42"},
+		"nested wrong type":  map[string]any{"userId": "1", "device": map[string]any{"os": 17}},
+		"not allowed value":  map[string]any{"userId": "1", "state": "TX"},
+		"nil entity context": nil,
+	} {
+		assert.Error(t, as.ValidateEntityContext(entityContext), name)
+	}
+
+	err := as.ValidateEntityContext(map[string]any{"uid": "1", "state": "TX"})
+	assert.EqualError(t, err, `entity context does not conform to the attributes of the entity type: `+
+		`missing required attribute "userId"; value "TX" of attribute "state" is not an allowed value ["CA","NY"]; undeclared attribute "uid"`)
+}
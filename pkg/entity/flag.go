@@ -22,8 +22,27 @@ type Flag struct {
 	SnapshotID  uint
 	Notes       string `gorm:"type:text"`
 
+	Prerequisites    []FlagPrerequisite
+	Targets          []FlagTarget
+	ActivationWindow *ActivationWindow `gorm:"type:text"`
+
+	LayerID        *uint `gorm:"index:idx_flag_layerid"`
+	Layer          *Layer
+	LayerSlotStart uint // first slot of the layer owned by the flag
+	LayerSlotEnd   uint // the slot after the last one owned by the flag
+
+	HoldoutID        *uint `gorm:"index:idx_flag_holdoutid"`
+	Holdout          *Holdout
+	HoldoutVariantID uint // variant of the entities in the holdout, required, not the DefaultVariantID
+
+	DefaultVariantID uint // variant returned when the flag is disabled or no segment matched, 0 for none
+
+	AttachmentSchema AttachmentSchema `gorm:"type:text"` // JSON Schema of the attachments of the variants
+
 	DataRecordsEnabled bool
 	EntityType         string
+	BucketBy           string // EntityContext attribute hashed instead of the EntityID, see BucketKey
+	Salt               string `gorm:"type:varchar(64)"` // salt of the bucketing hash, the flag ID if empty
 
 	FlagEvaluation FlagEvaluation `gorm:"-" json:"-"`
 }
@@ -31,6 +50,9 @@ type Flag struct {
 // FlagEvaluation is a struct that holds the necessary info for evaluation
 type FlagEvaluation struct {
 	VariantsMap map[uint]*Variant
+	TargetsMap  map[string]*Variant // entityID to the variant it's targeted to
+
+	bucketBy *propertyRef
 }
 
 // Preloads just the tags
@@ -53,7 +75,15 @@ func PreloadSegmentsVariantsTags(db *gorm.DB) *gorm.DB {
 		}).
 		Preload("Tags", func(db *gorm.DB) *gorm.DB {
 			return db.Order("id")
-		})
+		}).
+		Preload("Prerequisites", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Preload("Targets", func(db *gorm.DB) *gorm.DB {
+			return db.Order("id")
+		}).
+		Preload("Layer").
+		Preload("Holdout")
 }
 
 // Preload preloads the segments, variants and tags into flags
@@ -70,15 +100,110 @@ func (f *Flag) PreloadTags(db *gorm.DB) error {
 func (f *Flag) PrepareEvaluation() error {
 	f.FlagEvaluation = FlagEvaluation{
 		VariantsMap: make(map[uint]*Variant),
+		TargetsMap:  make(map[string]*Variant, len(f.Targets)),
+	}
+	if f.ActivationWindow != nil {
+		if err := f.ActivationWindow.Validate(); err != nil {
+			return err
+		}
+	}
+	if f.Layer != nil && f.Layer.Slots == 0 {
+		return fmt.Errorf("layer %s has no slots", f.Layer.Key)
+	}
+	if f.BucketBy != "" {
+		ref, err := parsePropertyRef(f.BucketBy)
+		if err != nil {
+			return fmt.Errorf("invalid BucketBy: %w", err)
+		}
+		f.FlagEvaluation.bucketBy = &ref
 	}
 	for i := range f.Segments {
 		if err := f.Segments[i].PrepareEvaluation(); err != nil {
 			return err
 		}
+		if f.Salt != "" {
+			f.Segments[i].SegmentEvaluation.Salt = f.Salt
+		}
 	}
 	for i := range f.Variants {
 		f.FlagEvaluation.VariantsMap[f.Variants[i].ID] = &f.Variants[i]
 	}
+	if f.Holdout != nil && f.FlagEvaluation.VariantsMap[f.HoldoutVariantID] == nil {
+		return fmt.Errorf("holdout variant %d of holdout %s not found", f.HoldoutVariantID, f.Holdout.Key)
+	}
+	if f.DefaultVariantID != 0 && f.FlagEvaluation.VariantsMap[f.DefaultVariantID] == nil {
+		return fmt.Errorf("default variant %d not found", f.DefaultVariantID)
+	}
+	for _, t := range f.Targets {
+		v := f.FlagEvaluation.VariantsMap[t.VariantID]
+		if v == nil {
+			return fmt.Errorf("variant %d of target %s not found", t.VariantID, t.EntityID)
+		}
+		f.FlagEvaluation.TargetsMap[t.EntityID] = v
+	}
+	return nil
+}
+
+// BucketKey resolves the BucketBy attribute of the flag in the entity
+// context into the value that is hashed instead of the entity ID. It returns
+// an error if the attribute is missing or not a string or a number.
+func (f *Flag) BucketKey(entityContext map[string]any) (string, error) {
+	ref := f.FlagEvaluation.bucketBy
+	if ref == nil {
+		r, err := parsePropertyRef(f.BucketBy)
+		if err != nil {
+			return "", err
+		}
+		ref = &r
+	}
+	v, err := ref.resolve(entityContext)
+	if err != nil {
+		return "", err
+	}
+	key, err := stringValue(v)
+	if err != nil {
+		return "", err
+	}
+	if key == "" {
+		return "", fmt.Errorf("empty value of %s", f.BucketBy)
+	}
+	return key, nil
+}
+
+// LayerSlot hashes the entity into the layer of the flag, and reports whether
+// the flag owns the slot. A flag without a layer owns every slot.
+func (f *Flag) LayerSlot(entityID string) (slot uint, ok bool) {
+	if f.Layer == nil {
+		return 0, true
+	}
+	slot = f.Layer.Slot(entityID)
+	return slot, slot >= f.LayerSlotStart && slot < f.LayerSlotEnd
+}
+
+// InHoldout reports whether the entity is in the holdout of the flag
+func (f *Flag) InHoldout(entityID string) bool {
+	return f.Holdout != nil && f.Holdout.Contains(entityID)
+}
+
+// ValidateBucketBy validates the BucketBy attribute of a flag
+func ValidateBucketBy(bucketBy string) error {
+	if bucketBy == "" {
+		return nil
+	}
+	if _, err := parsePropertyRef(bucketBy); err != nil {
+		return fmt.Errorf("invalid BucketBy: %w", err)
+	}
+	return nil
+}
+
+// SaltMaxLength is the max length of the Salt of a flag
+const SaltMaxLength = 64
+
+// ValidateSalt validates the Salt of a flag, which has to fit its column
+func ValidateSalt(salt string) error {
+	if len(salt) > SaltMaxLength {
+		return fmt.Errorf("invalid Salt: longer than %d characters", SaltMaxLength)
+	}
 	return nil
 }
 
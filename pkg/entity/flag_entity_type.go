@@ -6,4 +6,17 @@ import "gorm.io/gorm"
 type FlagEntityType struct {
 	gorm.Model
 	Key string `gorm:"type:varchar(64);uniqueIndex:flag_entity_type_key"`
+
+	// Attributes is the schema of the entity context of the flags of the
+	// entity type, see EntityAttributes
+	Attributes EntityAttributes `gorm:"type:text" json:",omitempty"`
+}
+
+// LoadEntityTypeSchemas loads the entity types that declare attributes
+func LoadEntityTypeSchemas(db *gorm.DB) ([]FlagEntityType, error) {
+	ets := []FlagEntityType{}
+	if err := db.Where("attributes IS NOT NULL").Order("id").Find(&ets).Error; err != nil {
+		return nil, err
+	}
+	return ets, nil
 }
@@ -0,0 +1,105 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// FlagPrerequisite is a flag that has to evaluate to one of the VariantKeys
+// for the same entity before the flag it belongs to is evaluated
+type FlagPrerequisite struct {
+	gorm.Model
+
+	FlagID      uint        `gorm:"index:idx_flagprerequisite_flagid"`
+	FlagKey     string      `gorm:"type:varchar(64)"` // key of the prerequisite flag
+	VariantKeys StringArray `gorm:"type:text"`
+}
+
+// StringArray is a list of strings stored as a JSON array
+type StringArray []string
+
+// Scan implements scanner interface
+func (a *StringArray) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+	s := cast.ToString(value)
+	if err := json.Unmarshal([]byte(s), a); err != nil {
+		return fmt.Errorf("cannot scan %v into StringArray type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (a StringArray) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(a)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// Validate validates the prerequisite without looking up the prerequisite flag
+func (p *FlagPrerequisite) Validate() error {
+	if p.FlagKey == "" {
+		return fmt.Errorf("empty prerequisite flag key")
+	}
+	if len(p.VariantKeys) == 0 {
+		return fmt.Errorf("prerequisite flag %s has no variant keys", p.FlagKey)
+	}
+	return nil
+}
+
+// PrerequisiteCycle finds a cycle in the prerequisites graph, which maps from
+// a flag key to the keys of its prerequisite flags. It returns the flag keys
+// along the cycle, starting and ending with the same key, or nil if there's
+// no cycle.
+func PrerequisiteCycle(deps map[string][]string) []string {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	state := make(map[string]int, len(deps))
+	var path []string
+
+	var visit func(key string) []string
+	visit = func(key string) []string {
+		switch state[key] {
+		case visiting:
+			i := slices.Index(path, key)
+			return append(slices.Clone(path[i:]), key)
+		case visited:
+			return nil
+		}
+
+		state[key] = visiting
+		path = append(path, key)
+		for _, dep := range deps[key] {
+			if cycle := visit(dep); cycle != nil {
+				return cycle
+			}
+		}
+		path = path[:len(path)-1]
+		state[key] = visited
+		return nil
+	}
+
+	// sort the keys so that the same cycle is always reported
+	keys := make([]string, 0, len(deps))
+	for key := range deps {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		if cycle := visit(key); cycle != nil {
+			return cycle
+		}
+	}
+	return nil
+}
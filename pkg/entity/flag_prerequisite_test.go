@@ -0,0 +1,57 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlagPrerequisiteValidate(t *testing.T) {
+	p := FlagPrerequisite{FlagKey: "new_payments_backend", VariantKeys: StringArray{"on"}}
+	assert.NoError(t, p.Validate())
+
+	p.VariantKeys = nil
+	assert.Error(t, p.Validate())
+
+	p = FlagPrerequisite{VariantKeys: StringArray{"on"}}
+	assert.Error(t, p.Validate())
+}
+
+func TestStringArray(t *testing.T) {
+	a := StringArray{"on", "treatment"}
+	v, err := a.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `["on","treatment"]`, v)
+
+	var b StringArray
+	assert.NoError(t, b.Scan(v))
+	assert.Equal(t, a, b)
+	assert.NoError(t, b.Scan(nil))
+	assert.Error(t, b.Scan(`["on"`))
+}
+
+func TestPrerequisiteCycle(t *testing.T) {
+	t.Run("no cycle", func(t *testing.T) {
+		assert.Nil(t, PrerequisiteCycle(nil))
+		assert.Nil(t, PrerequisiteCycle(map[string][]string{
+			"a": {"b", "c"},
+			"b": {"c"},
+			"d": {"a", "unknown"},
+		}))
+	})
+
+	t.Run("self reference", func(t *testing.T) {
+		assert.Equal(t, []string{"a", "a"}, PrerequisiteCycle(map[string][]string{
+			"a": {"a"},
+		}))
+	})
+
+	t.Run("cycle", func(t *testing.T) {
+		assert.Equal(t, []string{"b", "c", "d", "b"}, PrerequisiteCycle(map[string][]string{
+			"a": {"b"},
+			"b": {"c"},
+			"c": {"d"},
+			"d": {"b"},
+		}))
+	})
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+// FlagTarget pins an entity to a variant of the flag. Targets are evaluated
+// before the segments of the flag.
+type FlagTarget struct {
+	gorm.Model
+
+	FlagID     uint   `gorm:"index:idx_flagtarget_flagid"`
+	EntityID   string `gorm:"type:varchar(255)"`
+	VariantID  uint   `gorm:"index:idx_flagtarget_variantid"`
+	VariantKey string
+}
+
+// Validate validates the target without looking up its variant
+func (t *FlagTarget) Validate() error {
+	if t.EntityID == "" {
+		return fmt.Errorf("empty target entityID")
+	}
+	if t.VariantKey == "" {
+		return fmt.Errorf("target %s has no variant key", t.EntityID)
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFlagTargetValidate(t *testing.T) {
+	ft := FlagTarget{EntityID: "qa_account", VariantKey: "treatment"}
+	assert.NoError(t, ft.Validate())
+
+	ft.VariantKey = ""
+	assert.Error(t, ft.Validate())
+
+	ft = FlagTarget{VariantKey: "treatment"}
+	assert.Error(t, ft.Validate())
+}
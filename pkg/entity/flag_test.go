@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -13,6 +14,89 @@ func TestFlagPrepareEvaluation(t *testing.T) {
 		assert.NotNil(t, f.FlagEvaluation.VariantsMap)
 		assert.NotNil(t, f.Tags)
 	})
+
+	t.Run("salt", func(t *testing.T) {
+		f := GenFixtureFlag()
+		assert.NoError(t, f.PrepareEvaluation())
+		assert.Equal(t, "100", f.Segments[0].SegmentEvaluation.Salt)
+
+		f.Salt = "experiment-2"
+		assert.NoError(t, f.PrepareEvaluation())
+		assert.Equal(t, "experiment-2", f.Segments[0].SegmentEvaluation.Salt)
+	})
+
+	t.Run("invalid BucketBy", func(t *testing.T) {
+		f := GenFixtureFlag()
+		f.BucketBy = "company["
+		assert.Error(t, f.PrepareEvaluation())
+	})
+
+	t.Run("layer without slots", func(t *testing.T) {
+		f := GenFixtureFlag()
+		f.Layer = &Layer{Key: "checkout"}
+		assert.EqualError(t, f.PrepareEvaluation(), "layer checkout has no slots")
+	})
+
+	t.Run("unknown holdout variant", func(t *testing.T) {
+		f := GenFixtureFlag()
+		f.Holdout = &Holdout{Key: "global"}
+		f.HoldoutVariantID = 300
+		assert.NoError(t, f.PrepareEvaluation())
+		f.HoldoutVariantID = 999
+		assert.EqualError(t, f.PrepareEvaluation(), "holdout variant 999 of holdout global not found")
+	})
+
+	t.Run("unknown default variant", func(t *testing.T) {
+		f := GenFixtureFlag()
+		f.DefaultVariantID = 301
+		assert.NoError(t, f.PrepareEvaluation())
+		f.DefaultVariantID = 999
+		assert.EqualError(t, f.PrepareEvaluation(), "default variant 999 not found")
+	})
+
+	t.Run("targets", func(t *testing.T) {
+		f := GenFixtureFlag()
+		f.Targets = []FlagTarget{{EntityID: "qa_account", VariantID: 301, VariantKey: "treatment"}}
+		assert.NoError(t, f.PrepareEvaluation())
+		assert.Equal(t, "treatment", f.FlagEvaluation.TargetsMap["qa_account"].Key)
+		assert.Nil(t, f.FlagEvaluation.TargetsMap["user1"])
+
+		f.Targets[0].VariantID = 999
+		assert.EqualError(t, f.PrepareEvaluation(), "variant 999 of target qa_account not found")
+	})
+}
+
+func TestFlagBucketKey(t *testing.T) {
+	f := GenFixtureFlag()
+	f.BucketBy = "company.id"
+	assert.NoError(t, f.PrepareEvaluation())
+
+	key, err := f.BucketKey(map[string]any{"company": map[string]any{"id": "acme"}})
+	assert.NoError(t, err)
+	assert.Equal(t, "acme", key)
+
+	key, err = f.BucketKey(map[string]any{"company": map[string]any{"id": float64(42)}})
+	assert.NoError(t, err)
+	assert.Equal(t, "42", key)
+
+	_, err = f.BucketKey(map[string]any{"company": map[string]any{"id": ""}})
+	assert.Error(t, err)
+	_, err = f.BucketKey(map[string]any{"company": map[string]any{"id": true}})
+	assert.Error(t, err)
+	_, err = f.BucketKey(map[string]any{})
+	assert.Error(t, err)
+	_, err = f.BucketKey(nil)
+	assert.Error(t, err)
+
+	assert.NoError(t, ValidateBucketBy(""))
+	assert.NoError(t, ValidateBucketBy("company_id"))
+	assert.Error(t, ValidateBucketBy("company["))
+}
+
+func TestValidateSalt(t *testing.T) {
+	assert.NoError(t, ValidateSalt(""))
+	assert.NoError(t, ValidateSalt(strings.Repeat("s", SaltMaxLength)))
+	assert.Error(t, ValidateSalt(strings.Repeat("s", SaltMaxLength+1)))
 }
 
 func TestFlagPreload(t *testing.T) {
@@ -0,0 +1,43 @@
+package entity
+
+import (
+	"fmt"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// Holdout is a share of the entities excluded from the experiments of all the
+// flags in it. The entities in the holdout get the holdout variant of every
+// flag, so that the cumulative impact of the experiments can be measured
+// against them.
+type Holdout struct {
+	gorm.Model
+
+	Key         string `gorm:"type:varchar(64);uniqueIndex:idx_holdout_key"`
+	Description string `gorm:"type:text"`
+	Percent     float64 // from 0 to 100 in steps of PercentPrecision
+}
+
+// Contains reports whether the entity is hashed into the holdout. The holdout
+// ID is the salt, prefixed so that it never equals the salt of a flag or a
+// layer with the same ID, and the same entity is in the holdout of every flag.
+func (h *Holdout) Contains(entityID string) bool {
+	salt := "holdout" + strconv.FormatUint(uint64(h.ID), 10)
+	return crc32Num(entityID, salt) < PercentBuckets(h.Percent)
+}
+
+// Validate validates the Percent of the holdout
+func (h *Holdout) Validate() error {
+	if err := ValidatePercent(h.Percent); err != nil {
+		return fmt.Errorf("invalid percent. %w", err)
+	}
+	return nil
+}
+
+// FlagIDs returns the IDs of the flags in the holdout
+func (h *Holdout) FlagIDs(db *gorm.DB) ([]uint, error) {
+	ids := []uint{}
+	err := db.Model(&Flag{}).Where("holdout_id = ?", h.ID).Order("id").Pluck("id", &ids).Error
+	return ids, err
+}
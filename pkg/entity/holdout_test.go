@@ -0,0 +1,67 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHoldoutContains(t *testing.T) {
+	countIn := func(h *Holdout) int {
+		cnt := 0
+		for i := range 10000 {
+			if h.Contains(fmt.Sprintf("user%d", i)) {
+				cnt++
+			}
+		}
+		return cnt
+	}
+
+	h := &Holdout{Percent: 5}
+	h.ID = 1
+	assert.InDelta(t, 500, countIn(h), 100)
+	assert.Equal(t, h.Contains("user1"), h.Contains("user1"))
+
+	assert.Zero(t, countIn(&Holdout{Percent: 0}))
+	assert.Equal(t, 10000, countIn(&Holdout{Percent: 100}))
+
+	h = &Holdout{Percent: 0.5}
+	h.ID = 1
+	assert.InDelta(t, 50, countIn(h), 25)
+}
+
+func TestHoldoutValidate(t *testing.T) {
+	assert.NoError(t, (&Holdout{Percent: 0.5}).Validate())
+	assert.NoError(t, (&Holdout{Percent: 100}).Validate())
+	assert.Error(t, (&Holdout{Percent: 0.25}).Validate())
+	assert.Error(t, (&Holdout{Percent: 101}).Validate())
+}
+
+func TestHoldoutFlagIDs(t *testing.T) {
+	db := NewTestDB()
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+
+	h := Holdout{Key: "global", Percent: 5}
+	assert.NoError(t, db.Create(&h).Error)
+
+	ids, err := h.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+
+	assert.NoError(t, db.Create(&Flag{Key: "flag_1", HoldoutID: &h.ID}).Error)
+	assert.NoError(t, db.Create(&Flag{Key: "flag_2"}).Error)
+	f3 := Flag{Key: "flag_3", HoldoutID: &h.ID}
+	assert.NoError(t, db.Create(&f3).Error)
+	assert.NoError(t, db.Delete(&f3).Error)
+
+	ids, err = h.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1}, ids)
+}
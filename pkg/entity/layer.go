@@ -0,0 +1,90 @@
+package entity
+
+import (
+	"fmt"
+	"hash/crc32"
+	"sort"
+	"strconv"
+
+	"gorm.io/gorm"
+)
+
+// LayerDefaultSlots is the default number of slots of a layer
+const LayerDefaultSlots = uint(1000)
+
+// Layer is a bucket space shared by mutually exclusive flags. Every flag in
+// the layer owns a disjoint range of its slots, and an entity is only
+// evaluated by the flag owning the slot the entity is hashed into.
+type Layer struct {
+	gorm.Model
+
+	Key         string `gorm:"type:varchar(64);uniqueIndex:idx_layer_key"`
+	Description string `gorm:"type:text"`
+	Slots       uint
+}
+
+// LayerAllocation is the slot range [SlotStart, SlotEnd) of a layer owned by
+// a flag
+type LayerAllocation struct {
+	FlagID    uint
+	FlagKey   string
+	SlotStart uint
+	SlotEnd   uint
+}
+
+// Slot hashes the entity into one of the slots of the layer. The layer ID is
+// the salt, prefixed so that it never equals the salt of a flag with the same
+// ID, otherwise the slots and the buckets of the flag would be correlated.
+func (l *Layer) Slot(entityID string) uint {
+	salt := "layer" + strconv.FormatUint(uint64(l.ID), 10)
+	return uint(crc32.ChecksumIEEE([]byte(salt+entityID))) % l.Slots
+}
+
+// Allocations returns the slot ranges owned by the flags in the layer,
+// ordered by SlotStart
+func (l *Layer) Allocations(db *gorm.DB) ([]LayerAllocation, error) {
+	fs := []Flag{}
+	err := db.
+		Select("id", "key", "layer_slot_start", "layer_slot_end").
+		Where("layer_id = ?", l.ID).
+		Order("layer_slot_start").
+		Find(&fs).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	as := make([]LayerAllocation, len(fs))
+	for i, f := range fs {
+		as[i] = LayerAllocation{
+			FlagID:    f.ID,
+			FlagKey:   f.Key,
+			SlotStart: f.LayerSlotStart,
+			SlotEnd:   f.LayerSlotEnd,
+		}
+	}
+	return as, nil
+}
+
+// ValidateLayerAllocations validates that the slot ranges fit into the slots
+// of the layer and that no two of them overlap
+func ValidateLayerAllocations(slots uint, as []LayerAllocation) error {
+	sorted := make([]LayerAllocation, len(as))
+	copy(sorted, as)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].SlotStart < sorted[j].SlotStart })
+
+	for i, a := range sorted {
+		if a.SlotStart >= a.SlotEnd {
+			return fmt.Errorf("flag %s: empty slot range [%d, %d)", a.FlagKey, a.SlotStart, a.SlotEnd)
+		}
+		if a.SlotEnd > slots {
+			return fmt.Errorf("flag %s: slot range [%d, %d) exceeds the %d slots of the layer", a.FlagKey, a.SlotStart, a.SlotEnd, slots)
+		}
+		if i > 0 && a.SlotStart < sorted[i-1].SlotEnd {
+			prev := sorted[i-1]
+			return fmt.Errorf("flag %s: slot range [%d, %d) overlaps [%d, %d) of flag %s",
+				a.FlagKey, a.SlotStart, a.SlotEnd, prev.SlotStart, prev.SlotEnd, prev.FlagKey)
+		}
+	}
+	return nil
+}
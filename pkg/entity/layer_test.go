@@ -0,0 +1,92 @@
+package entity
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLayerSlot(t *testing.T) {
+	l := &Layer{Slots: 10}
+	l.ID = 1
+
+	cnt := make(map[uint]int)
+	for i := range 1000 {
+		slot := l.Slot(fmt.Sprintf("user%d", i))
+		assert.Less(t, slot, l.Slots)
+		cnt[slot]++
+	}
+	assert.Len(t, cnt, 10)
+	assert.Equal(t, l.Slot("user1"), l.Slot("user1"))
+}
+
+func TestFlagLayerSlot(t *testing.T) {
+	f := &Flag{}
+	_, ok := f.LayerSlot("user1")
+	assert.True(t, ok, "a flag without a layer owns every slot")
+
+	f.Layer = &Layer{Slots: 10}
+	f.LayerSlotStart, f.LayerSlotEnd = 0, 5
+	owned := 0
+	for i := range 1000 {
+		slot, ok := f.LayerSlot(fmt.Sprintf("user%d", i))
+		assert.Equal(t, slot < 5, ok)
+		if ok {
+			owned++
+		}
+	}
+	assert.InDelta(t, 500, owned, 100)
+}
+
+func TestValidateLayerAllocations(t *testing.T) {
+	t.Run("happy code path", func(t *testing.T) {
+		assert.NoError(t, ValidateLayerAllocations(100, nil))
+		assert.NoError(t, ValidateLayerAllocations(100, []LayerAllocation{
+			{FlagKey: "b", SlotStart: 50, SlotEnd: 100},
+			{FlagKey: "a", SlotStart: 0, SlotEnd: 50},
+		}))
+	})
+
+	t.Run("empty range", func(t *testing.T) {
+		err := ValidateLayerAllocations(100, []LayerAllocation{{FlagKey: "a", SlotStart: 50, SlotEnd: 50}})
+		assert.ErrorContains(t, err, "flag a: empty slot range [50, 50)")
+	})
+
+	t.Run("range past the slots", func(t *testing.T) {
+		err := ValidateLayerAllocations(100, []LayerAllocation{{FlagKey: "a", SlotStart: 50, SlotEnd: 101}})
+		assert.ErrorContains(t, err, "exceeds the 100 slots of the layer")
+	})
+
+	t.Run("overlapping ranges", func(t *testing.T) {
+		err := ValidateLayerAllocations(100, []LayerAllocation{
+			{FlagKey: "a", SlotStart: 0, SlotEnd: 60},
+			{FlagKey: "b", SlotStart: 50, SlotEnd: 100},
+		})
+		assert.ErrorContains(t, err, "flag b: slot range [50, 100) overlaps [0, 60) of flag a")
+	})
+}
+
+func TestLayerAllocations(t *testing.T) {
+	db := NewTestDB()
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+
+	l := Layer{Key: "checkout", Slots: 100}
+	assert.NoError(t, db.Create(&l).Error)
+	assert.NoError(t, db.Create(&Flag{Key: "flag_2", LayerID: &l.ID, LayerSlotStart: 50, LayerSlotEnd: 100}).Error)
+	assert.NoError(t, db.Create(&Flag{Key: "flag_1", LayerID: &l.ID, LayerSlotStart: 0, LayerSlotEnd: 50}).Error)
+	assert.NoError(t, db.Create(&Flag{Key: "flag_3"}).Error)
+
+	as, err := l.Allocations(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []LayerAllocation{
+		{FlagID: 2, FlagKey: "flag_1", SlotStart: 0, SlotEnd: 50},
+		{FlagID: 1, FlagKey: "flag_2", SlotStart: 50, SlotEnd: 100},
+	}, as)
+}
@@ -0,0 +1,254 @@
+package entity
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"gorm.io/gorm"
+)
+
+// ListValueMaxLength is the max length of a single value of a List
+const ListValueMaxLength = 255
+
+// List is an uploaded set of values, e.g. entity IDs, referenced by the
+// IN_LIST and NOT_IN_LIST constraints. Version is bumped on every upload.
+type List struct {
+	gorm.Model
+
+	Key         string `gorm:"type:varchar(64);uniqueIndex:idx_list_key"`
+	Description string `gorm:"type:text"`
+	Version     uint
+	Size        uint
+
+	// Values are stored as ListItems and only loaded by the EvalCache
+	Values []string `gorm:"-"`
+}
+
+// ListItem is a single value of a List
+type ListItem struct {
+	ID     uint   `gorm:"primaryKey"`
+	ListID uint   `gorm:"index:idx_listitem_listid"`
+	Value  string `gorm:"type:varchar(255)"`
+}
+
+// ParseListValues reads the values of a list from CSV or newline separated
+// text. Every non-empty field is a value, surrounding spaces are trimmed and
+// duplicates are dropped, keeping the order of the first occurrence.
+func ParseListValues(r io.Reader) ([]string, error) {
+	cr := csv.NewReader(r)
+	cr.FieldsPerRecord = -1
+	cr.LazyQuotes = true
+	cr.ReuseRecord = true
+
+	values := []string{}
+	seen := map[string]struct{}{}
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		for _, field := range record {
+			v := strings.TrimSpace(field)
+			if v == "" {
+				continue
+			}
+			if len(v) > ListValueMaxLength {
+				return nil, fmt.Errorf("list value %.32s... is longer than %d characters", v, ListValueMaxLength)
+			}
+			if _, ok := seen[v]; ok {
+				continue
+			}
+			seen[v] = struct{}{}
+			values = append(values, v)
+		}
+	}
+	return values, nil
+}
+
+// LoadLists loads all the lists with their values
+func LoadLists(db *gorm.DB) ([]List, error) {
+	return LoadChangedLists(db, nil)
+}
+
+// LoadChangedLists loads all the lists, reusing the values of the loaded ones
+// that are still at the same version. Only the values of the new and the
+// changed lists are read.
+func LoadChangedLists(db *gorm.DB, loaded []List) ([]List, error) {
+	ls := []List{}
+	if err := db.Order("id").Find(&ls).Error; err != nil {
+		return nil, err
+	}
+
+	prev := make(map[uint]*List, len(loaded))
+	for i := range loaded {
+		prev[loaded[i].ID] = &loaded[i]
+	}
+	idx := make(map[uint]int, len(ls))
+	for i := range ls {
+		if p, ok := prev[ls[i].ID]; ok && p.SameVersion(&ls[i]) {
+			ls[i].Values = p.Values
+			continue
+		}
+		idx[ls[i].ID] = i
+		ls[i].Values = make([]string, 0, ls[i].Size)
+	}
+	if len(idx) == 0 {
+		return ls, nil
+	}
+
+	tx := db.Order("id")
+	if len(idx) < len(ls) {
+		tx = tx.Where("list_id IN ?", slices.Collect(maps.Keys(idx)))
+	}
+	items := []ListItem{}
+	if err := tx.Find(&items).Error; err != nil {
+		return nil, err
+	}
+	for _, item := range items {
+		if i, ok := idx[item.ListID]; ok {
+			ls[i].Values = append(ls[i].Values, item.Value)
+		}
+	}
+	return ls, nil
+}
+
+// SameVersion reports whether o is the same list as l, and neither its values
+// nor its fields have changed since
+func (l *List) SameVersion(o *List) bool {
+	return l.ID == o.ID && l.Key == o.Key && l.Version == o.Version && l.UpdatedAt.Equal(o.UpdatedAt)
+}
+
+// listOperators are the operators that reference a List by its key
+var listOperators = []string{
+	models.ConstraintOperatorINLIST,
+	models.ConstraintOperatorNOTINLIST,
+}
+
+// ListKey returns the key of the list referenced by the constraint, if its
+// operator is IN_LIST or NOT_IN_LIST
+func (c *Constraint) ListKey() (string, bool) {
+	if c.Operator != models.ConstraintOperatorINLIST && c.Operator != models.ConstraintOperatorNOTINLIST {
+		return "", false
+	}
+	key, err := unquoteValue(strings.TrimSpace(c.Value))
+	if err != nil {
+		return "", false
+	}
+	return key, true
+}
+
+// constraintValues returns the constraint values that reference the list
+func (l *List) constraintValues() []string {
+	return []string{l.Key, strconv.Quote(l.Key)}
+}
+
+// ConstraintCount returns the number of constraints referencing the list in
+// the segments of the flags, either directly or through an audience. The
+// segments of deleted flags are left in place, so the flags are joined to
+// skip them.
+func (l *List) ConstraintCount(db *gorm.DB) (int64, error) {
+	var direct int64
+	err := db.Model(&Constraint{}).
+		Joins("JOIN segments ON segments.id = constraints.segment_id AND segments.deleted_at IS NULL").
+		Joins("JOIN flags ON flags.id = segments.flag_id AND flags.deleted_at IS NULL").
+		Where("constraints.operator IN ? AND constraints.value IN ?", listOperators, l.constraintValues()).
+		Count(&direct).
+		Error
+	if err != nil {
+		return 0, err
+	}
+
+	var viaAudience int64
+	err = db.Model(&Constraint{}).
+		Where("constraints.operator IN ? AND constraints.value IN ?", listOperators, l.constraintValues()).
+		Where("constraints.audience_id IN (?)", db.Table("segments_audiences").
+			Select("segments_audiences.audience_id").
+			Joins("JOIN segments ON segments.id = segments_audiences.segment_id AND segments.deleted_at IS NULL").
+			Joins("JOIN flags ON flags.id = segments.flag_id AND flags.deleted_at IS NULL")).
+		Count(&viaAudience).
+		Error
+	return direct + viaAudience, err
+}
+
+// FlagIDs returns the IDs of the flags that have segments referencing the
+// list, either directly or through an audience, skipping the deleted flags
+func (l *List) FlagIDs(db *gorm.DB) ([]uint, error) {
+	direct := []uint{}
+	err := db.Model(&Segment{}).
+		Joins("JOIN flags ON flags.id = segments.flag_id AND flags.deleted_at IS NULL").
+		Joins("JOIN constraints ON constraints.segment_id = segments.id AND constraints.deleted_at IS NULL").
+		Where("constraints.operator IN ? AND constraints.value IN ?", listOperators, l.constraintValues()).
+		Distinct().
+		Pluck("segments.flag_id", &direct).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	viaAudience := []uint{}
+	err = db.Model(&Segment{}).
+		Joins("JOIN flags ON flags.id = segments.flag_id AND flags.deleted_at IS NULL").
+		Joins("JOIN segments_audiences ON segments_audiences.segment_id = segments.id").
+		Joins("JOIN constraints ON constraints.audience_id = segments_audiences.audience_id AND constraints.deleted_at IS NULL").
+		Where("constraints.operator IN ? AND constraints.value IN ?", listOperators, l.constraintValues()).
+		Distinct().
+		Pluck("segments.flag_id", &viaAudience).
+		Error
+	if err != nil {
+		return nil, err
+	}
+
+	seen := map[uint]struct{}{}
+	ids := []uint{}
+	for _, id := range append(direct, viaAudience...) {
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids, nil
+}
+
+// ListSet is the in-memory set of the values of a List
+type ListSet map[string]struct{}
+
+// NewListSet builds the set of the values
+func NewListSet(values []string) ListSet {
+	s := make(ListSet, len(values))
+	for _, v := range values {
+		s[v] = struct{}{}
+	}
+	return s
+}
+
+// listSets holds the sets matched by IN_LIST and NOT_IN_LIST, keyed by the
+// list key. It's swapped as a whole by the EvalCache on every reload.
+var listSets atomic.Pointer[map[string]ListSet]
+
+// SetListSets replaces the sets used by the IN_LIST and NOT_IN_LIST operators
+func SetListSets(sets map[string]ListSet) {
+	listSets.Store(&sets)
+}
+
+func lookupListSet(key string) (ListSet, bool) {
+	sets := listSets.Load()
+	if sets == nil {
+		return nil, false
+	}
+	s, ok := (*sets)[key]
+	return s, ok
+}
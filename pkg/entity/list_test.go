@@ -0,0 +1,173 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseListValues(t *testing.T) {
+	t.Run("newline separated", func(t *testing.T) {
+		values, err := ParseListValues(strings.NewReader("u1\n u2 \n\nu1\r\nu3\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u1", "u2", "u3"}, values)
+	})
+
+	t.Run("csv", func(t *testing.T) {
+		values, err := ParseListValues(strings.NewReader("u1,u2\n\"u,3\",u4,\n"))
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"u1", "u2", "u,3", "u4"}, values)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		values, err := ParseListValues(strings.NewReader(""))
+		assert.NoError(t, err)
+		assert.Empty(t, values)
+	})
+
+	t.Run("too long value", func(t *testing.T) {
+		_, err := ParseListValues(strings.NewReader(strings.Repeat("a", ListValueMaxLength+1)))
+		assert.Error(t, err)
+	})
+}
+
+func TestConstraintListKey(t *testing.T) {
+	key, ok := (&Constraint{Operator: models.ConstraintOperatorINLIST, Value: ` "beta_users" `}).ListKey()
+	assert.True(t, ok)
+	assert.Equal(t, "beta_users", key)
+
+	key, ok = (&Constraint{Operator: models.ConstraintOperatorNOTINLIST, Value: `beta_users`}).ListKey()
+	assert.True(t, ok)
+	assert.Equal(t, "beta_users", key)
+
+	_, ok = (&Constraint{Operator: models.ConstraintOperatorEQ, Value: `"beta_users"`}).ListKey()
+	assert.False(t, ok)
+}
+
+func TestListReferences(t *testing.T) {
+	db := NewTestDB()
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+
+	l := List{Key: "beta_users"}
+	assert.NoError(t, db.Create(&l).Error)
+
+	ids, err := l.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+
+	// flag 1 references the list directly, flag 2 through an audience,
+	// and flag 3 by a deleted constraint
+	a := Audience{Key: "beta", Constraints: ConstraintArray{
+		{Property: "user_id", Operator: models.ConstraintOperatorNOTINLIST, Value: `beta_users`},
+	}}
+	assert.NoError(t, db.Create(&a).Error)
+	assert.NoError(t, db.Create(&Flag{Key: "flag_1", Segments: []Segment{{Constraints: ConstraintArray{
+		{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`},
+	}}}}).Error)
+	assert.NoError(t, db.Create(&Flag{Key: "flag_2", Segments: []Segment{{Audiences: []Audience{a}}}}).Error)
+	f3 := Flag{Key: "flag_3", Segments: []Segment{{Constraints: ConstraintArray{
+		{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`},
+	}}}}
+	assert.NoError(t, db.Create(&f3).Error)
+	assert.NoError(t, db.Delete(&f3.Segments[0].Constraints[0]).Error)
+
+	ids, err = l.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1, 2}, ids)
+
+	cnt, err := l.ConstraintCount(db)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(2), cnt)
+
+	// the segments of deleted flags are left in place
+	assert.NoError(t, db.Delete(&Flag{}, 2).Error)
+	ids, err = l.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []uint{1}, ids)
+	cnt, err = l.ConstraintCount(db)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), cnt)
+
+	assert.NoError(t, db.Delete(&Flag{}, 1).Error)
+	ids, err = l.FlagIDs(db)
+	assert.NoError(t, err)
+	assert.Empty(t, ids)
+	cnt, err = l.ConstraintCount(db)
+	assert.NoError(t, err)
+	assert.Zero(t, cnt)
+}
+
+func TestLoadLists(t *testing.T) {
+	db := NewTestDB()
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+
+	ls, err := LoadLists(db)
+	assert.NoError(t, err)
+	assert.Empty(t, ls)
+
+	l1 := List{Key: "l1", Size: 2}
+	l2 := List{Key: "l2"}
+	assert.NoError(t, db.Create(&l1).Error)
+	assert.NoError(t, db.Create(&l2).Error)
+	assert.NoError(t, db.Create(&[]ListItem{
+		{ListID: l1.ID, Value: "a"},
+		{ListID: l1.ID, Value: "b"},
+	}).Error)
+
+	ls, err = LoadLists(db)
+	assert.NoError(t, err)
+	assert.Len(t, ls, 2)
+	assert.Equal(t, []string{"a", "b"}, ls[0].Values)
+	assert.Empty(t, ls[1].Values)
+}
+
+func TestLoadChangedLists(t *testing.T) {
+	db := NewTestDB()
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+
+	l1 := List{Key: "l1", Size: 1}
+	l2 := List{Key: "l2", Size: 1}
+	assert.NoError(t, db.Create(&l1).Error)
+	assert.NoError(t, db.Create(&l2).Error)
+	assert.NoError(t, db.Create(&[]ListItem{
+		{ListID: l1.ID, Value: "a"},
+		{ListID: l2.ID, Value: "b"},
+	}).Error)
+
+	loaded, err := LoadLists(db)
+	assert.NoError(t, err)
+
+	// the values of an unchanged list are reused, not read again
+	assert.NoError(t, db.Create(&ListItem{ListID: l1.ID, Value: "stale"}).Error)
+	assert.NoError(t, db.Create(&ListItem{ListID: l2.ID, Value: "c"}).Error)
+	assert.NoError(t, db.Model(&l2).Updates(map[string]any{"version": 1, "size": 2}).Error)
+	l3 := List{Key: "l3"}
+	assert.NoError(t, db.Create(&l3).Error)
+
+	ls, err := LoadChangedLists(db, loaded)
+	assert.NoError(t, err)
+	assert.Len(t, ls, 3)
+	assert.Equal(t, []string{"a"}, ls[0].Values)
+	assert.Equal(t, []string{"b", "c"}, ls[1].Values)
+	assert.Empty(t, ls[2].Values)
+}
@@ -0,0 +1,103 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+	"gorm.io/gorm"
+)
+
+// RolloutSchedule ramps the RolloutPercent of a segment over time. The
+// percent of a step applies from its time on; with Linear the percent is
+// interpolated between the steps instead.
+type RolloutSchedule struct {
+	gorm.Model
+
+	SegmentID      uint         `gorm:"index:idx_rolloutschedule_segmentid"`
+	Steps          RolloutSteps `gorm:"type:text"`
+	Linear         bool
+	Status         string   `gorm:"type:varchar(16);index:idx_rolloutschedule_status"` // models.RolloutScheduleStatus*
+	AppliedPercent *float64 // the last RolloutPercent written by the scheduler
+	CreatedBy      string
+	UpdatedBy      string
+}
+
+// RolloutStep is the RolloutPercent of a segment from the given time on, in
+// steps of PercentPrecision
+type RolloutStep struct {
+	At      time.Time
+	Percent float64
+}
+
+// RolloutSteps is a list of RolloutStep stored as a JSON array
+type RolloutSteps []RolloutStep
+
+// Scan implements scanner interface
+func (s *RolloutSteps) Scan(value any) error {
+	if value == nil {
+		return nil
+	}
+	str := cast.ToString(value)
+	if err := json.Unmarshal([]byte(str), s); err != nil {
+		return fmt.Errorf("cannot scan %v into RolloutSteps type. err: %v", value, err)
+	}
+	return nil
+}
+
+// Value implements valuer interface
+func (s RolloutSteps) Value() (driver.Value, error) {
+	bytes, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return string(bytes), nil
+}
+
+// Validate validates the steps of the schedule
+func (rs *RolloutSchedule) Validate() error {
+	if len(rs.Steps) == 0 {
+		return fmt.Errorf("rollout schedule has no steps")
+	}
+	if rs.Linear && len(rs.Steps) < 2 {
+		return fmt.Errorf("linear rollout schedule needs at least 2 steps")
+	}
+	for i, step := range rs.Steps {
+		if err := ValidatePercent(step.Percent); err != nil {
+			return fmt.Errorf("step %d: %s", i, err)
+		}
+		if i > 0 && !step.At.After(rs.Steps[i-1].At) {
+			return fmt.Errorf("step %d: time %s is not after the previous step", i, step.At.Format(time.RFC3339))
+		}
+	}
+	return nil
+}
+
+// PercentAt returns the RolloutPercent the schedule prescribes at the given
+// time. ok is false before the first step, and done is true once the last
+// step is reached. The linear interpolation is truncated towards the percent
+// of the previous step, in steps of PercentPrecision.
+func (rs *RolloutSchedule) PercentAt(now time.Time) (percent float64, ok bool, done bool) {
+	if len(rs.Steps) == 0 || now.Before(rs.Steps[0].At) {
+		return 0, false, false
+	}
+
+	i := len(rs.Steps) - 1
+	for now.Before(rs.Steps[i].At) {
+		i--
+	}
+	if i == len(rs.Steps)-1 {
+		return rs.Steps[i].Percent, true, true
+	}
+	if !rs.Linear {
+		return rs.Steps[i].Percent, true, false
+	}
+
+	from, to := rs.Steps[i], rs.Steps[i+1]
+	ratio := float64(now.Sub(from.At)) / float64(to.At.Sub(from.At))
+	fromBuckets, toBuckets := int(PercentBuckets(from.Percent)), int(PercentBuckets(to.Percent))
+	buckets := fromBuckets + int(ratio*float64(toBuckets-fromBuckets))
+	return float64(buckets) / float64(PercentMultiplier), true, false
+}
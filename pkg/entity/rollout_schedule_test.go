@@ -0,0 +1,123 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRolloutScheduleValidate(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	rs := RolloutSchedule{Steps: RolloutSteps{
+		{At: start, Percent: 1},
+		{At: start.Add(24 * time.Hour), Percent: 10},
+		{At: start.Add(48 * time.Hour), Percent: 100},
+	}}
+	assert.NoError(t, rs.Validate())
+
+	rs.Steps[2].Percent = 101
+	assert.Error(t, rs.Validate())
+
+	rs.Steps[2].Percent = 0.25
+	assert.Error(t, rs.Validate())
+
+	rs.Steps[0].Percent = 0.5
+	rs.Steps[2].Percent = 100
+	assert.NoError(t, rs.Validate())
+
+	rs.Steps[2].At = start
+	assert.Error(t, rs.Validate())
+
+	assert.Error(t, (&RolloutSchedule{}).Validate())
+	assert.Error(t, (&RolloutSchedule{Linear: true, Steps: RolloutSteps{{At: start, Percent: 100}}}).Validate())
+}
+
+func TestRolloutSchedulePercentAt(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	day := 24 * time.Hour
+
+	t.Run("steps", func(t *testing.T) {
+		rs := RolloutSchedule{Steps: RolloutSteps{
+			{At: start, Percent: 1},
+			{At: start.Add(day), Percent: 10},
+			{At: start.Add(2 * day), Percent: 100},
+		}}
+
+		_, ok, _ := rs.PercentAt(start.Add(-time.Second))
+		assert.False(t, ok)
+
+		percent, ok, done := rs.PercentAt(start)
+		assert.Equal(t, float64(1), percent)
+		assert.True(t, ok)
+		assert.False(t, done)
+
+		percent, _, done = rs.PercentAt(start.Add(day + time.Hour))
+		assert.Equal(t, float64(10), percent)
+		assert.False(t, done)
+
+		percent, _, done = rs.PercentAt(start.Add(3 * day))
+		assert.Equal(t, float64(100), percent)
+		assert.True(t, done)
+	})
+
+	t.Run("linear", func(t *testing.T) {
+		rs := RolloutSchedule{Linear: true, Steps: RolloutSteps{
+			{At: start, Percent: 0},
+			{At: start.Add(10 * day), Percent: 100},
+		}}
+
+		percent, _, done := rs.PercentAt(start.Add(day))
+		assert.Equal(t, float64(10), percent)
+		assert.False(t, done)
+
+		// 50.42% truncated to the precision of 0.1%
+		percent, _, _ = rs.PercentAt(start.Add(5*day + time.Hour))
+		assert.Equal(t, 50.4, percent)
+
+		percent, _, done = rs.PercentAt(start.Add(10 * day))
+		assert.Equal(t, float64(100), percent)
+		assert.True(t, done)
+	})
+
+	t.Run("linear ramp down", func(t *testing.T) {
+		rs := RolloutSchedule{Linear: true, Steps: RolloutSteps{
+			{At: start, Percent: 100},
+			{At: start.Add(4 * day), Percent: 0},
+		}}
+
+		percent, _, _ := rs.PercentAt(start.Add(day))
+		assert.Equal(t, float64(75), percent)
+	})
+
+	t.Run("sub-percent", func(t *testing.T) {
+		rs := RolloutSchedule{Steps: RolloutSteps{
+			{At: start, Percent: 0.5},
+			{At: start.Add(day), Percent: 1},
+		}}
+
+		percent, _, _ := rs.PercentAt(start.Add(time.Hour))
+		assert.Equal(t, 0.5, percent)
+
+		rs.Linear = true
+		rs.Steps[0].Percent = 0
+		percent, _, _ = rs.PercentAt(start.Add(12 * time.Hour))
+		assert.Equal(t, 0.5, percent)
+		percent, _, _ = rs.PercentAt(start.Add(6 * time.Hour))
+		assert.Equal(t, 0.2, percent)
+	})
+}
+
+func TestRolloutSteps(t *testing.T) {
+	steps := RolloutSteps{{At: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), Percent: 10}}
+	v, err := steps.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, `[{"At":"2026-01-01T00:00:00Z","Percent":10}]`, v)
+
+	var scanned RolloutSteps
+	assert.NoError(t, scanned.Scan(v))
+	assert.Equal(t, steps, scanned)
+	assert.NoError(t, scanned.Scan(nil))
+	assert.Error(t, scanned.Scan(`[{"At"`))
+}
@@ -1,6 +1,7 @@
 package entity
 
 import (
+	"fmt"
 	"strconv"
 
 	"github.com/zhouzhuojie/conditions"
@@ -16,9 +17,12 @@ type Segment struct {
 	FlagID         uint   `gorm:"index:idx_segment_flagid"`
 	Description    string `gorm:"type:text"`
 	Rank           uint
-	RolloutPercent uint
+	RolloutPercent float64
 	Constraints    ConstraintArray
 	Distributions  []Distribution
+	Audiences      []Audience `gorm:"many2many:segments_audiences;"`
+
+	ActivationWindow *ActivationWindow `gorm:"type:text"`
 
 	// Purely for evaluation
 	SegmentEvaluation SegmentEvaluation `gorm:"-" json:"-"`
@@ -33,6 +37,9 @@ func PreloadConstraintsDistribution(db *gorm.DB) *gorm.DB {
 		}).
 		Preload("Constraints", func(db *gorm.DB) *gorm.DB {
 			return db.Order("created_at")
+		}).
+		Preload("Audiences", func(db *gorm.DB) *gorm.DB {
+			return PreloadAudienceConstraints(db).Order("audiences.id")
 		})
 }
 
@@ -43,9 +50,26 @@ func (s *Segment) Preload(db *gorm.DB) error {
 
 // SegmentEvaluation is a struct that holds the necessary info for evaluation
 type SegmentEvaluation struct {
-	ConditionsExpr    conditions.Expr
+	ConstraintGroups  []ConstraintGroupEvaluation // OR'ed, ordered by group number
+	Audiences         []AudienceEvaluation        // all of them have to match besides ConstraintGroups
 	DistributionArray DistributionArray
-	FlagIDStr         string // pre-formatted flagID string used as salt in rollout
+	Salt              string // salt of the rollout hash, the pre-formatted flagID unless the flag has a Salt
+}
+
+// ConstraintGroupEvaluation holds the parsed constraints of a constraint group.
+// The group matches if both ConditionsExpr and all ConstraintMatchers match.
+type ConstraintGroupEvaluation struct {
+	Group              uint
+	ConditionsExpr     conditions.Expr
+	ConstraintMatchers []ConstraintMatcher // constraints with native operators, see IsNativeOperator
+}
+
+// AudienceEvaluation holds the parsed constraint groups of an audience
+// referenced by the segment. An audience without constraints matches everyone.
+type AudienceEvaluation struct {
+	ID               uint
+	Key              string
+	ConstraintGroups []ConstraintGroupEvaluation
 }
 
 // PrepareEvaluation prepares the segment for evaluation by parsing constraints
@@ -57,26 +81,63 @@ func (s *Segment) PrepareEvaluation() error {
 			VariantIDs:          make([]uint, dLen),
 			PercentsAccumulated: make([]int, dLen),
 		},
-		FlagIDStr: strconv.FormatUint(uint64(s.FlagID), 10),
+		Salt: strconv.FormatUint(uint64(s.FlagID), 10),
 	}
 
-	if len(s.Constraints) != 0 {
-		expr, err := s.Constraints.ToExpr()
-		if err != nil {
+	if s.ActivationWindow != nil {
+		if err := s.ActivationWindow.Validate(); err != nil {
 			return err
 		}
-		se.ConditionsExpr = expr
+	}
+
+	groups, err := s.Constraints.prepareGroups()
+	if err != nil {
+		return err
+	}
+	se.ConstraintGroups = groups
+
+	for _, a := range s.Audiences {
+		groups, err := a.Constraints.prepareGroups()
+		if err != nil {
+			return fmt.Errorf("audience %s: %w", a.Key, err)
+		}
+		se.Audiences = append(se.Audiences, AudienceEvaluation{
+			ID:               a.ID,
+			Key:              a.Key,
+			ConstraintGroups: groups,
+		})
 	}
 
 	for i, d := range s.Distributions {
 		se.DistributionArray.VariantIDs[i] = d.VariantID
 		if i == 0 {
-			se.DistributionArray.PercentsAccumulated[i] = int(d.Percent * PercentMultiplier)
+			se.DistributionArray.PercentsAccumulated[i] = int(PercentBuckets(d.Percent))
 		} else {
-			se.DistributionArray.PercentsAccumulated[i] = se.DistributionArray.PercentsAccumulated[i-1] + int(d.Percent*PercentMultiplier)
+			se.DistributionArray.PercentsAccumulated[i] = se.DistributionArray.PercentsAccumulated[i-1] + int(PercentBuckets(d.Percent))
 		}
 	}
 
 	s.SegmentEvaluation = se
 	return nil
 }
+
+// prepareGroups parses the constraints into the evaluations of their groups
+func (cs ConstraintArray) prepareGroups() ([]ConstraintGroupEvaluation, error) {
+	var groups []ConstraintGroupEvaluation
+	for _, g := range cs.Groups() {
+		expr, err := g.ToExpr()
+		if err != nil {
+			return nil, err
+		}
+		matchers, err := g.ToMatchers()
+		if err != nil {
+			return nil, err
+		}
+		groups = append(groups, ConstraintGroupEvaluation{
+			Group:              g[0].Group,
+			ConditionsExpr:     expr,
+			ConstraintMatchers: matchers,
+		})
+	}
+	return groups, nil
+}
@@ -3,6 +3,7 @@ package entity
 import (
 	"testing"
 
+	"github.com/foxdalas/flagr/swagger_gen/models"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,16 +11,63 @@ func TestSegmentPrepareEvaluation(t *testing.T) {
 	t.Run("happy code path", func(t *testing.T) {
 		s := GenFixtureSegment()
 		assert.NoError(t, s.PrepareEvaluation())
-		assert.NotNil(t, s.SegmentEvaluation.ConditionsExpr)
+		assert.Len(t, s.SegmentEvaluation.ConstraintGroups, 1)
+		assert.NotNil(t, s.SegmentEvaluation.ConstraintGroups[0].ConditionsExpr)
 		assert.NotNil(t, s.SegmentEvaluation.DistributionArray)
 	})
 
+	t.Run("constraint groups", func(t *testing.T) {
+		s := GenFixtureSegment()
+		s.Constraints = append(s.Constraints,
+			Constraint{Property: "beta_tester", Operator: models.ConstraintOperatorEQ, Value: "true", Group: 1},
+			Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"2.0.0"`, Group: 1},
+		)
+		assert.NoError(t, s.PrepareEvaluation())
+		assert.Len(t, s.SegmentEvaluation.ConstraintGroups, 2)
+		assert.Equal(t, uint(0), s.SegmentEvaluation.ConstraintGroups[0].Group)
+		assert.Empty(t, s.SegmentEvaluation.ConstraintGroups[0].ConstraintMatchers)
+		assert.Equal(t, uint(1), s.SegmentEvaluation.ConstraintGroups[1].Group)
+		assert.NotNil(t, s.SegmentEvaluation.ConstraintGroups[1].ConditionsExpr)
+		assert.Len(t, s.SegmentEvaluation.ConstraintGroups[1].ConstraintMatchers, 1)
+	})
+
+	t.Run("audiences", func(t *testing.T) {
+		s := GenFixtureSegment()
+		s.Audiences = []Audience{
+			{Key: "eu_users", Constraints: []Constraint{
+				{Property: "country", Operator: models.ConstraintOperatorIN, Value: `["DE", "FR"]`},
+			}},
+			{Key: "everyone"},
+		}
+		assert.NoError(t, s.PrepareEvaluation())
+		assert.Len(t, s.SegmentEvaluation.Audiences, 2)
+		assert.Equal(t, "eu_users", s.SegmentEvaluation.Audiences[0].Key)
+		assert.Len(t, s.SegmentEvaluation.Audiences[0].ConstraintGroups, 1)
+		assert.Empty(t, s.SegmentEvaluation.Audiences[1].ConstraintGroups)
+
+		s.Audiences[0].Constraints[0].Value = `["DE"` // invalid value
+		err := s.PrepareEvaluation()
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "audience eu_users")
+	})
+
+	t.Run("fractional percents", func(t *testing.T) {
+		s := GenFixtureSegment()
+		s.Distributions = []Distribution{
+			{VariantID: 1, Percent: 33.3},
+			{VariantID: 2, Percent: 33.3},
+			{VariantID: 3, Percent: 33.4},
+		}
+		assert.NoError(t, s.PrepareEvaluation())
+		assert.Equal(t, []int{333, 666, 1000}, s.SegmentEvaluation.DistributionArray.PercentsAccumulated)
+	})
+
 	t.Run("error code path", func(t *testing.T) {
 		s := GenFixtureSegment()
 		s.SegmentEvaluation = SegmentEvaluation{}
 		s.Constraints[0].Value = `"CA"]` // invalid value
 		assert.Error(t, s.PrepareEvaluation())
-		assert.Empty(t, s.SegmentEvaluation.ConditionsExpr)
+		assert.Empty(t, s.SegmentEvaluation.ConstraintGroups)
 		assert.Empty(t, s.SegmentEvaluation.DistributionArray.VariantIDs)
 		assert.Empty(t, s.SegmentEvaluation.DistributionArray.PercentsAccumulated)
 	})
@@ -0,0 +1,142 @@
+package entity
+
+import (
+	"cmp"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Semver is a parsed semantic version (https://semver.org).
+//
+// Parsing is lenient about the forms commonly seen in app versions: a leading
+// "v" is allowed and missing minor/patch components default to 0, so "v1.2"
+// is equal to "1.2.0". Build metadata ("+build.5") is accepted and ignored
+// for ordering, as the spec requires.
+type Semver struct {
+	Major      uint64
+	Minor      uint64
+	Patch      uint64
+	PreRelease []string
+}
+
+// ParseSemver parses s into a Semver
+func ParseSemver(s string) (*Semver, error) {
+	v := strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if v == "" {
+		return nil, fmt.Errorf("invalid semver %q: empty version", s)
+	}
+
+	if i := strings.IndexByte(v, '+'); i >= 0 {
+		if err := validateSemverIdentifiers(v[i+1:], false); err != nil {
+			return nil, fmt.Errorf("invalid semver %q: build metadata %s", s, err)
+		}
+		v = v[:i]
+	}
+
+	sv := &Semver{}
+	if i := strings.IndexByte(v, '-'); i >= 0 {
+		pre := v[i+1:]
+		if err := validateSemverIdentifiers(pre, true); err != nil {
+			return nil, fmt.Errorf("invalid semver %q: pre-release %s", s, err)
+		}
+		sv.PreRelease = strings.Split(pre, ".")
+		v = v[:i]
+	}
+
+	parts := strings.Split(v, ".")
+	if len(parts) > 3 {
+		return nil, fmt.Errorf("invalid semver %q: too many version components", s)
+	}
+	nums := [3]uint64{}
+	for i, p := range parts {
+		n, err := strconv.ParseUint(p, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid semver %q: %q is not a non-negative integer", s, p)
+		}
+		nums[i] = n
+	}
+	sv.Major, sv.Minor, sv.Patch = nums[0], nums[1], nums[2]
+	return sv, nil
+}
+
+// validateSemverIdentifiers checks a dot separated list of identifiers made
+// of [0-9A-Za-z-]. Numeric pre-release identifiers must not have leading zeros.
+func validateSemverIdentifiers(s string, preRelease bool) error {
+	for _, id := range strings.Split(s, ".") {
+		if id == "" {
+			return fmt.Errorf("has an empty identifier")
+		}
+		numeric := true
+		for _, r := range id {
+			switch {
+			case r >= '0' && r <= '9':
+			case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r == '-':
+				numeric = false
+			default:
+				return fmt.Errorf("identifier %q contains invalid character %q", id, r)
+			}
+		}
+		if preRelease && numeric && len(id) > 1 && id[0] == '0' {
+			return fmt.Errorf("numeric identifier %q has a leading zero", id)
+		}
+	}
+	return nil
+}
+
+// Compare returns -1, 0 or 1 if v is lower than, equal to or greater than o,
+// following the semver precedence rules. A pre-release version has lower
+// precedence than the associated normal version, e.g. 1.0.0-rc.1 < 1.0.0.
+func (v *Semver) Compare(o *Semver) int {
+	if c := cmp.Compare(v.Major, o.Major); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Minor, o.Minor); c != 0 {
+		return c
+	}
+	if c := cmp.Compare(v.Patch, o.Patch); c != 0 {
+		return c
+	}
+
+	switch {
+	case len(v.PreRelease) == 0 && len(o.PreRelease) == 0:
+		return 0
+	case len(v.PreRelease) == 0:
+		return 1
+	case len(o.PreRelease) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(v.PreRelease) && i < len(o.PreRelease); i++ {
+		if c := comparePreReleaseIdentifier(v.PreRelease[i], o.PreRelease[i]); c != 0 {
+			return c
+		}
+	}
+	return cmp.Compare(len(v.PreRelease), len(o.PreRelease))
+}
+
+// String returns the canonical form of the version without build metadata
+func (v *Semver) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.PreRelease) != 0 {
+		s += "-" + strings.Join(v.PreRelease, ".")
+	}
+	return s
+}
+
+// comparePreReleaseIdentifier compares numeric identifiers numerically and
+// alphanumeric identifiers lexically. Numeric identifiers always have lower
+// precedence than alphanumeric ones.
+func comparePreReleaseIdentifier(a, b string) int {
+	an, aErr := strconv.ParseUint(a, 10, 64)
+	bn, bErr := strconv.ParseUint(b, 10, 64)
+	switch {
+	case aErr == nil && bErr == nil:
+		return cmp.Compare(an, bn)
+	case aErr == nil:
+		return -1
+	case bErr == nil:
+		return 1
+	}
+	return strings.Compare(a, b)
+}
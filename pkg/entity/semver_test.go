@@ -0,0 +1,85 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSemver(t *testing.T) {
+	t.Run("happy code path", func(t *testing.T) {
+		for s, expected := range map[string]string{
+			"1.2.3":              "1.2.3",
+			"v1.2.3":             "1.2.3",
+			"1.2":                "1.2.0",
+			"1":                  "1.0.0",
+			" 1.10.0 ":           "1.10.0",
+			"1.0.0-alpha.1":      "1.0.0-alpha.1",
+			"1.0.0-rc.1+build.5": "1.0.0-rc.1",
+			"1.0.0+20130313":     "1.0.0",
+		} {
+			v, err := ParseSemver(s)
+			assert.NoError(t, err, s)
+			assert.Equal(t, expected, v.String())
+		}
+	})
+
+	t.Run("invalid versions", func(t *testing.T) {
+		for _, s := range []string{
+			"",
+			"v",
+			"a.b.c",
+			"1.2.3.4",
+			"1..2",
+			"-1.0.0",
+			"1.0.0-",
+			"1.0.0-alpha..1",
+			"1.0.0-01",
+			"1.0.0-alpha_1",
+			"1.0.0+",
+		} {
+			_, err := ParseSemver(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
+
+func TestSemverCompare(t *testing.T) {
+	// ordered by precedence, see https://semver.org/#spec-item-11
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.9.0",
+		"1.10.0",
+		"2.0.0",
+	}
+	for i := range ordered {
+		for j := range ordered {
+			a, err := ParseSemver(ordered[i])
+			assert.NoError(t, err)
+			b, err := ParseSemver(ordered[j])
+			assert.NoError(t, err)
+
+			switch {
+			case i < j:
+				assert.Equal(t, -1, a.Compare(b), "%s < %s", ordered[i], ordered[j])
+			case i > j:
+				assert.Equal(t, 1, a.Compare(b), "%s > %s", ordered[i], ordered[j])
+			default:
+				assert.Equal(t, 0, a.Compare(b), "%s == %s", ordered[i], ordered[j])
+			}
+		}
+	}
+
+	t.Run("build metadata is ignored", func(t *testing.T) {
+		a, _ := ParseSemver("1.0.0+build.1")
+		b, _ := ParseSemver("1.0.0+build.2")
+		assert.Equal(t, 0, a.Compare(b))
+	})
+}
@@ -0,0 +1,113 @@
+package entity
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// timeNow is stubbed in tests to evaluate relative time literals
+var timeNow = time.Now
+
+// relativeTimeRegex matches literals like now, now+7d, now-1h30m
+var relativeTimeRegex = regexp.MustCompile(`^now(?:([+-])((?:\d+[smhdw])+))?$`)
+
+var relativeTimeUnitRegex = regexp.MustCompile(`(\d+)([smhdw])`)
+
+var relativeTimeUnits = map[string]time.Duration{
+	"s": time.Second,
+	"m": time.Minute,
+	"h": time.Hour,
+	"d": 24 * time.Hour,
+	"w": 7 * 24 * time.Hour,
+}
+
+// timeLiteral is the parsed value of a BEFORE/AFTER constraint. It's either
+// an absolute time or an offset relative to the time of evaluation.
+type timeLiteral struct {
+	abs      time.Time
+	relative bool
+	offset   time.Duration
+}
+
+// at returns the time the literal refers to when evaluated at now
+func (l timeLiteral) at(now time.Time) time.Time {
+	if l.relative {
+		return now.Add(l.offset)
+	}
+	return l.abs
+}
+
+// parseTimeLiteral parses a constraint value: an absolute time accepted by
+// parseTime, or a relative one like now, now+7d or now-12h.
+func parseTimeLiteral(value string) (timeLiteral, error) {
+	s := strings.TrimSpace(value)
+	if m := relativeTimeRegex.FindStringSubmatch(strings.ToLower(s)); m != nil {
+		l := timeLiteral{relative: true}
+		for _, u := range relativeTimeUnitRegex.FindAllStringSubmatch(m[2], -1) {
+			n, err := strconv.ParseInt(u[1], 10, 64)
+			if err != nil {
+				return timeLiteral{}, fmt.Errorf("invalid relative time %q: %s", s, err)
+			}
+			l.offset += time.Duration(n) * relativeTimeUnits[u[2]]
+		}
+		if m[1] == "-" {
+			l.offset = -l.offset
+		}
+		return l, nil
+	}
+
+	t, err := parseTime(s)
+	if err != nil {
+		return timeLiteral{}, err
+	}
+	return timeLiteral{abs: t}, nil
+}
+
+// parseTime parses RFC3339 timestamps, dates like 2006-01-02 (UTC) and
+// unix epoch seconds.
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return epochToTime(f)
+	}
+	return time.Time{}, fmt.Errorf("invalid time %q: expected RFC3339, YYYY-MM-DD, unix epoch seconds or now[+-]<duration>", s)
+}
+
+// timeValue converts an entity context value to time.Time
+func timeValue(v any) (time.Time, error) {
+	switch t := v.(type) {
+	case string:
+		return parseTime(strings.TrimSpace(t))
+	case float64:
+		return epochToTime(t)
+	case json.Number:
+		f, err := t.Float64()
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid unix epoch %v: %s", t, err)
+		}
+		return epochToTime(f)
+	case int:
+		return time.Unix(int64(t), 0), nil
+	case int64:
+		return time.Unix(t, 0), nil
+	}
+	return time.Time{}, fmt.Errorf("unsupported type: %T", v)
+}
+
+func epochToTime(f float64) (time.Time, error) {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		return time.Time{}, fmt.Errorf("invalid unix epoch %v", f)
+	}
+	sec, frac := math.Modf(f)
+	return time.Unix(int64(sec), int64(frac*float64(time.Second))), nil
+}
@@ -0,0 +1,70 @@
+package entity
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTimeLiteral(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	t.Run("absolute times", func(t *testing.T) {
+		for s, expected := range map[string]time.Time{
+			"2026-01-01T00:00:00Z":      time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			"2026-01-01T02:00:00+02:00": time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			"2026-01-01":                time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			"1767225600":                time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+			"1767225600.5":              time.Date(2026, 1, 1, 0, 0, 0, int(500*time.Millisecond), time.UTC),
+		} {
+			l, err := parseTimeLiteral(s)
+			assert.NoError(t, err, s)
+			assert.True(t, expected.Equal(l.at(now)), "%s: %s", s, l.at(now))
+		}
+	})
+
+	t.Run("relative times", func(t *testing.T) {
+		for s, expected := range map[string]time.Duration{
+			"now":       0,
+			"NOW":       0,
+			"now+7d":    7 * 24 * time.Hour,
+			"now-1h30m": -90 * time.Minute,
+			"now+2w":    14 * 24 * time.Hour,
+			"now-45s":   -45 * time.Second,
+		} {
+			l, err := parseTimeLiteral(s)
+			assert.NoError(t, err, s)
+			assert.Equal(t, now.Add(expected), l.at(now), s)
+		}
+	})
+
+	t.Run("invalid literals", func(t *testing.T) {
+		for _, s := range []string{"", "yesterday", "now+", "now+7", "now+7y", "2026-13-01", "01/02/2026"} {
+			_, err := parseTimeLiteral(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
+
+func TestTimeValue(t *testing.T) {
+	expected := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, v := range []any{
+		"2026-01-01T00:00:00Z",
+		"2026-01-01",
+		"1767225600",
+		float64(1767225600),
+		json.Number("1767225600"),
+		int64(1767225600),
+	} {
+		got, err := timeValue(v)
+		assert.NoError(t, err, "%v", v)
+		assert.True(t, expected.Equal(got), "%v", v)
+	}
+
+	_, err := timeValue(true)
+	assert.Error(t, err)
+	_, err = timeValue("soon")
+	assert.Error(t, err)
+}
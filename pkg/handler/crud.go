@@ -10,11 +10,16 @@ import (
 	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/r2e"
 	"github.com/foxdalas/flagr/pkg/notification"
 	"github.com/foxdalas/flagr/pkg/util"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/audience"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/constraint"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/holdout"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/layer"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/list"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/tag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/target"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/variant"
 	"github.com/foxdalas/flagr/swagger_gen/models"
 
@@ -33,8 +38,18 @@ type CRUD interface {
 	DeleteFlag(flag.DeleteFlagParams) middleware.Responder
 	RestoreFlag(flag.RestoreFlagParams) middleware.Responder
 	SetFlagEnabledState(flag.SetFlagEnabledParams) middleware.Responder
+	PutFlagPrerequisites(flag.PutFlagPrerequisitesParams) middleware.Responder
+	PutFlagActivationWindow(flag.PutFlagActivationWindowParams) middleware.Responder
+	DeleteFlagActivationWindow(flag.DeleteFlagActivationWindowParams) middleware.Responder
+	PutFlagLayer(flag.PutFlagLayerParams) middleware.Responder
+	DeleteFlagLayer(flag.DeleteFlagLayerParams) middleware.Responder
+	PutFlagHoldout(flag.PutFlagHoldoutParams) middleware.Responder
+	DeleteFlagHoldout(flag.DeleteFlagHoldoutParams) middleware.Responder
 	GetFlagSnapshots(params flag.GetFlagSnapshotsParams) middleware.Responder
+	PostFlagSnapshotEvaluation(params flag.PostFlagSnapshotEvaluationParams) middleware.Responder
 	GetFlagEntityTypes(params flag.GetFlagEntityTypesParams) middleware.Responder
+	GetEntityTypeSchema(params flag.GetEntityTypeSchemaParams) middleware.Responder
+	PutEntityTypeSchema(params flag.PutEntityTypeSchemaParams) middleware.Responder
 	GetFlagSnapshotMaxID(params flag.GetFlagSnapshotMaxIDParams) middleware.Responder
 
 	//Tags
@@ -43,12 +58,47 @@ type CRUD interface {
 	FindTags(tag.FindTagsParams) middleware.Responder
 	FindAllTags(params tag.FindAllTagsParams) middleware.Responder
 
+	// Audiences
+	FindAudiences(audience.FindAudiencesParams) middleware.Responder
+	CreateAudience(audience.CreateAudienceParams) middleware.Responder
+	GetAudience(audience.GetAudienceParams) middleware.Responder
+	PutAudience(audience.PutAudienceParams) middleware.Responder
+	DeleteAudience(audience.DeleteAudienceParams) middleware.Responder
+
+	// Layers
+	FindLayers(layer.FindLayersParams) middleware.Responder
+	CreateLayer(layer.CreateLayerParams) middleware.Responder
+	GetLayer(layer.GetLayerParams) middleware.Responder
+	PutLayer(layer.PutLayerParams) middleware.Responder
+	DeleteLayer(layer.DeleteLayerParams) middleware.Responder
+
+	// Holdouts
+	FindHoldouts(holdout.FindHoldoutsParams) middleware.Responder
+	CreateHoldout(holdout.CreateHoldoutParams) middleware.Responder
+	GetHoldout(holdout.GetHoldoutParams) middleware.Responder
+	PutHoldout(holdout.PutHoldoutParams) middleware.Responder
+	DeleteHoldout(holdout.DeleteHoldoutParams) middleware.Responder
+
+	// Lists
+	FindLists(list.FindListsParams) middleware.Responder
+	CreateList(list.CreateListParams) middleware.Responder
+	GetList(list.GetListParams) middleware.Responder
+	PutList(list.PutListParams) middleware.Responder
+	DeleteList(list.DeleteListParams) middleware.Responder
+	PutListValues(list.PutListValuesParams) middleware.Responder
+
 	// Segments
 	CreateSegment(segment.CreateSegmentParams) middleware.Responder
 	FindSegments(segment.FindSegmentsParams) middleware.Responder
 	PutSegment(segment.PutSegmentParams) middleware.Responder
 	DeleteSegment(segment.DeleteSegmentParams) middleware.Responder
 	PutSegmentsReorder(segment.PutSegmentsReorderParams) middleware.Responder
+	GetRolloutSchedule(segment.GetRolloutScheduleParams) middleware.Responder
+	CreateRolloutSchedule(segment.CreateRolloutScheduleParams) middleware.Responder
+	SetRolloutSchedulePaused(segment.SetRolloutSchedulePausedParams) middleware.Responder
+	CancelRolloutSchedule(segment.CancelRolloutScheduleParams) middleware.Responder
+	PutSegmentActivationWindow(segment.PutSegmentActivationWindowParams) middleware.Responder
+	DeleteSegmentActivationWindow(segment.DeleteSegmentActivationWindowParams) middleware.Responder
 
 	// Constraints
 	CreateConstraint(constraint.CreateConstraintParams) middleware.Responder
@@ -65,6 +115,12 @@ type CRUD interface {
 	FindVariants(variant.FindVariantsParams) middleware.Responder
 	PutVariant(variant.PutVariantParams) middleware.Responder
 	DeleteVariant(variant.DeleteVariantParams) middleware.Responder
+
+	// Targets
+	FindTargets(target.FindTargetsParams) middleware.Responder
+	CreateTarget(target.CreateTargetParams) middleware.Responder
+	PutTargets(target.PutTargetsParams) middleware.Responder
+	DeleteTarget(target.DeleteTargetParams) middleware.Responder
 }
 
 // NewCRUD creates a new CRUD instance
@@ -79,8 +135,9 @@ var (
 	e2rMapFlags         = e2r.MapFlags
 	e2rMapFlagSnapshots = e2r.MapFlagSnapshots
 
-	r2eMapAttachment    = r2e.MapAttachment
-	r2eMapDistributions = r2e.MapDistributions
+	r2eMapAttachment       = r2e.MapAttachment
+	r2eMapAttachmentSchema = r2e.MapAttachmentSchema
+	r2eMapDistributions    = r2e.MapDistributions
 )
 
 func (c *crud) FindFlags(params flag.FindFlagsParams) middleware.Responder {
@@ -251,26 +308,88 @@ func (c *crud) PutFlag(params flag.PutFlagParams) middleware.Responder {
 	if params.Body.DataRecordsEnabled != nil {
 		f.DataRecordsEnabled = *params.Body.DataRecordsEnabled
 	}
+	oldKey := f.Key
+	var dependentIDs []uint
 	if params.Body.Key != nil {
 		key, err := entity.CreateFlagKey(*params.Body.Key)
 		if err != nil {
 			return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
 		}
 		f.Key = key
+		if f.Key != oldKey {
+			ids, verr := validatePutFlagKey(f, oldKey)
+			if verr != nil {
+				return flag.NewPutFlagDefault(verr.StatusCode).WithPayload(ErrorMessage("%s", verr))
+			}
+			dependentIDs = ids
+		}
 	}
 	if params.Body.EntityType != nil {
 		et := *params.Body.EntityType
 		if err := entity.CreateFlagEntityType(tx, et); err != nil {
 			return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
 		}
+		if et != f.EntityType {
+			if err := validatePutFlagEntityType(f, et); err != nil {
+				return flag.NewPutFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+			}
+		}
 		f.EntityType = et
 	}
 
+	if params.Body.BucketBy != nil {
+		if err := entity.ValidateBucketBy(*params.Body.BucketBy); err != nil {
+			return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+		if f.LayerID != nil {
+			if err := validateLayerBucketBy(tx, *f.LayerID, f.ID, *params.Body.BucketBy); err != nil {
+				return flag.NewPutFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+			}
+		}
+		f.BucketBy = *params.Body.BucketBy
+	}
+	if params.Body.Salt != nil {
+		if err := entity.ValidateSalt(*params.Body.Salt); err != nil {
+			return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+		f.Salt = *params.Body.Salt
+	}
+	if params.Body.DefaultVariantID != nil {
+		variantID := util.SafeUint(params.Body.DefaultVariantID)
+		if variantID != 0 {
+			if err := tx.Where("flag_id = ?", f.ID).First(&entity.Variant{}, variantID).Error; err != nil {
+				return flag.NewPutFlagDefault(400).WithPayload(
+					ErrorMessage("unable to find variant %v of flag %v in the database", variantID, f.ID))
+			}
+		}
+		f.DefaultVariantID = variantID
+	}
+	if params.Body.AttachmentSchema != nil {
+		schema, err := r2eMapAttachmentSchema(params.Body.AttachmentSchema)
+		if err != nil {
+			return flag.NewPutFlagDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+		if err := validatePutFlagAttachmentSchema(f.ID, schema); err != nil {
+			return flag.NewPutFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		f.AttachmentSchema = schema
+	}
+
 	if params.Body.Notes != nil {
 		f.Notes = *params.Body.Notes
 	}
 
-	if err := tx.Save(f).Error; err != nil {
+	// The flags that have the flag as a prerequisite follow its key change
+	err := tx.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(f).Error; err != nil {
+			return err
+		}
+		if len(dependentIDs) == 0 {
+			return nil
+		}
+		return tx.Model(&entity.FlagPrerequisite{}).Where("flag_key = ?", oldKey).Update("flag_key", f.Key).Error
+	})
+	if err != nil {
 		return flag.NewPutFlagDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
@@ -286,6 +405,9 @@ func (c *crud) PutFlag(params flag.PutFlagParams) middleware.Responder {
 	resp.SetPayload(payload)
 
 	entity.SaveFlagSnapshot(getDB(), util.SafeUint(params.FlagID), getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentFlag, util.SafeUint(params.FlagID), f.Key)
+	for _, id := range dependentIDs {
+		entity.SaveFlagSnapshot(getDB(), id, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentFlag, util.SafeUint(params.FlagID), f.Key)
+	}
 	return resp
 }
 
@@ -312,6 +434,283 @@ func (c *crud) SetFlagEnabledState(params flag.SetFlagEnabledParams) middleware.
 	return resp
 }
 
+// PutFlagPrerequisites replaces the prerequisites of the flag
+func (c *crud) PutFlagPrerequisites(params flag.PutFlagPrerequisitesParams) middleware.Responder {
+	f := &entity.Flag{}
+	if err := getDB().First(f, params.FlagID).Error; err != nil {
+		return flag.NewPutFlagPrerequisitesDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	ps := r2e.MapFlagPrerequisites(params.Body.Prerequisites, f.ID)
+	if err := validatePutFlagPrerequisites(f, ps); err != nil {
+		return flag.NewPutFlagPrerequisitesDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	err := getDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("flag_id = ?", f.ID).Delete(&entity.FlagPrerequisite{}).Error; err != nil {
+			return err
+		}
+		if len(ps) == 0 {
+			return nil
+		}
+		return tx.Create(&ps).Error
+	})
+	if err != nil {
+		return flag.NewPutFlagPrerequisitesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := f.Preload(getDB()); err != nil {
+		return flag.NewPutFlagPrerequisitesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewPutFlagPrerequisitesOK()
+	payload, err := e2rMapFlag(f)
+	if err != nil {
+		return flag.NewPutFlagPrerequisitesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	resp.SetPayload(payload)
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentFlag, f.ID, f.Key)
+	return resp
+}
+
+// PutFlagActivationWindow sets the activation window of the flag
+func (c *crud) PutFlagActivationWindow(params flag.PutFlagActivationWindowParams) middleware.Responder {
+	w := r2e.MapActivationWindow(params.Body)
+	if err := w.Validate(); err != nil {
+		return flag.NewPutFlagActivationWindowDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+	f, err := updateFlagActivationWindow(params.FlagID, w)
+	if err != nil {
+		return flag.NewPutFlagActivationWindowDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewPutFlagActivationWindowOK()
+	payload, mapErr := e2rMapFlag(f)
+	if mapErr != nil {
+		return flag.NewPutFlagActivationWindowDefault(500).WithPayload(ErrorMessage("%s", mapErr))
+	}
+	resp.SetPayload(payload)
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentFlag, f.ID, f.Key)
+	return resp
+}
+
+// DeleteFlagActivationWindow removes the activation window of the flag
+func (c *crud) DeleteFlagActivationWindow(params flag.DeleteFlagActivationWindowParams) middleware.Responder {
+	f, err := updateFlagActivationWindow(params.FlagID, nil)
+	if err != nil {
+		return flag.NewDeleteFlagActivationWindowDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewDeleteFlagActivationWindowOK()
+	payload, mapErr := e2rMapFlag(f)
+	if mapErr != nil {
+		return flag.NewDeleteFlagActivationWindowDefault(500).WithPayload(ErrorMessage("%s", mapErr))
+	}
+	resp.SetPayload(payload)
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentFlag, f.ID, f.Key)
+	return resp
+}
+
+// updateFlagActivationWindow writes the activation window of the flag, nil
+// removes it, and returns the preloaded flag
+func updateFlagActivationWindow(flagID int64, w *entity.ActivationWindow) (*entity.Flag, *Error) {
+	f := &entity.Flag{}
+	if err := getDB().First(f, flagID).Error; err != nil {
+		return nil, NewError(404, "%s", err)
+	}
+	if err := getDB().Model(f).Select("activation_window").Updates(&entity.Flag{ActivationWindow: w}).Error; err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	if err := f.Preload(getDB()); err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	return f, nil
+}
+
+// PutFlagLayer puts the flag into a layer, owning the given slot range of it.
+// The range must not overlap the ranges owned by the other flags in the layer.
+func (c *crud) PutFlagLayer(params flag.PutFlagLayerParams) middleware.Responder {
+	f, err := updateFlagLayer(
+		params.FlagID,
+		new(util.SafeUint(params.Body.LayerID)),
+		util.SafeUint(params.Body.SlotStart),
+		util.SafeUint(params.Body.SlotEnd),
+	)
+	if err != nil {
+		return flag.NewPutFlagLayerDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewPutFlagLayerOK()
+	payload, mapErr := e2rMapFlag(f)
+	if mapErr != nil {
+		return flag.NewPutFlagLayerDefault(500).WithPayload(ErrorMessage("%s", mapErr))
+	}
+	resp.SetPayload(payload)
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentLayer, f.Layer.ID, f.Layer.Key)
+	return resp
+}
+
+// DeleteFlagLayer removes the flag from its layer, releasing its slots
+func (c *crud) DeleteFlagLayer(params flag.DeleteFlagLayerParams) middleware.Responder {
+	f, err := updateFlagLayer(params.FlagID, nil, 0, 0)
+	if err != nil {
+		return flag.NewDeleteFlagLayerDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewDeleteFlagLayerOK()
+	payload, mapErr := e2rMapFlag(f)
+	if mapErr != nil {
+		return flag.NewDeleteFlagLayerDefault(500).WithPayload(ErrorMessage("%s", mapErr))
+	}
+	resp.SetPayload(payload)
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationDelete, notification.ComponentLayer, 0, "")
+	return resp
+}
+
+// updateFlagLayer writes the layer and the slot range of the flag, a nil
+// layerID removes the flag from its layer, and returns the preloaded flag
+func updateFlagLayer(flagID int64, layerID *uint, slotStart uint, slotEnd uint) (*entity.Flag, *Error) {
+	f := &entity.Flag{}
+	err := getDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.First(f, flagID).Error; err != nil {
+			return NewError(404, "%s", err)
+		}
+
+		if layerID != nil {
+			l := &entity.Layer{}
+			if err := tx.First(l, *layerID).Error; err != nil {
+				return NewError(400, "unable to find layer %v in the database", *layerID)
+			}
+			as, err := l.Allocations(tx)
+			if err != nil {
+				return NewError(500, "%s", err)
+			}
+			others := []entity.LayerAllocation{}
+			for _, a := range as {
+				if a.FlagID != f.ID {
+					others = append(others, a)
+				}
+			}
+			others = append(others, entity.LayerAllocation{FlagID: f.ID, FlagKey: f.Key, SlotStart: slotStart, SlotEnd: slotEnd})
+			if err := entity.ValidateLayerAllocations(l.Slots, others); err != nil {
+				return NewError(400, "%s", err)
+			}
+			if err := validateLayerBucketBy(tx, l.ID, f.ID, f.BucketBy); err != nil {
+				return err
+			}
+		}
+
+		update := &entity.Flag{LayerID: layerID, LayerSlotStart: slotStart, LayerSlotEnd: slotEnd}
+		if err := tx.Model(f).Select("layer_id", "layer_slot_start", "layer_slot_end").Updates(update).Error; err != nil {
+			return NewError(500, "%s", err)
+		}
+		return nil
+	})
+	if err != nil {
+		var e *Error
+		if errors.As(err, &e) {
+			return nil, e
+		}
+		return nil, NewError(500, "%s", err)
+	}
+
+	if err := f.Preload(getDB()); err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	return f, nil
+}
+
+// validateLayerBucketBy validates that the flag buckets by the same attribute
+// as the other flags in the layer, otherwise an entity could be hashed into
+// the slots of more than one of them
+func validateLayerBucketBy(tx *gorm.DB, layerID uint, flagID uint, bucketBy string) *Error {
+	others := []entity.Flag{}
+	err := tx.
+		Select("key", "bucket_by").
+		Where("layer_id = ? AND id <> ? AND bucket_by <> ?", layerID, flagID, bucketBy).
+		Limit(1).
+		Find(&others).
+		Error
+	if err != nil {
+		return NewError(500, "%s", err)
+	}
+	if len(others) != 0 {
+		return NewError(400, "flags in the same layer must have the same bucketBy. flag %s buckets by %q", others[0].Key, others[0].BucketBy)
+	}
+	return nil
+}
+
+// PutFlagHoldout puts the flag into a holdout. The entities in the holdout get
+// the given variant of the flag.
+func (c *crud) PutFlagHoldout(params flag.PutFlagHoldoutParams) middleware.Responder {
+	f, err := updateFlagHoldout(params.FlagID, new(util.SafeUint(params.Body.HoldoutID)), util.SafeUint(params.Body.VariantID))
+	if err != nil {
+		return flag.NewPutFlagHoldoutDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewPutFlagHoldoutOK()
+	payload, mapErr := e2rMapFlag(f)
+	if mapErr != nil {
+		return flag.NewPutFlagHoldoutDefault(500).WithPayload(ErrorMessage("%s", mapErr))
+	}
+	resp.SetPayload(payload)
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentHoldout, f.Holdout.ID, f.Holdout.Key)
+	return resp
+}
+
+// DeleteFlagHoldout removes the flag from its holdout
+func (c *crud) DeleteFlagHoldout(params flag.DeleteFlagHoldoutParams) middleware.Responder {
+	f, err := updateFlagHoldout(params.FlagID, nil, 0)
+	if err != nil {
+		return flag.NewDeleteFlagHoldoutDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewDeleteFlagHoldoutOK()
+	payload, mapErr := e2rMapFlag(f)
+	if mapErr != nil {
+		return flag.NewDeleteFlagHoldoutDefault(500).WithPayload(ErrorMessage("%s", mapErr))
+	}
+	resp.SetPayload(payload)
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationDelete, notification.ComponentHoldout, 0, "")
+	return resp
+}
+
+// updateFlagHoldout writes the holdout and the holdout variant of the flag, a
+// nil holdoutID removes the flag from its holdout, and returns the preloaded
+// flag
+func updateFlagHoldout(flagID int64, holdoutID *uint, variantID uint) (*entity.Flag, *Error) {
+	f := &entity.Flag{}
+	if err := getDB().First(f, flagID).Error; err != nil {
+		return nil, NewError(404, "%s", err)
+	}
+
+	if holdoutID != nil {
+		if err := getDB().First(&entity.Holdout{}, *holdoutID).Error; err != nil {
+			return nil, NewError(400, "unable to find holdout %v in the database", *holdoutID)
+		}
+		err := getDB().Where("flag_id = ?", f.ID).First(&entity.Variant{}, variantID).Error
+		if err != nil {
+			return nil, NewError(400, "unable to find variant %v of flag %v in the database", variantID, f.ID)
+		}
+	}
+
+	update := &entity.Flag{HoldoutID: holdoutID, HoldoutVariantID: variantID}
+	if err := getDB().Model(f).Select("holdout_id", "holdout_variant_id").Updates(update).Error; err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	if err := f.Preload(getDB()); err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	return f, nil
+}
+
 func (c *crud) RestoreFlag(params flag.RestoreFlagParams) middleware.Responder {
 	f := &entity.Flag{}
 	if err := entity.PreloadFlagTags(getDB().Unscoped()).First(f, params.FlagID).Error; err != nil {
@@ -340,8 +739,18 @@ func (c *crud) DeleteFlag(params flag.DeleteFlagParams) middleware.Responder {
 	if err := getDB().First(f, params.FlagID).Error; err != nil {
 		return flag.NewDeleteFlagDefault(404).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateDeleteFlag(f); err != nil {
+		return flag.NewDeleteFlagDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
-	if err := getDB().Delete(&entity.Flag{}, params.FlagID).Error; err != nil {
+	// Release the slots of the layer, a restored flag has to be put back into it
+	err := getDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(f).Select("layer_id", "layer_slot_start", "layer_slot_end").Updates(&entity.Flag{}).Error; err != nil {
+			return err
+		}
+		return tx.Delete(&entity.Flag{}, params.FlagID).Error
+	})
+	if err != nil {
 		return flag.NewDeleteFlagDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
@@ -428,11 +837,23 @@ func (c *crud) CreateTag(params tag.CreateTagParams) middleware.Responder {
 func (c *crud) CreateSegment(params segment.CreateSegmentParams) middleware.Responder {
 	s := &entity.Segment{}
 	s.FlagID = uint(params.FlagID)
-	s.RolloutPercent = uint(*params.Body.RolloutPercent)
+	s.RolloutPercent = *params.Body.RolloutPercent
 	s.Description = util.SafeString(params.Body.Description)
 	s.Rank = entity.SegmentDefaultRank
+	if err := entity.ValidatePercent(s.RolloutPercent); err != nil {
+		return segment.NewCreateSegmentDefault(400).WithPayload(ErrorMessage("invalid rolloutPercent. %s", err))
+	}
+
+	as, err := findAudiencesByIDs(params.Body.AudienceIDs)
+	if err != nil {
+		return segment.NewCreateSegmentDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := validateSegmentAudiencesEntityType(params.FlagID, as); err != nil {
+		return segment.NewCreateSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	s.Audiences = as
 
-	err := getDB().Create(s).Error
+	err = getDB().Create(s).Error
 	if err != nil {
 		return segment.NewCreateSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
@@ -473,13 +894,31 @@ func (c *crud) PutSegment(params segment.PutSegmentParams) middleware.Responder
 		return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
-	s.RolloutPercent = util.SafeUint(params.Body.RolloutPercent)
+	s.RolloutPercent = util.SafeFloat64(params.Body.RolloutPercent)
 	s.Description = util.SafeString(params.Body.Description)
+	if err := entity.ValidatePercent(s.RolloutPercent); err != nil {
+		return segment.NewPutSegmentDefault(400).WithPayload(ErrorMessage("invalid rolloutPercent. %s", err))
+	}
 
 	if err := getDB().Save(s).Error; err != nil {
 		return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
 
+	// nil keeps the audiences, an empty array removes them
+	if params.Body.AudienceIDs != nil {
+		as, err := findAudiencesByIDs(params.Body.AudienceIDs)
+		if err != nil {
+			return segment.NewPutSegmentDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+		if err := validateSegmentAudiencesEntityType(params.FlagID, as); err != nil {
+			return segment.NewPutSegmentDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		if err := getDB().Model(s).Association("Audiences").Replace(as); err != nil {
+			return segment.NewPutSegmentDefault(500).WithPayload(ErrorMessage("%s", err))
+		}
+		s.Audiences = as
+	}
+
 	resp := segment.NewPutSegmentOK()
 	resp.SetPayload(e2r.MapSegment(s))
 
@@ -487,6 +926,54 @@ func (c *crud) PutSegment(params segment.PutSegmentParams) middleware.Responder
 	return resp
 }
 
+// PutSegmentActivationWindow sets the activation window of the segment
+func (c *crud) PutSegmentActivationWindow(params segment.PutSegmentActivationWindowParams) middleware.Responder {
+	w := r2e.MapActivationWindow(params.Body)
+	if err := w.Validate(); err != nil {
+		return segment.NewPutSegmentActivationWindowDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+	s, err := updateSegmentActivationWindow(params.FlagID, params.SegmentID, w)
+	if err != nil {
+		return segment.NewPutSegmentActivationWindowDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := segment.NewPutSegmentActivationWindowOK()
+	resp.SetPayload(e2r.MapSegment(s))
+
+	entity.SaveFlagSnapshot(getDB(), s.FlagID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentSegment, s.ID, "")
+	return resp
+}
+
+// DeleteSegmentActivationWindow removes the activation window of the segment
+func (c *crud) DeleteSegmentActivationWindow(params segment.DeleteSegmentActivationWindowParams) middleware.Responder {
+	s, err := updateSegmentActivationWindow(params.FlagID, params.SegmentID, nil)
+	if err != nil {
+		return segment.NewDeleteSegmentActivationWindowDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := segment.NewDeleteSegmentActivationWindowOK()
+	resp.SetPayload(e2r.MapSegment(s))
+
+	entity.SaveFlagSnapshot(getDB(), s.FlagID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentSegment, s.ID, "")
+	return resp
+}
+
+// updateSegmentActivationWindow writes the activation window of the segment
+// of the flag, nil removes it, and returns the preloaded segment
+func updateSegmentActivationWindow(flagID int64, segmentID int64, w *entity.ActivationWindow) (*entity.Segment, *Error) {
+	s := &entity.Segment{}
+	if err := getDB().Where("flag_id = ?", flagID).First(s, segmentID).Error; err != nil {
+		return nil, NewError(404, "%s", err)
+	}
+	if err := getDB().Model(s).Select("activation_window").Updates(&entity.Segment{ActivationWindow: w}).Error; err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	if err := s.Preload(getDB()); err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	return s, nil
+}
+
 func (c *crud) PutSegmentsReorder(params segment.PutSegmentsReorderParams) middleware.Responder {
 	tx := getDB().Begin()
 	for i, segmentID := range params.Body.SegmentIDs {
@@ -528,10 +1015,17 @@ func (c *crud) CreateConstraint(params constraint.CreateConstraintParams) middle
 		cons.Property = util.SafeString(params.Body.Property)
 		cons.Operator = util.SafeString(params.Body.Operator)
 		cons.Value = util.SafeString(params.Body.Value)
+		cons.Group = util.SafeUint(params.Body.Group)
 	}
 	if err := cons.Validate(); err != nil {
 		return constraint.NewCreateConstraintDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateConstraintLists(*cons); err != nil {
+		return constraint.NewCreateConstraintDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := validateConstraintEntityType(params.FlagID, *cons); err != nil {
+		return constraint.NewCreateConstraintDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 	if err := getDB().Create(cons).Error; err != nil {
 		return constraint.NewCreateConstraintDefault(500).WithPayload(ErrorMessage("%s", err))
 	}
@@ -565,10 +1059,17 @@ func (c *crud) PutConstraint(params constraint.PutConstraintParams) middleware.R
 		cons.Property = util.SafeString(params.Body.Property)
 		cons.Operator = util.SafeString(params.Body.Operator)
 		cons.Value = util.SafeString(params.Body.Value)
+		cons.Group = util.SafeUint(params.Body.Group)
 	}
 	if err := cons.Validate(); err != nil {
 		return constraint.NewPutConstraintDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateConstraintLists(*cons); err != nil {
+		return constraint.NewPutConstraintDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := validateConstraintEntityType(params.FlagID, *cons); err != nil {
+		return constraint.NewPutConstraintDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
 	if err := getDB().Save(&cons).Error; err != nil {
 		return constraint.NewPutConstraintDefault(500).WithPayload(ErrorMessage("%s", err))
@@ -658,6 +1159,9 @@ func (c *crud) CreateVariant(params variant.CreateVariantParams) middleware.Resp
 	if err := v.Validate(); err != nil {
 		return variant.NewCreateVariantDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateVariantAttachment(v); err != nil {
+		return variant.NewCreateVariantDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
 	if err := getDB().Create(v).Error; err != nil {
 		return variant.NewCreateVariantDefault(500).WithPayload(ErrorMessage("%s", err))
@@ -705,6 +1209,9 @@ func (c *crud) PutVariant(params variant.PutVariantParams) middleware.Responder
 	if err := v.Validate(); err != nil {
 		return variant.NewPutVariantDefault(400).WithPayload(ErrorMessage("%s", err))
 	}
+	if err := validateVariantAttachment(v); err != nil {
+		return variant.NewPutVariantDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
 
 	if err := getDB().Save(&v).Error; err != nil {
 		return variant.NewPutVariantDefault(500).WithPayload(ErrorMessage("%s", err))
@@ -714,6 +1221,10 @@ func (c *crud) PutVariant(params variant.PutVariantParams) middleware.Responder
 		return variant.NewPutVariantDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
 	}
 
+	if err := validatePutVariantForTargets(v); err != nil {
+		return variant.NewPutVariantDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
 	resp := variant.NewPutVariantOK()
 	resp.SetPayload(e2r.MapVariant(v))
 
@@ -733,3 +1244,94 @@ func (c *crud) DeleteVariant(params variant.DeleteVariantParams) middleware.Resp
 	entity.SaveFlagSnapshot(getDB(), util.SafeUint(params.FlagID), getSubjectFromRequest(params.HTTPRequest), notification.OperationDelete, notification.ComponentVariant, util.SafeUint(params.VariantID), "")
 	return variant.NewDeleteVariantOK()
 }
+
+func (c *crud) FindTargets(params target.FindTargetsParams) middleware.Responder {
+	ts := []entity.FlagTarget{}
+	err := getDB().
+		Order("id").
+		Where(entity.FlagTarget{FlagID: util.SafeUint(params.FlagID)}).
+		Find(&ts).
+		Error
+	if err != nil {
+		return target.NewFindTargetsDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := target.NewFindTargetsOK()
+	resp.SetPayload(e2r.MapFlagTargets(ts))
+	return resp
+}
+
+func (c *crud) CreateTarget(params target.CreateTargetParams) middleware.Responder {
+	f := &entity.Flag{}
+	if err := getDB().Preload("Variants").Preload("Targets").First(f, params.FlagID).Error; err != nil {
+		return target.NewCreateTargetDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	// validate the new target together with the existing ones, so that an
+	// entity can't be targeted twice
+	ts := append(f.Targets, entity.FlagTarget{
+		FlagID:     f.ID,
+		EntityID:   util.SafeString(params.Body.EntityID),
+		VariantKey: util.SafeString(params.Body.VariantKey),
+	})
+	if err := validatePutTargets(f, ts); err != nil {
+		return target.NewCreateTargetDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	t := &ts[len(ts)-1]
+	if err := getDB().Create(t).Error; err != nil {
+		return target.NewCreateTargetDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := target.NewCreateTargetOK()
+	resp.SetPayload(e2r.MapFlagTarget(t))
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationCreate, notification.ComponentTarget, t.ID, t.EntityID)
+	return resp
+}
+
+// PutTargets replaces the targets of the flag
+func (c *crud) PutTargets(params target.PutTargetsParams) middleware.Responder {
+	f := &entity.Flag{}
+	if err := getDB().Preload("Variants").First(f, params.FlagID).Error; err != nil {
+		return target.NewPutTargetsDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	ts := r2e.MapFlagTargets(params.Body.Targets, f.ID)
+	if err := validatePutTargets(f, ts); err != nil {
+		return target.NewPutTargetsDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	err := getDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("flag_id = ?", f.ID).Delete(&entity.FlagTarget{}).Error; err != nil {
+			return err
+		}
+		if len(ts) == 0 {
+			return nil
+		}
+		// insert in batches to stay below the bind variables limit of the database
+		return tx.CreateInBatches(&ts, 500).Error
+	})
+	if err != nil {
+		return target.NewPutTargetsDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := target.NewPutTargetsOK()
+	resp.SetPayload(e2r.MapFlagTargets(ts))
+
+	entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentTarget, 0, "")
+	return resp
+}
+
+func (c *crud) DeleteTarget(params target.DeleteTargetParams) middleware.Responder {
+	err := getDB().
+		Where(entity.FlagTarget{FlagID: util.SafeUint(params.FlagID)}).
+		Delete(&entity.FlagTarget{}, params.TargetID).
+		Error
+	if err != nil {
+		return target.NewDeleteTargetDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	entity.SaveFlagSnapshot(getDB(), util.SafeUint(params.FlagID), getSubjectFromRequest(params.HTTPRequest), notification.OperationDelete, notification.ComponentTarget, util.SafeUint(params.TargetID), "")
+	return target.NewDeleteTargetOK()
+}
@@ -0,0 +1,224 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/r2e"
+	"github.com/foxdalas/flagr/pkg/notification"
+	"github.com/foxdalas/flagr/pkg/util"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/audience"
+
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+)
+
+func (c *crud) FindAudiences(params audience.FindAudiencesParams) middleware.Responder {
+	tx := entity.PreloadAudienceConstraints(getDB())
+	as := []entity.Audience{}
+
+	if params.Limit != nil {
+		tx = tx.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		tx = tx.Offset(int(*params.Offset))
+	}
+	if params.KeyLike != nil {
+		tx = tx.Where(
+			"lower(audiences.key) like ?",
+			fmt.Sprintf("%%%s%%", strings.ToLower(*params.KeyLike)),
+		)
+	}
+
+	if err := tx.Order("id").Find(&as).Error; err != nil {
+		return audience.NewFindAudiencesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := audience.NewFindAudiencesOK()
+	resp.SetPayload(e2r.MapAudiences(as))
+	return resp
+}
+
+func (c *crud) CreateAudience(params audience.CreateAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	if params.Body != nil {
+		a.Key = util.SafeString(params.Body.Key)
+		a.Description = params.Body.Description
+		a.Constraints = r2e.MapAudienceConstraints(params.Body.Constraints, 0)
+	}
+	if ok, reason := util.IsSafeKey(a.Key); !ok {
+		return audience.NewCreateAudienceDefault(400).WithPayload(
+			ErrorMessage("cannot create audience due to invalid key. reason: %s", reason))
+	}
+	if err := validateAudienceKey(a.Key, 0); err != nil {
+		return audience.NewCreateAudienceDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := a.Validate(); err != nil {
+		return audience.NewCreateAudienceDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := validateConstraintLists(a.Constraints...); err != nil {
+		return audience.NewCreateAudienceDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := getDB().Create(a).Error; err != nil {
+		return audience.NewCreateAudienceDefault(500).WithPayload(
+			ErrorMessage("cannot create audience. %s", err))
+	}
+
+	resp := audience.NewCreateAudienceOK()
+	resp.SetPayload(e2r.MapAudience(a))
+	return resp
+}
+
+func (c *crud) GetAudience(params audience.GetAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	err := entity.PreloadAudienceConstraints(getDB()).First(a, params.AudienceID).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return audience.NewGetAudienceDefault(404).WithPayload(
+			ErrorMessage("unable to find audience %v in the database", params.AudienceID))
+	}
+	if err != nil {
+		return audience.NewGetAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := audience.NewGetAudienceOK()
+	resp.SetPayload(e2r.MapAudience(a))
+	return resp
+}
+
+// PutAudience updates the audience and snapshots every flag referencing it,
+// so that the change is picked up by the EvalCache of all the flags
+func (c *crud) PutAudience(params audience.PutAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	if err := getDB().First(a, params.AudienceID).Error; err != nil {
+		return audience.NewPutAudienceDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if params.Body.Key != nil {
+		if ok, reason := util.IsSafeKey(*params.Body.Key); !ok {
+			return audience.NewPutAudienceDefault(400).WithPayload(
+				ErrorMessage("invalid key. reason: %s", reason))
+		}
+		if err := validateAudienceKey(*params.Body.Key, a.ID); err != nil {
+			return audience.NewPutAudienceDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		a.Key = *params.Body.Key
+	}
+	if params.Body.Description != nil {
+		a.Description = *params.Body.Description
+	}
+
+	constraints := r2e.MapAudienceConstraints(params.Body.Constraints, a.ID)
+	if params.Body.Constraints != nil {
+		a.Constraints = constraints
+		if err := a.Validate(); err != nil {
+			return audience.NewPutAudienceDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+		if err := validateConstraintLists(a.Constraints...); err != nil {
+			return audience.NewPutAudienceDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		if err := validateAudienceEntityTypes(a, a.Constraints...); err != nil {
+			return audience.NewPutAudienceDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+		}
+		a.Constraints = nil
+	}
+
+	tx := getDB().Begin()
+	if err := tx.Save(a).Error; err != nil {
+		tx.Rollback()
+		return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if params.Body.Constraints != nil {
+		if err := tx.Where("audience_id = ?", a.ID).Delete(&entity.Constraint{}).Error; err != nil {
+			tx.Rollback()
+			return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+		}
+		if len(constraints) != 0 {
+			if err := tx.Create(&constraints).Error; err != nil {
+				tx.Rollback()
+				return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+			}
+		}
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := a.Preload(getDB()); err != nil {
+		return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	flagIDs, err := a.FlagIDs(getDB())
+	if err != nil {
+		return audience.NewPutAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	for _, flagID := range flagIDs {
+		entity.SaveFlagSnapshot(getDB(), flagID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentAudience, a.ID, a.Key)
+	}
+
+	resp := audience.NewPutAudienceOK()
+	resp.SetPayload(e2r.MapAudience(a))
+	return resp
+}
+
+// DeleteAudience deletes the audience and its constraints. Audiences that are
+// still referenced by segments cannot be deleted.
+func (c *crud) DeleteAudience(params audience.DeleteAudienceParams) middleware.Responder {
+	a := &entity.Audience{}
+	if err := getDB().First(a, params.AudienceID).Error; err != nil {
+		return audience.NewDeleteAudienceDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	flagIDs, err := a.FlagIDs(getDB())
+	if err != nil {
+		return audience.NewDeleteAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if len(flagIDs) != 0 {
+		return audience.NewDeleteAudienceDefault(400).WithPayload(
+			ErrorMessage("audience %s is still used by flags %v", a.Key, flagIDs))
+	}
+
+	// Hard delete, so that the key can be reused by a new audience
+	err = getDB().Transaction(func(tx *gorm.DB) error {
+		if err := tx.Exec("DELETE FROM segments_audiences WHERE audience_id = ?", a.ID).Error; err != nil {
+			return err
+		}
+		if err := tx.Unscoped().Where("audience_id = ?", a.ID).Delete(&entity.Constraint{}).Error; err != nil {
+			return err
+		}
+		return tx.Unscoped().Delete(a).Error
+	})
+	if err != nil {
+		return audience.NewDeleteAudienceDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return audience.NewDeleteAudienceOK()
+}
+
+// findAudiencesByIDs finds the audiences with their constraints. It fails if
+// any of the audiences doesn't exist.
+func findAudiencesByIDs(ids []int64) ([]entity.Audience, error) {
+	as := []entity.Audience{}
+	if len(ids) == 0 {
+		return as, nil
+	}
+
+	if err := entity.PreloadAudienceConstraints(getDB()).Order("id").Find(&as, ids).Error; err != nil {
+		return nil, err
+	}
+
+	found := make(map[int64]bool, len(as))
+	for _, a := range as {
+		found[int64(a.ID)] = true
+	}
+	for _, id := range ids {
+		if !found[id] {
+			return nil, fmt.Errorf("unable to find audience %v in the database", id)
+		}
+	}
+	return as, nil
+}
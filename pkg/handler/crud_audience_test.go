@@ -0,0 +1,340 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/audience"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudAudiences(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+
+	// step 1. it should be able to create the audience with constraints
+	res = c.CreateAudience(audience.CreateAudienceParams{
+		Body: &models.CreateAudienceRequest{
+			Key:         new("internal_employees"),
+			Description: "employees of the company",
+			Constraints: []*models.CreateConstraintRequest{
+				{
+					Property: new("email"),
+					Operator: new(models.ConstraintOperatorEREG),
+					Value:    new(`"@example\.com$"`),
+				},
+			},
+		},
+	})
+	a := res.(*audience.CreateAudienceOK).Payload
+	assert.NotZero(t, a.ID)
+	assert.Equal(t, "internal_employees", *a.Key)
+	assert.Len(t, a.Constraints, 1)
+
+	// step 2. it should be able to find and get the audience
+	res = c.FindAudiences(audience.FindAudiencesParams{KeyLike: new("EMPLOYEE")})
+	assert.Len(t, res.(*audience.FindAudiencesOK).Payload, 1)
+	res = c.FindAudiences(audience.FindAudiencesParams{KeyLike: new("eu_users")})
+	assert.Len(t, res.(*audience.FindAudiencesOK).Payload, 0)
+
+	res = c.GetAudience(audience.GetAudienceParams{AudienceID: a.ID})
+	assert.Len(t, res.(*audience.GetAudienceOK).Payload.Constraints, 1)
+
+	// step 3. it should be able to reference the audience from a segment
+	res = c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(100)),
+			AudienceIDs:    []int64{a.ID},
+		},
+	})
+	s := res.(*segment.CreateSegmentOK).Payload
+	assert.Len(t, s.Audiences, 1)
+
+	res = c.GetFlag(flag.GetFlagParams{FlagID: int64(1)})
+	f := res.(*flag.GetFlagOK).Payload
+	assert.Equal(t, "internal_employees", *f.Segments[0].Audiences[0].Key)
+	assert.Len(t, f.Segments[0].Audiences[0].Constraints, 1)
+
+	// step 4. it should re-snapshot the flag when the audience changes
+	var maxID uint
+	db.Model(&entity.FlagSnapshot{}).Select("MAX(id)").Scan(&maxID)
+
+	res = c.PutAudience(audience.PutAudienceParams{
+		AudienceID: a.ID,
+		Body: &models.PutAudienceRequest{
+			Constraints: []*models.CreateConstraintRequest{
+				{
+					Property: new("email"),
+					Operator: new(models.ConstraintOperatorEREG),
+					Value:    new(`"@example\.(com|org)$"`),
+				},
+				{
+					Property: new("employee"),
+					Operator: new(models.ConstraintOperatorEQ),
+					Value:    new("true"),
+				},
+			},
+		},
+	})
+	a = res.(*audience.PutAudienceOK).Payload
+	assert.Equal(t, "internal_employees", *a.Key)
+	assert.Len(t, a.Constraints, 2)
+
+	snapshots := []entity.FlagSnapshot{}
+	db.Where("id > ?", maxID).Find(&snapshots)
+	assert.Len(t, snapshots, 1)
+	assert.Equal(t, uint(1), snapshots[0].FlagID)
+	assert.Contains(t, string(snapshots[0].Flag), `@example\\.(com|org)$`)
+
+	// step 5. it should keep the constraints when they are not set
+	res = c.PutAudience(audience.PutAudienceParams{
+		AudienceID: a.ID,
+		Body: &models.PutAudienceRequest{
+			Description: new("employees and contractors"),
+		},
+	})
+	a = res.(*audience.PutAudienceOK).Payload
+	assert.Equal(t, "employees and contractors", a.Description)
+	assert.Len(t, a.Constraints, 2)
+
+	// step 6. it should not delete the audience while it's referenced
+	res = c.DeleteAudience(audience.DeleteAudienceParams{AudienceID: a.ID})
+	assert.NotZero(t, res.(*audience.DeleteAudienceDefault).Payload)
+
+	// step 7. it should keep the audiences of the segment when they are not set
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: s.ID,
+		Body: &models.PutSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(50)),
+		},
+	})
+	assert.Len(t, res.(*segment.PutSegmentOK).Payload.Audiences, 1)
+
+	// step 8. it should remove the audiences of the segment with an empty array
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: s.ID,
+		Body: &models.PutSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(50)),
+			AudienceIDs:    []int64{},
+		},
+	})
+	assert.Len(t, res.(*segment.PutSegmentOK).Payload.Audiences, 0)
+
+	// step 9. it should be able to delete the audience and reuse its key
+	res = c.DeleteAudience(audience.DeleteAudienceParams{AudienceID: a.ID})
+	assert.NotNil(t, res.(*audience.DeleteAudienceOK))
+
+	res = c.GetAudience(audience.GetAudienceParams{AudienceID: a.ID})
+	assert.NotZero(t, res.(*audience.GetAudienceDefault).Payload)
+
+	res = c.CreateAudience(audience.CreateAudienceParams{
+		Body: &models.CreateAudienceRequest{
+			Key: new("internal_employees"),
+		},
+	})
+	assert.NotZero(t, res.(*audience.CreateAudienceOK).Payload.ID)
+}
+
+func TestCrudAudienceOfDeletedFlag(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	res = c.CreateAudience(audience.CreateAudienceParams{
+		Body: &models.CreateAudienceRequest{
+			Key: new("internal_employees"),
+		},
+	})
+	a := res.(*audience.CreateAudienceOK).Payload
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(100)),
+			AudienceIDs:    []int64{a.ID},
+		},
+	})
+
+	res = c.DeleteAudience(audience.DeleteAudienceParams{AudienceID: a.ID})
+	assert.NotZero(t, res.(*audience.DeleteAudienceDefault).Payload)
+
+	res = c.DeleteFlag(flag.DeleteFlagParams{FlagID: int64(1)})
+	assert.NotNil(t, res.(*flag.DeleteFlagOK))
+
+	res = c.DeleteAudience(audience.DeleteAudienceParams{AudienceID: a.ID})
+	assert.NotNil(t, res.(*audience.DeleteAudienceOK))
+}
+
+func TestCrudAudiencesWithFailures(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	c.CreateAudience(audience.CreateAudienceParams{
+		Body: &models.CreateAudienceRequest{
+			Key: new("eu_users"),
+		},
+	})
+
+	t.Run("CreateAudience - invalid key", func(t *testing.T) {
+		res = c.CreateAudience(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{
+				Key: new("eu users"),
+			},
+		})
+		assert.NotZero(t, res.(*audience.CreateAudienceDefault).Payload)
+	})
+
+	t.Run("CreateAudience - invalid constraint", func(t *testing.T) {
+		res = c.CreateAudience(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{
+				Key: new("beta_testers"),
+				Constraints: []*models.CreateConstraintRequest{
+					{
+						Property: new("app_version"),
+						Operator: new(models.ConstraintOperatorSEMVERGTE),
+						Value:    new(`"latest"`),
+					},
+				},
+			},
+		})
+		assert.NotZero(t, res.(*audience.CreateAudienceDefault).Payload)
+	})
+
+	t.Run("CreateAudience - duplicate key", func(t *testing.T) {
+		res = c.CreateAudience(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{
+				Key: new("eu_users"),
+			},
+		})
+		assert.Contains(t, *res.(*audience.CreateAudienceDefault).Payload.Message, "status_code: 400. audience eu_users already exists")
+	})
+
+	t.Run("PutAudience - not found", func(t *testing.T) {
+		res = c.PutAudience(audience.PutAudienceParams{
+			AudienceID: int64(999),
+			Body:       &models.PutAudienceRequest{},
+		})
+		assert.NotZero(t, res.(*audience.PutAudienceDefault).Payload)
+	})
+
+	t.Run("PutAudience - duplicate key", func(t *testing.T) {
+		c.CreateAudience(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{
+				Key: new("us_users"),
+			},
+		})
+		res = c.PutAudience(audience.PutAudienceParams{
+			AudienceID: int64(2),
+			Body:       &models.PutAudienceRequest{Key: new("eu_users")},
+		})
+		assert.Contains(t, *res.(*audience.PutAudienceDefault).Payload.Message, "status_code: 400. audience eu_users already exists")
+
+		res = c.PutAudience(audience.PutAudienceParams{
+			AudienceID: int64(2),
+			Body:       &models.PutAudienceRequest{Key: new("us_users")},
+		})
+		assert.NotNil(t, res.(*audience.PutAudienceOK))
+	})
+
+	t.Run("PutAudience - invalid constraint", func(t *testing.T) {
+		res = c.PutAudience(audience.PutAudienceParams{
+			AudienceID: int64(1),
+			Body: &models.PutAudienceRequest{
+				Constraints: []*models.CreateConstraintRequest{
+					{
+						Property: new("country"),
+						Operator: new(models.ConstraintOperatorIN),
+						Value:    new(`["DE", "FR"`),
+					},
+				},
+			},
+		})
+		assert.NotZero(t, res.(*audience.PutAudienceDefault).Payload)
+	})
+
+	t.Run("CreateSegment - audience not found", func(t *testing.T) {
+		res = c.CreateSegment(segment.CreateSegmentParams{
+			FlagID: int64(1),
+			Body: &models.CreateSegmentRequest{
+				Description:    new("segment1"),
+				RolloutPercent: new(float64(100)),
+				AudienceIDs:    []int64{1, 999},
+			},
+		})
+		assert.NotZero(t, res.(*segment.CreateSegmentDefault).Payload)
+	})
+
+	t.Run("DeleteAudience - not found", func(t *testing.T) {
+		res = c.DeleteAudience(audience.DeleteAudienceParams{AudienceID: int64(999)})
+		assert.NotZero(t, res.(*audience.DeleteAudienceDefault).Payload)
+	})
+
+	t.Run("FindAudiences - db generic error", func(t *testing.T) {
+		db.Error = fmt.Errorf("db generic error")
+		res = c.FindAudiences(audience.FindAudiencesParams{})
+		assert.NotZero(t, res.(*audience.FindAudiencesDefault).Payload)
+		db.Error = nil
+	})
+
+	t.Run("GetAudience - db generic error", func(t *testing.T) {
+		db.Error = fmt.Errorf("db generic error")
+		res = c.GetAudience(audience.GetAudienceParams{AudienceID: int64(1)})
+		assert.NotZero(t, res.(*audience.GetAudienceDefault).Payload)
+		db.Error = nil
+	})
+}
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/r2e"
+	"github.com/foxdalas/flagr/pkg/notification"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+)
+
+func (c *crud) GetEntityTypeSchema(params flag.GetEntityTypeSchemaParams) middleware.Responder {
+	et := &entity.FlagEntityType{}
+	err := getDB().Where(&entity.FlagEntityType{Key: params.EntityType}).First(et).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return flag.NewGetEntityTypeSchemaDefault(404).WithPayload(
+			ErrorMessage("unable to find entity type %s in the database", params.EntityType))
+	}
+	if err != nil {
+		return flag.NewGetEntityTypeSchemaDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := flag.NewGetEntityTypeSchemaOK()
+	resp.SetPayload(e2r.MapEntityTypeSchema(et))
+	return resp
+}
+
+// PutEntityTypeSchema declares the attributes of the entity type, creating the
+// entity type if it doesn't exist. The constraints of the flags of the entity
+// type must conform to the attributes, and the flags are snapshotted so that
+// the evaluation picks the attributes up.
+func (c *crud) PutEntityTypeSchema(params flag.PutEntityTypeSchemaParams) middleware.Responder {
+	attributes := r2e.MapEntityAttributes(params.Body.Attributes)
+	if err := attributes.Validate(); err != nil {
+		return flag.NewPutEntityTypeSchemaDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+
+	tx := getDB()
+	if err := entity.CreateFlagEntityType(tx, params.EntityType); err != nil {
+		return flag.NewPutEntityTypeSchemaDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+	et := &entity.FlagEntityType{}
+	if err := tx.Where(&entity.FlagEntityType{Key: params.EntityType}).First(et).Error; err != nil {
+		return flag.NewPutEntityTypeSchemaDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	fs := []entity.Flag{}
+	if err := tx.Preload("Segments.Constraints").Preload("Segments.Audiences.Constraints").Where("entity_type = ?", et.Key).Find(&fs).Error; err != nil {
+		return flag.NewPutEntityTypeSchemaDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := validateFlagsEntityAttributes(fs, attributes); err != nil {
+		return flag.NewPutEntityTypeSchemaDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+
+	et.Attributes = attributes
+	if err := tx.Model(et).Select("attributes").Updates(et).Error; err != nil {
+		return flag.NewPutEntityTypeSchemaDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	for _, f := range fs {
+		entity.SaveFlagSnapshot(getDB(), f.ID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentEntityType, et.ID, et.Key)
+	}
+
+	resp := flag.NewPutEntityTypeSchemaOK()
+	resp.SetPayload(e2r.MapEntityTypeSchema(et))
+	return resp
+}
+
+// validateFlagsEntityAttributes validates the constraints of the segments of
+// the flags, and of their audiences, against the attributes
+func validateFlagsEntityAttributes(fs []entity.Flag, attributes entity.EntityAttributes) error {
+	for _, f := range fs {
+		for _, s := range f.Segments {
+			for _, cons := range s.Constraints {
+				if err := attributes.ValidateConstraint(cons); err != nil {
+					return fmt.Errorf("flag %s, segment %v: %s", f.Key, s.ID, err)
+				}
+			}
+			for _, a := range s.Audiences {
+				for _, cons := range a.Constraints {
+					if err := attributes.ValidateConstraint(cons); err != nil {
+						return fmt.Errorf("flag %s, segment %v, audience %s: %s", f.Key, s.ID, a.Key, err)
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
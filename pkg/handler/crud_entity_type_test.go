@@ -0,0 +1,266 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/audience"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/constraint"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudEntityTypeSchema(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagRequest{EntityType: new("user")},
+	})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(100)),
+		},
+	})
+	createConstraint := func(property, value string) middleware.Responder {
+		return c.CreateConstraint(constraint.CreateConstraintParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.CreateConstraintRequest{
+				Property: new(property),
+				Operator: new(models.ConstraintOperatorEQ),
+				Value:    new(value),
+			},
+		})
+	}
+
+	// step 1. the flag's entity type has no attributes yet, any property works
+	res = c.GetEntityTypeSchema(flag.GetEntityTypeSchemaParams{EntityType: "user"})
+	assert.Empty(t, res.(*flag.GetEntityTypeSchemaOK).Payload.Attributes)
+	res = c.GetEntityTypeSchema(flag.GetEntityTypeSchemaParams{EntityType: "unknown"})
+	assert.NotZero(t, res.(*flag.GetEntityTypeSchemaDefault).Payload)
+
+	res = createConstraint("uid", `"1"`)
+	uid := res.(*constraint.CreateConstraintOK).Payload
+
+	// step 2. the attributes must be valid, and fit the existing constraints
+	res = c.PutEntityTypeSchema(flag.PutEntityTypeSchemaParams{
+		EntityType: "user",
+		Body: &models.PutEntityTypeSchemaRequest{
+			Attributes: []*models.EntityAttribute{{Name: new("state"), Type: "text"}},
+		},
+	})
+	assert.NotZero(t, res.(*flag.PutEntityTypeSchemaDefault).Payload)
+
+	attributes := []*models.EntityAttribute{
+		{Name: new("userId"), Type: models.EntityAttributeTypeString, Required: true},
+		{Name: new("state"), Type: models.EntityAttributeTypeString, AllowedValues: []any{"CA", "NY"}},
+	}
+	res = c.PutEntityTypeSchema(flag.PutEntityTypeSchemaParams{
+		EntityType: "user",
+		Body:       &models.PutEntityTypeSchemaRequest{Attributes: attributes},
+	})
+	assert.NotZero(t, res.(*flag.PutEntityTypeSchemaDefault).Payload)
+
+	c.DeleteConstraint(constraint.DeleteConstraintParams{FlagID: int64(1), SegmentID: int64(1), ConstraintID: uid.ID})
+	var snapshots int64
+	db.Model(&entity.FlagSnapshot{}).Count(&snapshots)
+
+	res = c.PutEntityTypeSchema(flag.PutEntityTypeSchemaParams{
+		EntityType: "user",
+		Body:       &models.PutEntityTypeSchemaRequest{Attributes: attributes},
+	})
+	assert.Len(t, res.(*flag.PutEntityTypeSchemaOK).Payload.Attributes, 2)
+
+	res = c.GetEntityTypeSchema(flag.GetEntityTypeSchemaParams{EntityType: "user"})
+	schema := res.(*flag.GetEntityTypeSchemaOK).Payload
+	assert.Equal(t, "userId", *schema.Attributes[0].Name)
+	assert.True(t, schema.Attributes[0].Required)
+	assert.Equal(t, []any{"CA", "NY"}, schema.Attributes[1].AllowedValues)
+
+	// the flags of the entity type are snapshotted for the eval cache
+	var snapshotsAfter int64
+	db.Model(&entity.FlagSnapshot{}).Count(&snapshotsAfter)
+	assert.Equal(t, snapshots+1, snapshotsAfter)
+
+	ets, err := entity.LoadEntityTypeSchemas(db)
+	assert.NoError(t, err)
+	assert.Len(t, ets, 1)
+
+	// step 3. constraints are validated against the attributes
+	res = createConstraint("uid", `"1"`)
+	assert.NotZero(t, res.(*constraint.CreateConstraintDefault).Payload)
+	res = createConstraint("state", `"TX"`)
+	assert.NotZero(t, res.(*constraint.CreateConstraintDefault).Payload)
+	res = createConstraint("state", `"CA"`)
+	cons := res.(*constraint.CreateConstraintOK).Payload
+
+	res = c.PutConstraint(constraint.PutConstraintParams{
+		FlagID:       int64(1),
+		SegmentID:    int64(1),
+		ConstraintID: cons.ID,
+		Body: &models.CreateConstraintRequest{
+			Property: new("State"),
+			Operator: new(models.ConstraintOperatorEQ),
+			Value:    new(`"CA"`),
+		},
+	})
+	assert.NotZero(t, res.(*constraint.PutConstraintDefault).Payload)
+
+	// step 4. an empty list of attributes removes the schema
+	res = c.PutEntityTypeSchema(flag.PutEntityTypeSchemaParams{
+		EntityType: "user",
+		Body:       &models.PutEntityTypeSchemaRequest{Attributes: []*models.EntityAttribute{}},
+	})
+	assert.Empty(t, res.(*flag.PutEntityTypeSchemaOK).Payload.Attributes)
+	ets, err = entity.LoadEntityTypeSchemas(db)
+	assert.NoError(t, err)
+	assert.Empty(t, ets)
+
+	res = createConstraint("uid", `"1"`)
+	assert.NotZero(t, res.(*constraint.CreateConstraintOK).Payload)
+}
+
+func TestCrudEntityTypeSchemaOfFlagsAndAudiences(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagRequest{EntityType: new("user")},
+	})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(100)),
+		},
+	})
+	c.CreateConstraint(constraint.CreateConstraintParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateConstraintRequest{
+			Property: new("state"),
+			Operator: new(models.ConstraintOperatorEQ),
+			Value:    new(`"CA"`),
+		},
+	})
+	createAudience := func(key, property, value string) *models.Audience {
+		res := c.CreateAudience(audience.CreateAudienceParams{
+			Body: &models.CreateAudienceRequest{
+				Key: new(key),
+				Constraints: []*models.CreateConstraintRequest{{
+					Property: new(property),
+					Operator: new(models.ConstraintOperatorEQ),
+					Value:    new(value),
+				}},
+			},
+		})
+		return res.(*audience.CreateAudienceOK).Payload
+	}
+	stringAttribute := func(name string) []*models.EntityAttribute {
+		return []*models.EntityAttribute{{Name: new(name), Type: models.EntityAttributeTypeString}}
+	}
+
+	// step 1. the flag can't move to an entity type its constraints don't conform to
+	c.PutEntityTypeSchema(flag.PutEntityTypeSchemaParams{
+		EntityType: "device",
+		Body:       &models.PutEntityTypeSchemaRequest{Attributes: stringAttribute("os")},
+	})
+	res = c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagRequest{EntityType: new("device")},
+	})
+	assert.Contains(t, *res.(*flag.PutFlagDefault).Payload.Message, `property "state" is not a declared attribute`)
+
+	res = c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagRequest{EntityType: new("user")},
+	})
+	assert.Equal(t, "user", res.(*flag.PutFlagOK).Payload.EntityType)
+
+	// step 2. the segments can only reference audiences conforming to the entity type
+	ios := createAudience("ios", "os", `"ios"`)
+	ca := createAudience("ca", "state", `"CA"`)
+	c.PutEntityTypeSchema(flag.PutEntityTypeSchemaParams{
+		EntityType: "user",
+		Body:       &models.PutEntityTypeSchemaRequest{Attributes: stringAttribute("state")},
+	})
+
+	res = c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    new("segment2"),
+			RolloutPercent: new(float64(100)),
+			AudienceIDs:    []int64{ios.ID},
+		},
+	})
+	assert.Contains(t, *res.(*segment.CreateSegmentDefault).Payload.Message, `constraint of audience ios doesn't conform to entity type user`)
+
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.PutSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(100)),
+			AudienceIDs:    []int64{ca.ID},
+		},
+	})
+	assert.Len(t, res.(*segment.PutSegmentOK).Payload.Audiences, 1)
+
+	// step 3. the audience constraints must conform to the entity types of its flags
+	res = c.PutAudience(audience.PutAudienceParams{
+		AudienceID: ca.ID,
+		Body: &models.PutAudienceRequest{
+			Constraints: []*models.CreateConstraintRequest{{
+				Property: new("region"),
+				Operator: new(models.ConstraintOperatorEQ),
+				Value:    new(`"west"`),
+			}},
+		},
+	})
+	assert.Contains(t, *res.(*audience.PutAudienceDefault).Payload.Message, `property "region" is not a declared attribute`)
+
+	c.DeleteConstraint(constraint.DeleteConstraintParams{FlagID: int64(1), SegmentID: int64(1), ConstraintID: int64(1)})
+	res = c.PutEntityTypeSchema(flag.PutEntityTypeSchemaParams{
+		EntityType: "user",
+		Body:       &models.PutEntityTypeSchemaRequest{Attributes: stringAttribute("country")},
+	})
+	assert.Contains(t, *res.(*flag.PutEntityTypeSchemaDefault).Payload.Message, `segment 1, audience ca: property "state" is not a declared attribute`)
+}
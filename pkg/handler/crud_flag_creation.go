@@ -67,7 +67,7 @@ func LoadSimpleBooleanFlagTemplate(flag *entity.Flag, tx *gorm.DB) error {
 	// Create our default segment
 	s := &entity.Segment{}
 	s.FlagID = flag.ID
-	s.RolloutPercent = uint(100)
+	s.RolloutPercent = 100
 	s.Rank = entity.SegmentDefaultRank
 
 	if err := tx.Create(s).Error; err != nil {
@@ -88,7 +88,7 @@ func LoadSimpleBooleanFlagTemplate(flag *entity.Flag, tx *gorm.DB) error {
 	d.SegmentID = s.ID
 	d.VariantID = v.ID
 	d.VariantKey = v.Key
-	d.Percent = uint(100)
+	d.Percent = 100
 
 	if err := tx.Create(d).Error; err != nil {
 		return err
@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/util"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+)
+
+// PostFlagSnapshotEvaluation evaluates the entity against a snapshot of the
+// flag and against the current flag, to debug why the entity got a variant in
+// the past. The evaluations are not recorded by the data recorder.
+func (c *crud) PostFlagSnapshotEvaluation(params flag.PostFlagSnapshotEvaluationParams) middleware.Responder {
+	req := params.Body
+	if req == nil || util.SafeString(req.EntityID) == "" {
+		return flag.NewPostFlagSnapshotEvaluationDefault(400).WithPayload(
+			ErrorMessage("entityID is required to evaluate a flag snapshot"))
+	}
+	timestamp := time.Time(req.Timestamp)
+	if (req.FlagSnapshotID == 0) == timestamp.IsZero() {
+		return flag.NewPostFlagSnapshotEvaluationDefault(400).WithPayload(
+			ErrorMessage("either flagSnapshotID or timestamp is required"))
+	}
+
+	fs, err := findFlagSnapshot(params.FlagID, req.FlagSnapshotID, timestamp)
+	if err != nil {
+		return flag.NewPostFlagSnapshotEvaluationDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	snapshotFlag, err := prepareFlagSnapshot(fs)
+	if err != nil {
+		return flag.NewPostFlagSnapshotEvaluationDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	evalContext := models.EvalContext{
+		EntityID:      util.SafeString(req.EntityID),
+		EntityType:    req.EntityType,
+		EntityContext: req.EntityContext,
+		EnableDebug:   req.EnableDebug,
+		FlagID:        params.FlagID,
+	}
+	snapshotResult := EvalFlagWithContext(withoutDataRecords(snapshotFlag), evalContext)
+	currentResult := EvalFlagWithContext(withoutDataRecords(LookupFlag(evalContext)), evalContext)
+
+	resp := flag.NewPostFlagSnapshotEvaluationOK()
+	resp.SetPayload(&models.SnapshotEvaluationResult{
+		FlagSnapshotID:    int64(fs.ID),
+		SnapshotUpdatedBy: fs.UpdatedBy,
+		SnapshotUpdatedAt: fs.UpdatedAt.UTC().Format(time.RFC3339),
+		SnapshotResult:    snapshotResult,
+		CurrentResult:     currentResult,
+		VariantChanged:    snapshotResult.VariantKey != currentResult.VariantKey,
+	})
+	return resp
+}
+
+// findFlagSnapshot finds the snapshot of the flag by its ID, or the latest
+// snapshot of the flag taken at or before the timestamp
+func findFlagSnapshot(flagID int64, snapshotID int64, timestamp time.Time) (*entity.FlagSnapshot, *Error) {
+	fs := &entity.FlagSnapshot{}
+	tx := getDB().Where("flag_id = ?", flagID)
+	if snapshotID != 0 {
+		tx = tx.Where("id = ?", snapshotID)
+	} else {
+		tx = tx.Where("created_at <= ?", timestamp).Order("created_at desc").Order("id desc")
+	}
+
+	err := tx.First(fs).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		if snapshotID != 0 {
+			return nil, NewError(404, "unable to find snapshot %v of flag %v in the database", snapshotID, flagID)
+		}
+		return nil, NewError(404, "unable to find a snapshot of flag %v taken at or before %s", flagID, timestamp.UTC().Format(time.RFC3339))
+	}
+	if err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	return fs, nil
+}
+
+// prepareFlagSnapshot unmarshals the flag of the snapshot and prepares it for
+// the evaluation. A snapshot of a deleted flag returns a nil flag, which is
+// evaluated like a flag that is not found.
+func prepareFlagSnapshot(fs *entity.FlagSnapshot) (*entity.Flag, *Error) {
+	f := &entity.Flag{}
+	if err := json.Unmarshal(fs.Flag, f); err != nil {
+		return nil, NewError(500, "cannot unmarshal the flag of snapshot %v. %s", fs.ID, err)
+	}
+	if f.DeletedAt.Valid {
+		return nil, nil
+	}
+	if err := f.PrepareEvaluation(); err != nil {
+		return nil, NewError(500, "cannot prepare the flag of snapshot %v for the evaluation. %s", fs.ID, err)
+	}
+	return f, nil
+}
+
+// withoutDataRecords returns a shallow copy of the flag with data records
+// disabled, so that an evaluation for debugging doesn't look like an exposure
+func withoutDataRecords(f *entity.Flag) *entity.Flag {
+	if f == nil {
+		return nil
+	}
+	c := *f
+	c.DataRecordsEnabled = false
+	return &c
+}
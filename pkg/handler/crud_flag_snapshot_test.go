@@ -0,0 +1,122 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+)
+
+func TestPostFlagSnapshotEvaluation(t *testing.T) {
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+
+	var recorded []bool
+	defer gostub.Stub(&logEvalResult, func(r *models.EvalResult, dataRecordsEnabled bool) {
+		recorded = append(recorded, dataRecordsEnabled)
+	}).Reset()
+
+	createSnapshot := func(createdAt time.Time, modify func(f *entity.Flag)) entity.FlagSnapshot {
+		f := entity.GenFixtureFlag()
+		f.DataRecordsEnabled = true
+		modify(&f)
+		b, err := json.Marshal(f)
+		assert.NoError(t, err)
+		fs := entity.FlagSnapshot{Model: gorm.Model{CreatedAt: createdAt, UpdatedAt: createdAt}, FlagID: f.ID, UpdatedBy: "alice", Flag: b}
+		assert.NoError(t, db.Create(&fs).Error)
+		return fs
+	}
+	t0 := time.Date(2026, 10, 6, 10, 0, 0, 0, time.UTC)
+	// everyone in CA got control
+	allControl := createSnapshot(t0, func(f *entity.Flag) {
+		f.Segments[0].Distributions[0].Percent = 100
+		f.Segments[0].Distributions[1].Percent = 0
+	})
+	// everyone in CA got treatment
+	allTreatment := createSnapshot(t0.Add(24*time.Hour), func(f *entity.Flag) {
+		f.Segments[0].Distributions[0].Percent = 0
+		f.Segments[0].Distributions[1].Percent = 100
+	})
+	deleted := createSnapshot(t0.Add(48*time.Hour), func(f *entity.Flag) {
+		f.DeletedAt = gorm.DeletedAt{Time: t0.Add(48 * time.Hour), Valid: true}
+	})
+
+	evaluate := func(req *models.SnapshotEvaluationRequest) any {
+		if req != nil && req.EntityID == nil {
+			req.EntityID = new("entityID1")
+			req.EntityContext = map[string]any{"dl_state": "CA"}
+		}
+		return c.PostFlagSnapshotEvaluation(flag.PostFlagSnapshotEvaluationParams{FlagID: 100, Body: req})
+	}
+
+	t.Run("empty body", func(t *testing.T) {
+		res := evaluate(nil)
+		assert.NotZero(t, res.(*flag.PostFlagSnapshotEvaluationDefault).Payload)
+	})
+
+	t.Run("neither or both of flagSnapshotID and timestamp", func(t *testing.T) {
+		res := evaluate(&models.SnapshotEvaluationRequest{})
+		assert.NotZero(t, res.(*flag.PostFlagSnapshotEvaluationDefault).Payload)
+
+		res = evaluate(&models.SnapshotEvaluationRequest{
+			FlagSnapshotID: int64(allControl.ID),
+			Timestamp:      strfmt.DateTime(t0),
+		})
+		assert.NotZero(t, res.(*flag.PostFlagSnapshotEvaluationDefault).Payload)
+	})
+
+	t.Run("snapshot not found", func(t *testing.T) {
+		res := evaluate(&models.SnapshotEvaluationRequest{FlagSnapshotID: 999})
+		assert.NotZero(t, res.(*flag.PostFlagSnapshotEvaluationDefault).Payload)
+
+		res = evaluate(&models.SnapshotEvaluationRequest{Timestamp: strfmt.DateTime(t0.Add(-time.Hour))})
+		assert.NotZero(t, res.(*flag.PostFlagSnapshotEvaluationDefault).Payload)
+	})
+
+	t.Run("by flagSnapshotID", func(t *testing.T) {
+		recorded = nil
+		res := evaluate(&models.SnapshotEvaluationRequest{FlagSnapshotID: int64(allControl.ID)})
+		payload := res.(*flag.PostFlagSnapshotEvaluationOK).Payload
+		assert.Equal(t, int64(allControl.ID), payload.FlagSnapshotID)
+		assert.Equal(t, "alice", payload.SnapshotUpdatedBy)
+		assert.Equal(t, "control", payload.SnapshotResult.VariantKey)
+		assert.NotEmpty(t, payload.CurrentResult.VariantKey)
+		assert.Equal(t, payload.CurrentResult.VariantKey != "control", payload.VariantChanged)
+		assert.Equal(t, []bool{false, false}, recorded)
+	})
+
+	t.Run("by timestamp", func(t *testing.T) {
+		res := evaluate(&models.SnapshotEvaluationRequest{Timestamp: strfmt.DateTime(t0.Add(36 * time.Hour))})
+		payload := res.(*flag.PostFlagSnapshotEvaluationOK).Payload
+		assert.Equal(t, int64(allTreatment.ID), payload.FlagSnapshotID)
+		assert.Equal(t, "treatment", payload.SnapshotResult.VariantKey)
+
+		res = evaluate(&models.SnapshotEvaluationRequest{Timestamp: strfmt.DateTime(t0)})
+		payload = res.(*flag.PostFlagSnapshotEvaluationOK).Payload
+		assert.Equal(t, int64(allControl.ID), payload.FlagSnapshotID)
+	})
+
+	t.Run("snapshot of a deleted flag", func(t *testing.T) {
+		res := evaluate(&models.SnapshotEvaluationRequest{FlagSnapshotID: int64(deleted.ID)})
+		payload := res.(*flag.PostFlagSnapshotEvaluationOK).Payload
+		assert.Zero(t, payload.SnapshotResult.VariantID)
+		assert.NotZero(t, payload.CurrentResult.VariantID)
+		assert.True(t, payload.VariantChanged)
+	})
+}
@@ -0,0 +1,135 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/foxdalas/flagr/pkg/notification"
+	"github.com/foxdalas/flagr/pkg/util"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/holdout"
+
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+)
+
+func (c *crud) FindHoldouts(params holdout.FindHoldoutsParams) middleware.Responder {
+	tx := getDB()
+	hs := []entity.Holdout{}
+
+	if params.Limit != nil {
+		tx = tx.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		tx = tx.Offset(int(*params.Offset))
+	}
+
+	if err := tx.Order("id").Find(&hs).Error; err != nil {
+		return holdout.NewFindHoldoutsDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := holdout.NewFindHoldoutsOK()
+	resp.SetPayload(e2r.MapHoldouts(hs))
+	return resp
+}
+
+func (c *crud) CreateHoldout(params holdout.CreateHoldoutParams) middleware.Responder {
+	h := &entity.Holdout{}
+	if params.Body != nil {
+		h.Key = util.SafeString(params.Body.Key)
+		h.Description = params.Body.Description
+		h.Percent = util.SafeFloat64(params.Body.Percent)
+	}
+	if ok, reason := util.IsSafeKey(h.Key); !ok {
+		return holdout.NewCreateHoldoutDefault(400).WithPayload(
+			ErrorMessage("cannot create holdout due to invalid key. reason: %s", reason))
+	}
+	if err := h.Validate(); err != nil {
+		return holdout.NewCreateHoldoutDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := getDB().Create(h).Error; err != nil {
+		return holdout.NewCreateHoldoutDefault(500).WithPayload(
+			ErrorMessage("cannot create holdout. %s", err))
+	}
+
+	resp := holdout.NewCreateHoldoutOK()
+	resp.SetPayload(e2r.MapHoldout(h))
+	return resp
+}
+
+func (c *crud) GetHoldout(params holdout.GetHoldoutParams) middleware.Responder {
+	h := &entity.Holdout{}
+	err := getDB().First(h, params.HoldoutID).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return holdout.NewGetHoldoutDefault(404).WithPayload(
+			ErrorMessage("unable to find holdout %v in the database", params.HoldoutID))
+	}
+	if err != nil {
+		return holdout.NewGetHoldoutDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := holdout.NewGetHoldoutOK()
+	resp.SetPayload(e2r.MapHoldout(h))
+	return resp
+}
+
+// PutHoldout updates the holdout and snapshots every flag in it, so that the
+// change is picked up by the EvalCache of all the flags
+func (c *crud) PutHoldout(params holdout.PutHoldoutParams) middleware.Responder {
+	h := &entity.Holdout{}
+	if err := getDB().First(h, params.HoldoutID).Error; err != nil {
+		return holdout.NewPutHoldoutDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if params.Body.Description != nil {
+		h.Description = *params.Body.Description
+	}
+	if params.Body.Percent != nil {
+		h.Percent = *params.Body.Percent
+		if err := h.Validate(); err != nil {
+			return holdout.NewPutHoldoutDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+
+	if err := getDB().Save(h).Error; err != nil {
+		return holdout.NewPutHoldoutDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	flagIDs, err := h.FlagIDs(getDB())
+	if err != nil {
+		return holdout.NewPutHoldoutDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	for _, flagID := range flagIDs {
+		entity.SaveFlagSnapshot(getDB(), flagID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentHoldout, h.ID, h.Key)
+	}
+
+	resp := holdout.NewPutHoldoutOK()
+	resp.SetPayload(e2r.MapHoldout(h))
+	return resp
+}
+
+// DeleteHoldout deletes the holdout. Holdouts that still have flags in them
+// cannot be deleted.
+func (c *crud) DeleteHoldout(params holdout.DeleteHoldoutParams) middleware.Responder {
+	h := &entity.Holdout{}
+	if err := getDB().First(h, params.HoldoutID).Error; err != nil {
+		return holdout.NewDeleteHoldoutDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	flagIDs, err := h.FlagIDs(getDB())
+	if err != nil {
+		return holdout.NewDeleteHoldoutDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if len(flagIDs) != 0 {
+		return holdout.NewDeleteHoldoutDefault(400).WithPayload(
+			ErrorMessage("holdout %s is still used by flags %v", h.Key, flagIDs))
+	}
+
+	// Hard delete, so that the key can be reused by a new holdout
+	if err := getDB().Unscoped().Delete(h).Error; err != nil {
+		return holdout.NewDeleteHoldoutDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return holdout.NewDeleteHoldoutOK()
+}
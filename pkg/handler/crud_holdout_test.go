@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/holdout"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/variant"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudHoldouts(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(1),
+		Body:   &models.CreateVariantRequest{Key: new("control")},
+	})
+
+	// step 1. it should be able to create the holdout
+	res = c.CreateHoldout(holdout.CreateHoldoutParams{
+		Body: &models.CreateHoldoutRequest{
+			Key:         new("global"),
+			Description: "long-term holdout",
+			Percent:     new(0.5),
+		},
+	})
+	h := res.(*holdout.CreateHoldoutOK).Payload
+	assert.NotZero(t, h.ID)
+	assert.Equal(t, 0.5, *h.Percent)
+
+	res = c.CreateHoldout(holdout.CreateHoldoutParams{
+		Body: &models.CreateHoldoutRequest{Key: new("invalid key"), Percent: new(float64(5))},
+	})
+	assert.NotZero(t, res.(*holdout.CreateHoldoutDefault).Payload)
+
+	res = c.CreateHoldout(holdout.CreateHoldoutParams{
+		Body: &models.CreateHoldoutRequest{Key: new("too_precise"), Percent: new(0.25)},
+	})
+	assert.Contains(t, *res.(*holdout.CreateHoldoutDefault).Payload.Message, "is not a multiple of 0.1")
+
+	res = c.FindHoldouts(holdout.FindHoldoutsParams{})
+	assert.Len(t, res.(*holdout.FindHoldoutsOK).Payload, 1)
+
+	// step 2. it should be able to put the flag into the holdout
+	res = c.PutFlagHoldout(flag.PutFlagHoldoutParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagHoldoutRequest{HoldoutID: new(int64(999)), VariantID: new(int64(1))},
+	})
+	assert.NotZero(t, res.(*flag.PutFlagHoldoutDefault).Payload)
+
+	res = c.PutFlagHoldout(flag.PutFlagHoldoutParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagHoldoutRequest{HoldoutID: new(h.ID), VariantID: new(int64(999))},
+	})
+	assert.NotZero(t, res.(*flag.PutFlagHoldoutDefault).Payload)
+
+	res = c.PutFlagHoldout(flag.PutFlagHoldoutParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagHoldoutRequest{HoldoutID: new(h.ID), VariantID: new(int64(1))},
+	})
+	f := res.(*flag.PutFlagHoldoutOK).Payload
+	assert.Equal(t, "global", f.Holdout.HoldoutKey)
+	assert.Equal(t, "control", f.Holdout.VariantKey)
+
+	// the holdout variant cannot be deleted
+	res = c.DeleteVariant(variant.DeleteVariantParams{FlagID: int64(1), VariantID: int64(1)})
+	assert.NotZero(t, res.(*variant.DeleteVariantDefault).Payload)
+
+	// step 3. it should snapshot the flags in the holdout when it changes
+	res = c.PutHoldout(holdout.PutHoldoutParams{
+		HoldoutID: h.ID,
+		Body:      &models.PutHoldoutRequest{Percent: new(float64(10))},
+	})
+	assert.Equal(t, float64(10), *res.(*holdout.PutHoldoutOK).Payload.Percent)
+
+	snapshot := entity.FlagSnapshot{}
+	db.Where("flag_id = ?", 1).Order("id desc").First(&snapshot)
+	assert.Contains(t, string(snapshot.Flag), `"Percent":10`)
+
+	// step 4. it should not delete a holdout with flags in it
+	res = c.DeleteHoldout(holdout.DeleteHoldoutParams{HoldoutID: h.ID})
+	assert.NotZero(t, res.(*holdout.DeleteHoldoutDefault).Payload)
+
+	res = c.DeleteFlagHoldout(flag.DeleteFlagHoldoutParams{FlagID: int64(1)})
+	assert.Nil(t, res.(*flag.DeleteFlagHoldoutOK).Payload.Holdout)
+
+	res = c.DeleteHoldout(holdout.DeleteHoldoutParams{HoldoutID: h.ID})
+	assert.IsType(t, &holdout.DeleteHoldoutOK{}, res)
+
+	res = c.GetHoldout(holdout.GetHoldoutParams{HoldoutID: h.ID})
+	assert.NotZero(t, res.(*holdout.GetHoldoutDefault).Payload)
+}
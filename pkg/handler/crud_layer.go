@@ -0,0 +1,161 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/foxdalas/flagr/pkg/notification"
+	"github.com/foxdalas/flagr/pkg/util"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/layer"
+
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+)
+
+func (c *crud) FindLayers(params layer.FindLayersParams) middleware.Responder {
+	tx := getDB()
+	ls := []entity.Layer{}
+
+	if params.Limit != nil {
+		tx = tx.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		tx = tx.Offset(int(*params.Offset))
+	}
+
+	if err := tx.Order("id").Find(&ls).Error; err != nil {
+		return layer.NewFindLayersDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	payload := make([]*models.Layer, len(ls))
+	for i := range ls {
+		as, err := ls[i].Allocations(getDB())
+		if err != nil {
+			return layer.NewFindLayersDefault(500).WithPayload(ErrorMessage("%s", err))
+		}
+		payload[i] = e2r.MapLayer(&ls[i], as)
+	}
+
+	resp := layer.NewFindLayersOK()
+	resp.SetPayload(payload)
+	return resp
+}
+
+func (c *crud) CreateLayer(params layer.CreateLayerParams) middleware.Responder {
+	l := &entity.Layer{Slots: entity.LayerDefaultSlots}
+	if params.Body != nil {
+		l.Key = util.SafeString(params.Body.Key)
+		l.Description = params.Body.Description
+		if params.Body.Slots != nil {
+			l.Slots = uint(*params.Body.Slots)
+		}
+	}
+	if ok, reason := util.IsSafeKey(l.Key); !ok {
+		return layer.NewCreateLayerDefault(400).WithPayload(
+			ErrorMessage("cannot create layer due to invalid key. reason: %s", reason))
+	}
+	if l.Slots == 0 {
+		return layer.NewCreateLayerDefault(400).WithPayload(ErrorMessage("layer must have at least one slot"))
+	}
+
+	if err := getDB().Create(l).Error; err != nil {
+		return layer.NewCreateLayerDefault(500).WithPayload(
+			ErrorMessage("cannot create layer. %s", err))
+	}
+
+	resp := layer.NewCreateLayerOK()
+	resp.SetPayload(e2r.MapLayer(l, nil))
+	return resp
+}
+
+func (c *crud) GetLayer(params layer.GetLayerParams) middleware.Responder {
+	l := &entity.Layer{}
+	err := getDB().First(l, params.LayerID).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return layer.NewGetLayerDefault(404).WithPayload(
+			ErrorMessage("unable to find layer %v in the database", params.LayerID))
+	}
+	if err != nil {
+		return layer.NewGetLayerDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	as, err := l.Allocations(getDB())
+	if err != nil {
+		return layer.NewGetLayerDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := layer.NewGetLayerOK()
+	resp.SetPayload(e2r.MapLayer(l, as))
+	return resp
+}
+
+// PutLayer updates the layer and snapshots every flag in it, so that the
+// change is picked up by the EvalCache of all the flags. Changing the number
+// of slots reshuffles the entities between the flags.
+func (c *crud) PutLayer(params layer.PutLayerParams) middleware.Responder {
+	l := &entity.Layer{}
+	if err := getDB().First(l, params.LayerID).Error; err != nil {
+		return layer.NewPutLayerDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	as, err := l.Allocations(getDB())
+	if err != nil {
+		return layer.NewPutLayerDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if params.Body.Description != nil {
+		l.Description = *params.Body.Description
+	}
+	if params.Body.Slots != nil {
+		l.Slots = uint(*params.Body.Slots)
+		if l.Slots == 0 {
+			return layer.NewPutLayerDefault(400).WithPayload(ErrorMessage("layer must have at least one slot"))
+		}
+		if err := entity.ValidateLayerAllocations(l.Slots, as); err != nil {
+			return layer.NewPutLayerDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+
+	if err := getDB().Save(l).Error; err != nil {
+		return layer.NewPutLayerDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	for _, a := range as {
+		entity.SaveFlagSnapshot(getDB(), a.FlagID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentLayer, l.ID, l.Key)
+	}
+
+	resp := layer.NewPutLayerOK()
+	resp.SetPayload(e2r.MapLayer(l, as))
+	return resp
+}
+
+// DeleteLayer deletes the layer. Layers that still have flags in them cannot
+// be deleted.
+func (c *crud) DeleteLayer(params layer.DeleteLayerParams) middleware.Responder {
+	l := &entity.Layer{}
+	if err := getDB().First(l, params.LayerID).Error; err != nil {
+		return layer.NewDeleteLayerDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	as, err := l.Allocations(getDB())
+	if err != nil {
+		return layer.NewDeleteLayerDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if len(as) != 0 {
+		flagKeys := make([]string, len(as))
+		for i, a := range as {
+			flagKeys[i] = a.FlagKey
+		}
+		return layer.NewDeleteLayerDefault(400).WithPayload(
+			ErrorMessage("layer %s still has flags %v", l.Key, flagKeys))
+	}
+
+	// Hard delete, so that the key can be reused by a new layer
+	if err := getDB().Unscoped().Delete(l).Error; err != nil {
+		return layer.NewDeleteLayerDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return layer.NewDeleteLayerOK()
+}
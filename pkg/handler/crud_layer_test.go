@@ -0,0 +1,146 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/layer"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudLayers(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	for _, key := range []string{"flag_1", "flag_2"} {
+		c.CreateFlag(flag.CreateFlagParams{
+			Body: &models.CreateFlagRequest{
+				Description: new("funny flag"),
+				Key:         key,
+			},
+		})
+	}
+
+	// step 1. it should be able to create the layer with the default slots
+	res = c.CreateLayer(layer.CreateLayerParams{
+		Body: &models.CreateLayerRequest{
+			Key:         new("checkout"),
+			Description: "checkout experiments",
+		},
+	})
+	l := res.(*layer.CreateLayerOK).Payload
+	assert.NotZero(t, l.ID)
+	assert.Equal(t, int64(entity.LayerDefaultSlots), *l.Slots)
+
+	res = c.CreateLayer(layer.CreateLayerParams{
+		Body: &models.CreateLayerRequest{Key: new("invalid key")},
+	})
+	assert.NotZero(t, res.(*layer.CreateLayerDefault).Payload)
+
+	// step 2. it should be able to put flags into disjoint slot ranges
+	res = c.PutFlagLayer(flag.PutFlagLayerParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagLayerRequest{LayerID: new(l.ID), SlotStart: new(int64(0)), SlotEnd: new(int64(500))},
+	})
+	f := res.(*flag.PutFlagLayerOK).Payload
+	assert.Equal(t, "checkout", f.Layer.LayerKey)
+	assert.Equal(t, int64(500), *f.Layer.SlotEnd)
+
+	res = c.PutFlagLayer(flag.PutFlagLayerParams{
+		FlagID: int64(2),
+		Body:   &models.PutFlagLayerRequest{LayerID: new(l.ID), SlotStart: new(int64(400)), SlotEnd: new(int64(1000))},
+	})
+	assert.NotZero(t, res.(*flag.PutFlagLayerDefault).Payload)
+
+	res = c.PutFlagLayer(flag.PutFlagLayerParams{
+		FlagID: int64(2),
+		Body:   &models.PutFlagLayerRequest{LayerID: new(l.ID), SlotStart: new(int64(500)), SlotEnd: new(int64(1001))},
+	})
+	assert.NotZero(t, res.(*flag.PutFlagLayerDefault).Payload)
+
+	res = c.PutFlagLayer(flag.PutFlagLayerParams{
+		FlagID: int64(2),
+		Body:   &models.PutFlagLayerRequest{LayerID: new(int64(999)), SlotStart: new(int64(500)), SlotEnd: new(int64(1000))},
+	})
+	assert.NotZero(t, res.(*flag.PutFlagLayerDefault).Payload)
+
+	res = c.PutFlagLayer(flag.PutFlagLayerParams{
+		FlagID: int64(2),
+		Body:   &models.PutFlagLayerRequest{LayerID: new(l.ID), SlotStart: new(int64(500)), SlotEnd: new(int64(1000))},
+	})
+	assert.IsType(t, &flag.PutFlagLayerOK{}, res)
+
+	// a flag can move within the layer without overlapping itself
+	res = c.PutFlagLayer(flag.PutFlagLayerParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagLayerRequest{LayerID: new(l.ID), SlotStart: new(int64(100)), SlotEnd: new(int64(500))},
+	})
+	assert.IsType(t, &flag.PutFlagLayerOK{}, res)
+
+	res = c.GetLayer(layer.GetLayerParams{LayerID: l.ID})
+	as := res.(*layer.GetLayerOK).Payload.Allocations
+	assert.Len(t, as, 2)
+	assert.Equal(t, "flag_1", as[0].FlagKey)
+	assert.Equal(t, int64(100), as[0].SlotStart)
+
+	res = c.FindLayers(layer.FindLayersParams{})
+	assert.Len(t, res.(*layer.FindLayersOK).Payload, 1)
+
+	// step 3. flags in the layer must bucket by the same attribute
+	res = c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagRequest{BucketBy: new("company.id")},
+	})
+	assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+
+	// step 4. it should not shrink the layer below the allocated slots
+	res = c.PutLayer(layer.PutLayerParams{
+		LayerID: l.ID,
+		Body:    &models.PutLayerRequest{Slots: new(int64(100))},
+	})
+	assert.NotZero(t, res.(*layer.PutLayerDefault).Payload)
+
+	res = c.PutLayer(layer.PutLayerParams{
+		LayerID: l.ID,
+		Body:    &models.PutLayerRequest{Description: new("checkout page"), Slots: new(int64(2000))},
+	})
+	assert.Equal(t, "checkout page", res.(*layer.PutLayerOK).Payload.Description)
+
+	snapshots := []entity.FlagSnapshot{}
+	db.Where("flag_id = ?", 2).Order("id").Find(&snapshots)
+	ef := &entity.Flag{}
+	assert.NoError(t, json.Unmarshal(snapshots[len(snapshots)-1].Flag, ef))
+	assert.Equal(t, uint(2000), ef.Layer.Slots, "snapshots of the flags in the layer should be updated")
+
+	// step 5. it should not delete a layer with flags in it
+	res = c.DeleteLayer(layer.DeleteLayerParams{LayerID: l.ID})
+	assert.NotZero(t, res.(*layer.DeleteLayerDefault).Payload)
+
+	res = c.DeleteFlagLayer(flag.DeleteFlagLayerParams{FlagID: int64(1)})
+	assert.Nil(t, res.(*flag.DeleteFlagLayerOK).Payload.Layer)
+
+	// deleting the flag releases its slots
+	res = c.DeleteFlag(flag.DeleteFlagParams{FlagID: int64(2)})
+	assert.IsType(t, &flag.DeleteFlagOK{}, res)
+
+	res = c.DeleteLayer(layer.DeleteLayerParams{LayerID: l.ID})
+	assert.IsType(t, &layer.DeleteLayerOK{}, res)
+
+	res = c.GetLayer(layer.GetLayerParams{LayerID: l.ID})
+	assert.NotZero(t, res.(*layer.GetLayerDefault).Payload)
+}
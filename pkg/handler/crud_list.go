@@ -0,0 +1,181 @@
+package handler
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/foxdalas/flagr/pkg/notification"
+	"github.com/foxdalas/flagr/pkg/util"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/list"
+
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+)
+
+func (c *crud) FindLists(params list.FindListsParams) middleware.Responder {
+	tx := getDB()
+	ls := []entity.List{}
+
+	if params.Limit != nil {
+		tx = tx.Limit(int(*params.Limit))
+	}
+	if params.Offset != nil {
+		tx = tx.Offset(int(*params.Offset))
+	}
+
+	if err := tx.Order("id").Find(&ls).Error; err != nil {
+		return list.NewFindListsDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := list.NewFindListsOK()
+	resp.SetPayload(e2r.MapLists(ls))
+	return resp
+}
+
+func (c *crud) CreateList(params list.CreateListParams) middleware.Responder {
+	l := &entity.List{}
+	if params.Body != nil {
+		l.Key = util.SafeString(params.Body.Key)
+		l.Description = params.Body.Description
+	}
+	if ok, reason := util.IsSafeKey(l.Key); !ok {
+		return list.NewCreateListDefault(400).WithPayload(
+			ErrorMessage("cannot create list due to invalid key. reason: %s", reason))
+	}
+
+	if err := getDB().Create(l).Error; err != nil {
+		return list.NewCreateListDefault(500).WithPayload(
+			ErrorMessage("cannot create list. %s", err))
+	}
+
+	resp := list.NewCreateListOK()
+	resp.SetPayload(e2r.MapList(l))
+	return resp
+}
+
+func (c *crud) GetList(params list.GetListParams) middleware.Responder {
+	l := &entity.List{}
+	err := getDB().First(l, params.ListID).Error
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return list.NewGetListDefault(404).WithPayload(
+			ErrorMessage("unable to find list %v in the database", params.ListID))
+	}
+	if err != nil {
+		return list.NewGetListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := list.NewGetListOK()
+	resp.SetPayload(e2r.MapList(l))
+	return resp
+}
+
+func (c *crud) PutList(params list.PutListParams) middleware.Responder {
+	l := &entity.List{}
+	if err := getDB().First(l, params.ListID).Error; err != nil {
+		return list.NewPutListDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if params.Body.Description != nil {
+		l.Description = *params.Body.Description
+	}
+
+	if err := getDB().Save(l).Error; err != nil {
+		return list.NewPutListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := list.NewPutListOK()
+	resp.SetPayload(e2r.MapList(l))
+	return resp
+}
+
+// PutListValues replaces the values of the list, bumps its version and
+// snapshots every flag referencing it, so that the new values are picked up
+// by the EvalCache
+func (c *crud) PutListValues(params list.PutListValuesParams) middleware.Responder {
+	l := &entity.List{}
+	if err := getDB().First(l, params.ListID).Error; err != nil {
+		return list.NewPutListValuesDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	values, err := entity.ParseListValues(strings.NewReader(params.Body))
+	if err != nil {
+		return list.NewPutListValuesDefault(400).WithPayload(
+			ErrorMessage("cannot parse the values of list %s. %s", l.Key, err))
+	}
+
+	items := make([]entity.ListItem, len(values))
+	for i, v := range values {
+		items[i] = entity.ListItem{ListID: l.ID, Value: v}
+	}
+	l.Version++
+	l.Size = uint(len(values))
+
+	tx := getDB().Begin()
+	if err := tx.Where("list_id = ?", l.ID).Delete(&entity.ListItem{}).Error; err != nil {
+		tx.Rollback()
+		return list.NewPutListValuesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if len(items) != 0 {
+		if err := tx.CreateInBatches(&items, 500).Error; err != nil {
+			tx.Rollback()
+			return list.NewPutListValuesDefault(500).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+	if err := tx.Save(l).Error; err != nil {
+		tx.Rollback()
+		return list.NewPutListValuesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return list.NewPutListValuesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	flagIDs, err := l.FlagIDs(getDB())
+	if err != nil {
+		return list.NewPutListValuesDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	for _, flagID := range flagIDs {
+		entity.SaveFlagSnapshot(getDB(), flagID, getSubjectFromRequest(params.HTTPRequest), notification.OperationUpdate, notification.ComponentList, l.ID, l.Key)
+	}
+
+	resp := list.NewPutListValuesOK()
+	resp.SetPayload(e2r.MapList(l))
+	return resp
+}
+
+// DeleteList deletes the list with its values. Lists that are still
+// referenced by constraints of the flags cannot be deleted.
+func (c *crud) DeleteList(params list.DeleteListParams) middleware.Responder {
+	l := &entity.List{}
+	if err := getDB().First(l, params.ListID).Error; err != nil {
+		return list.NewDeleteListDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	cnt, err := l.ConstraintCount(getDB())
+	if err != nil {
+		return list.NewDeleteListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if cnt != 0 {
+		return list.NewDeleteListDefault(400).WithPayload(
+			ErrorMessage("list %s is still used by %d constraint(s)", l.Key, cnt))
+	}
+
+	// Hard delete, so that the key can be reused by a new list
+	tx := getDB().Begin()
+	if err := tx.Where("list_id = ?", l.ID).Delete(&entity.ListItem{}).Error; err != nil {
+		tx.Rollback()
+		return list.NewDeleteListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := tx.Unscoped().Delete(l).Error; err != nil {
+		tx.Rollback()
+		return list.NewDeleteListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	if err := tx.Commit().Error; err != nil {
+		tx.Rollback()
+		return list.NewDeleteListDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+	return list.NewDeleteListOK()
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"testing"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/audience"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/constraint"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/list"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCrudLists(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(100)),
+		},
+	})
+
+	// step 1. it should be able to create the list
+	res = c.CreateList(list.CreateListParams{
+		Body: &models.CreateListRequest{
+			Key:         new("beta_users"),
+			Description: "users in the beta program",
+		},
+	})
+	l := res.(*list.CreateListOK).Payload
+	assert.NotZero(t, l.ID)
+	assert.Zero(t, l.Version)
+
+	res = c.CreateList(list.CreateListParams{
+		Body: &models.CreateListRequest{Key: new("invalid key")},
+	})
+	assert.NotZero(t, res.(*list.CreateListDefault).Payload)
+
+	res = c.FindLists(list.FindListsParams{})
+	assert.Len(t, res.(*list.FindListsOK).Payload, 1)
+
+	res = c.PutList(list.PutListParams{
+		ListID: l.ID,
+		Body:   &models.PutListRequest{Description: new("beta testers")},
+	})
+	assert.Equal(t, "beta testers", res.(*list.PutListOK).Payload.Description)
+
+	// step 2. constraints can only reference existing lists
+	res = c.CreateConstraint(constraint.CreateConstraintParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateConstraintRequest{
+			Property: new("user_id"),
+			Operator: new(models.ConstraintOperatorINLIST),
+			Value:    new(`"unknown"`),
+		},
+	})
+	assert.NotZero(t, res.(*constraint.CreateConstraintDefault).Payload)
+
+	res = c.CreateAudience(audience.CreateAudienceParams{
+		Body: &models.CreateAudienceRequest{
+			Key: new("beta"),
+			Constraints: []*models.CreateConstraintRequest{{
+				Property: new("user_id"),
+				Operator: new(models.ConstraintOperatorNOTINLIST),
+				Value:    new(`"unknown"`),
+			}},
+		},
+	})
+	assert.NotZero(t, res.(*audience.CreateAudienceDefault).Payload)
+
+	res = c.CreateConstraint(constraint.CreateConstraintParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateConstraintRequest{
+			Property: new("user_id"),
+			Operator: new(models.ConstraintOperatorINLIST),
+			Value:    new(`"beta_users"`),
+		},
+	})
+	assert.NotZero(t, res.(*constraint.CreateConstraintOK).Payload.ID)
+
+	// step 3. it should upload the values and snapshot the referencing flags
+	var before, after int64
+	db.Model(&entity.FlagSnapshot{}).Where("flag_id = ?", 1).Count(&before)
+	res = c.PutListValues(list.PutListValuesParams{
+		ListID: l.ID,
+		Body:   "user_id\nu1\nu2\nu1\n",
+	})
+	l = res.(*list.PutListValuesOK).Payload
+	assert.Equal(t, int64(1), l.Version)
+	assert.Equal(t, int64(3), l.Size)
+
+	db.Model(&entity.FlagSnapshot{}).Where("flag_id = ?", 1).Count(&after)
+	assert.Equal(t, before+1, after)
+
+	res = c.PutListValues(list.PutListValuesParams{
+		ListID: l.ID,
+		Body:   "u3,u4",
+	})
+	l = res.(*list.PutListValuesOK).Payload
+	assert.Equal(t, int64(2), l.Version)
+	assert.Equal(t, int64(2), l.Size)
+
+	ls, err := entity.LoadLists(db)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"u3", "u4"}, ls[0].Values)
+
+	res = c.PutListValues(list.PutListValuesParams{ListID: int64(999), Body: "u1"})
+	assert.NotZero(t, res.(*list.PutListValuesDefault).Payload)
+
+	// step 4. it should not delete a list referenced by constraints
+	res = c.DeleteList(list.DeleteListParams{ListID: l.ID})
+	assert.NotZero(t, res.(*list.DeleteListDefault).Payload)
+
+	c.DeleteConstraint(constraint.DeleteConstraintParams{FlagID: int64(1), SegmentID: int64(1), ConstraintID: int64(1)})
+
+	res = c.DeleteList(list.DeleteListParams{ListID: l.ID})
+	assert.IsType(t, &list.DeleteListOK{}, res)
+
+	res = c.GetList(list.GetListParams{ListID: l.ID})
+	assert.NotZero(t, res.(*list.GetListDefault).Payload)
+
+	var cnt int64
+	db.Model(&entity.ListItem{}).Count(&cnt)
+	assert.Zero(t, cnt)
+}
+
+func TestCrudListOfDeletedFlag(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(100)),
+		},
+	})
+	res = c.CreateList(list.CreateListParams{
+		Body: &models.CreateListRequest{Key: new("beta_users")},
+	})
+	l := res.(*list.CreateListOK).Payload
+	c.CreateConstraint(constraint.CreateConstraintParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateConstraintRequest{
+			Property: new("user_id"),
+			Operator: new(models.ConstraintOperatorINLIST),
+			Value:    new(`"beta_users"`),
+		},
+	})
+
+	res = c.DeleteList(list.DeleteListParams{ListID: l.ID})
+	assert.NotZero(t, res.(*list.DeleteListDefault).Payload)
+
+	res = c.DeleteFlag(flag.DeleteFlagParams{FlagID: int64(1)})
+	assert.IsType(t, &flag.DeleteFlagOK{}, res)
+
+	var before, after int64
+	db.Model(&entity.FlagSnapshot{}).Where("flag_id = ?", 1).Count(&before)
+	res = c.PutListValues(list.PutListValuesParams{ListID: l.ID, Body: "u1"})
+	assert.IsType(t, &list.PutListValuesOK{}, res)
+	db.Model(&entity.FlagSnapshot{}).Where("flag_id = ?", 1).Count(&after)
+	assert.Equal(t, before, after)
+
+	res = c.DeleteList(list.DeleteListParams{ListID: l.ID})
+	assert.IsType(t, &list.DeleteListOK{}, res)
+}
@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"errors"
+	"slices"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/e2r"
+	"github.com/foxdalas/flagr/pkg/mapper/entity_restapi/r2e"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
+
+	"github.com/go-openapi/runtime/middleware"
+	"gorm.io/gorm"
+)
+
+func (c *crud) GetRolloutSchedule(params segment.GetRolloutScheduleParams) middleware.Responder {
+	rs, err := findLatestRolloutSchedule(params.FlagID, params.SegmentID)
+	if err != nil {
+		return segment.NewGetRolloutScheduleDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := segment.NewGetRolloutScheduleOK()
+	resp.SetPayload(e2r.MapRolloutSchedule(rs))
+	return resp
+}
+
+// CreateRolloutSchedule creates the rollout schedule of the segment. The
+// schedule is applied by the rollout scheduler in the background.
+func (c *crud) CreateRolloutSchedule(params segment.CreateRolloutScheduleParams) middleware.Responder {
+	s := &entity.Segment{}
+	if err := getDB().Where("flag_id = ?", params.FlagID).First(s, params.SegmentID).Error; err != nil {
+		return segment.NewCreateRolloutScheduleDefault(404).WithPayload(ErrorMessage("%s", err))
+	}
+
+	latest, err := findLatestRolloutSchedule(params.FlagID, params.SegmentID)
+	if err != nil && err.StatusCode != 404 {
+		return segment.NewCreateRolloutScheduleDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	if latest != nil && isPendingRolloutSchedule(latest) {
+		return segment.NewCreateRolloutScheduleDefault(400).WithPayload(
+			ErrorMessage("segment %v already has a %s rollout schedule. cancel it first", s.ID, latest.Status))
+	}
+
+	subject := getSubjectFromRequest(params.HTTPRequest)
+	rs := &entity.RolloutSchedule{
+		SegmentID: s.ID,
+		Status:    models.RolloutScheduleStatusActive,
+		CreatedBy: subject,
+		UpdatedBy: subject,
+	}
+	if params.Body != nil {
+		rs.Steps = r2e.MapRolloutSteps(params.Body.Steps)
+		rs.Linear = params.Body.Linear
+	}
+	if err := rs.Validate(); err != nil {
+		return segment.NewCreateRolloutScheduleDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+
+	if err := getDB().Create(rs).Error; err != nil {
+		return segment.NewCreateRolloutScheduleDefault(500).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := segment.NewCreateRolloutScheduleOK()
+	resp.SetPayload(e2r.MapRolloutSchedule(rs))
+	return resp
+}
+
+func (c *crud) SetRolloutSchedulePaused(params segment.SetRolloutSchedulePausedParams) middleware.Responder {
+	rs, err := findLatestRolloutSchedule(params.FlagID, params.SegmentID)
+	if err != nil {
+		return segment.NewSetRolloutSchedulePausedDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	from, to := models.RolloutScheduleStatusPaused, models.RolloutScheduleStatusActive
+	if *params.Body.Paused {
+		from, to = to, from
+	}
+	if rs.Status != from {
+		return segment.NewSetRolloutSchedulePausedDefault(400).WithPayload(
+			ErrorMessage("rollout schedule %v is %s, expecting %s", rs.ID, rs.Status, from))
+	}
+
+	rs, err = updateRolloutScheduleStatus(rs, to, getSubjectFromRequest(params.HTTPRequest))
+	if err != nil {
+		return segment.NewSetRolloutSchedulePausedDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := segment.NewSetRolloutSchedulePausedOK()
+	resp.SetPayload(e2r.MapRolloutSchedule(rs))
+	return resp
+}
+
+// CancelRolloutSchedule cancels the rollout schedule of the segment and keeps
+// the RolloutPercent of the segment as is
+func (c *crud) CancelRolloutSchedule(params segment.CancelRolloutScheduleParams) middleware.Responder {
+	rs, err := findLatestRolloutSchedule(params.FlagID, params.SegmentID)
+	if err != nil {
+		return segment.NewCancelRolloutScheduleDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+	if !isPendingRolloutSchedule(rs) {
+		return segment.NewCancelRolloutScheduleDefault(400).WithPayload(
+			ErrorMessage("rollout schedule %v is already %s", rs.ID, rs.Status))
+	}
+
+	rs, err = updateRolloutScheduleStatus(rs, models.RolloutScheduleStatusCanceled, getSubjectFromRequest(params.HTTPRequest))
+	if err != nil {
+		return segment.NewCancelRolloutScheduleDefault(err.StatusCode).WithPayload(ErrorMessage("%s", err))
+	}
+
+	resp := segment.NewCancelRolloutScheduleOK()
+	resp.SetPayload(e2r.MapRolloutSchedule(rs))
+	return resp
+}
+
+// updateRolloutScheduleStatus moves the schedule from its status to the status
+// to, and returns it as updated. Like the updates of the rollout scheduler, it
+// only updates the schedule if its status hasn't changed since it was read, so
+// that it never overwrites the step applied by another instance in between.
+func updateRolloutScheduleStatus(rs *entity.RolloutSchedule, to string, subject string) (*entity.RolloutSchedule, *Error) {
+	res := getDB().Model(&entity.RolloutSchedule{}).
+		Where("id = ? AND status = ?", rs.ID, rs.Status).
+		Updates(map[string]any{
+			"status":     to,
+			"updated_by": subject,
+		})
+	if res.Error != nil {
+		return nil, NewError(500, "%s", res.Error)
+	}
+	if res.RowsAffected == 0 {
+		return nil, NewError(409, "rollout schedule %v was changed concurrently, it is no longer %s", rs.ID, rs.Status)
+	}
+
+	updated := &entity.RolloutSchedule{}
+	if err := getDB().First(updated, rs.ID).Error; err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	return updated, nil
+}
+
+// findLatestRolloutSchedule finds the latest rollout schedule of the segment
+// of the flag
+func findLatestRolloutSchedule(flagID int64, segmentID int64) (*entity.RolloutSchedule, *Error) {
+	rs := &entity.RolloutSchedule{}
+	err := getDB().
+		Joins("JOIN segments ON segments.id = rollout_schedules.segment_id").
+		Where("segments.flag_id = ? AND segments.id = ?", flagID, segmentID).
+		Order("rollout_schedules.id desc").
+		First(rs).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, NewError(404, "unable to find rollout schedule of segment %v in the database", segmentID)
+	}
+	if err != nil {
+		return nil, NewError(500, "%s", err)
+	}
+	return rs, nil
+}
+
+// isPendingRolloutSchedule reports whether the schedule may still change the
+// RolloutPercent of its segment
+func isPendingRolloutSchedule(rs *entity.RolloutSchedule) bool {
+	return slices.Contains([]string{
+		models.RolloutScheduleStatusActive,
+		models.RolloutScheduleStatusPaused,
+	}, rs.Status)
+}
@@ -0,0 +1,212 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func genRolloutScheduleSteps(start time.Time, percents ...float64) []*models.RolloutScheduleStep {
+	steps := make([]*models.RolloutScheduleStep, len(percents))
+	for i, p := range percents {
+		steps[i] = &models.RolloutScheduleStep{
+			At:      new(strfmt.DateTime(start.Add(time.Duration(i) * 24 * time.Hour))),
+			Percent: new(p),
+		}
+	}
+	return steps
+}
+
+func TestCrudRolloutSchedules(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+			Template:    "simple_boolean_flag",
+		},
+	})
+	start := time.Now().Add(time.Hour)
+
+	// step 1. it should be able to create the rollout schedule
+	res = c.CreateRolloutSchedule(segment.CreateRolloutScheduleParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateRolloutScheduleRequest{
+			Steps: genRolloutScheduleSteps(start, 1, 10, 100),
+		},
+	})
+	rs := res.(*segment.CreateRolloutScheduleOK).Payload
+	assert.NotZero(t, rs.ID)
+	assert.Equal(t, models.RolloutScheduleStatusActive, *rs.Status)
+	assert.Len(t, rs.Steps, 3)
+	assert.Nil(t, rs.AppliedPercent)
+
+	res = c.GetRolloutSchedule(segment.GetRolloutScheduleParams{FlagID: int64(1), SegmentID: int64(1)})
+	assert.Equal(t, rs.ID, res.(*segment.GetRolloutScheduleOK).Payload.ID)
+
+	// step 2. it should not create another schedule while one is pending
+	res = c.CreateRolloutSchedule(segment.CreateRolloutScheduleParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateRolloutScheduleRequest{
+			Steps: genRolloutScheduleSteps(start, 100),
+		},
+	})
+	assert.NotZero(t, res.(*segment.CreateRolloutScheduleDefault).Payload)
+
+	// step 3. it should be able to pause and resume the schedule
+	res = c.SetRolloutSchedulePaused(segment.SetRolloutSchedulePausedParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body:      &models.SetRolloutSchedulePausedRequest{Paused: new(true)},
+	})
+	assert.Equal(t, models.RolloutScheduleStatusPaused, *res.(*segment.SetRolloutSchedulePausedOK).Payload.Status)
+
+	res = c.SetRolloutSchedulePaused(segment.SetRolloutSchedulePausedParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body:      &models.SetRolloutSchedulePausedRequest{Paused: new(true)},
+	})
+	assert.NotZero(t, res.(*segment.SetRolloutSchedulePausedDefault).Payload)
+
+	res = c.SetRolloutSchedulePaused(segment.SetRolloutSchedulePausedParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body:      &models.SetRolloutSchedulePausedRequest{Paused: new(false)},
+	})
+	assert.Equal(t, models.RolloutScheduleStatusActive, *res.(*segment.SetRolloutSchedulePausedOK).Payload.Status)
+
+	// step 4. it should be able to cancel the schedule only once
+	res = c.CancelRolloutSchedule(segment.CancelRolloutScheduleParams{FlagID: int64(1), SegmentID: int64(1)})
+	assert.Equal(t, models.RolloutScheduleStatusCanceled, *res.(*segment.CancelRolloutScheduleOK).Payload.Status)
+
+	res = c.CancelRolloutSchedule(segment.CancelRolloutScheduleParams{FlagID: int64(1), SegmentID: int64(1)})
+	assert.NotZero(t, res.(*segment.CancelRolloutScheduleDefault).Payload)
+
+	// step 5. it should be able to create a new schedule after the cancellation
+	res = c.CreateRolloutSchedule(segment.CreateRolloutScheduleParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateRolloutScheduleRequest{
+			Steps:  genRolloutScheduleSteps(start, 0, 100),
+			Linear: true,
+		},
+	})
+	rs2 := res.(*segment.CreateRolloutScheduleOK).Payload
+	assert.NotEqual(t, rs.ID, rs2.ID)
+	assert.True(t, *rs2.Linear)
+
+	res = c.GetRolloutSchedule(segment.GetRolloutScheduleParams{FlagID: int64(1), SegmentID: int64(1)})
+	assert.Equal(t, rs2.ID, res.(*segment.GetRolloutScheduleOK).Payload.ID)
+}
+
+func TestCrudRolloutSchedulesWithFailures(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+			Template:    "simple_boolean_flag",
+		},
+	})
+	start := time.Now()
+
+	t.Run("CreateRolloutSchedule - segment not found", func(t *testing.T) {
+		res = c.CreateRolloutSchedule(segment.CreateRolloutScheduleParams{
+			FlagID:    int64(2),
+			SegmentID: int64(1),
+			Body: &models.CreateRolloutScheduleRequest{
+				Steps: genRolloutScheduleSteps(start, 100),
+			},
+		})
+		assert.NotZero(t, res.(*segment.CreateRolloutScheduleDefault).Payload)
+	})
+
+	t.Run("CreateRolloutSchedule - invalid steps", func(t *testing.T) {
+		steps := genRolloutScheduleSteps(start, 10, 100)
+		steps[0], steps[1] = steps[1], steps[0]
+		res = c.CreateRolloutSchedule(segment.CreateRolloutScheduleParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body:      &models.CreateRolloutScheduleRequest{Steps: steps},
+		})
+		assert.NotZero(t, res.(*segment.CreateRolloutScheduleDefault).Payload)
+	})
+
+	t.Run("GetRolloutSchedule - not found", func(t *testing.T) {
+		res = c.GetRolloutSchedule(segment.GetRolloutScheduleParams{FlagID: int64(1), SegmentID: int64(1)})
+		assert.NotZero(t, res.(*segment.GetRolloutScheduleDefault).Payload)
+	})
+
+	t.Run("SetRolloutSchedulePaused - not found", func(t *testing.T) {
+		res = c.SetRolloutSchedulePaused(segment.SetRolloutSchedulePausedParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body:      &models.SetRolloutSchedulePausedRequest{Paused: new(true)},
+		})
+		assert.NotZero(t, res.(*segment.SetRolloutSchedulePausedDefault).Payload)
+	})
+
+	t.Run("CancelRolloutSchedule - not found", func(t *testing.T) {
+		res = c.CancelRolloutSchedule(segment.CancelRolloutScheduleParams{FlagID: int64(1), SegmentID: int64(1)})
+		assert.NotZero(t, res.(*segment.CancelRolloutScheduleDefault).Payload)
+	})
+
+	t.Run("GetRolloutSchedule - db generic error", func(t *testing.T) {
+		db.Error = fmt.Errorf("db generic error")
+		res = c.GetRolloutSchedule(segment.GetRolloutScheduleParams{FlagID: int64(1), SegmentID: int64(1)})
+		assert.NotZero(t, res.(*segment.GetRolloutScheduleDefault).Payload)
+		db.Error = nil
+	})
+
+	t.Run("CancelRolloutSchedule - completed by the scheduler in between", func(t *testing.T) {
+		c.CreateRolloutSchedule(segment.CreateRolloutScheduleParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.CreateRolloutScheduleRequest{
+				Steps: genRolloutScheduleSteps(start, 50),
+			},
+		})
+		stale, err := findLatestRolloutSchedule(int64(1), int64(1))
+		assert.Nil(t, err)
+		assert.NoError(t, applyRolloutSchedule(stale, start))
+
+		_, err = updateRolloutScheduleStatus(stale, models.RolloutScheduleStatusCanceled, "")
+		assert.Equal(t, 409, err.StatusCode)
+
+		rs, _ := findLatestRolloutSchedule(int64(1), int64(1))
+		assert.Equal(t, models.RolloutScheduleStatusCompleted, rs.Status)
+		assert.Equal(t, float64(50), *rs.AppliedPercent)
+	})
+}
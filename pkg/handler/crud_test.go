@@ -7,6 +7,7 @@ import (
 	"go/token"
 	"strings"
 	"testing"
+	"time"
 
 	"encoding/json"
 
@@ -17,9 +18,11 @@ import (
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/tag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/target"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/variant"
 
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
 	"github.com/prashantv/gostub"
 	"github.com/stretchr/testify/assert"
 )
@@ -89,7 +92,7 @@ func TestCrudFlags(t *testing.T) {
 			FlagID: int64(1),
 			Body: &models.CreateSegmentRequest{
 				Description:    new("segment1"),
-				RolloutPercent: new(int64(100)),
+				RolloutPercent: new(float64(100)),
 			},
 		})
 		c.CreateVariant(variant.CreateVariantParams{
@@ -150,6 +153,71 @@ func TestCrudFlags(t *testing.T) {
 		assert.NotZero(t, len(ds))
 	})
 
+	t.Run("it should be able to put flag's BucketBy", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				BucketBy: new("company.id"),
+			}},
+		)
+		assert.Equal(t, "company.id", res.(*flag.PutFlagOK).Payload.BucketBy)
+		assert.Equal(t, "report", res.(*flag.PutFlagOK).Payload.EntityType)
+	})
+
+	t.Run("it should be able to rotate flag's Salt", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				Salt: new("experiment-2"),
+			}},
+		)
+		assert.Equal(t, "experiment-2", res.(*flag.PutFlagOK).Payload.Salt)
+
+		res = c.GetFlagSnapshots(flag.GetFlagSnapshotsParams{FlagID: int64(1)})
+		assert.Equal(t, "experiment-2", res.(*flag.GetFlagSnapshotsOK).Payload[0].Flag.Salt)
+	})
+
+	t.Run("it should reject a Salt longer than its column", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				Salt: new(strings.Repeat("s", entity.SaltMaxLength+1)),
+			}},
+		)
+		assert.Contains(t, *res.(*flag.PutFlagDefault).Payload.Message, "longer than 64 characters")
+	})
+
+	t.Run("it should be able to put flag's DefaultVariantID", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				DefaultVariantID: new(int64(1)),
+			}},
+		)
+		assert.Equal(t, int64(1), res.(*flag.PutFlagOK).Payload.DefaultVariantID)
+		assert.Equal(t, "variant1", res.(*flag.PutFlagOK).Payload.DefaultVariantKey)
+
+		res = c.DeleteVariant(variant.DeleteVariantParams{FlagID: int64(1), VariantID: int64(1)})
+		assert.NotZero(t, res.(*variant.DeleteVariantDefault).Payload)
+
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				DefaultVariantID: new(int64(999999)),
+			}},
+		)
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				DefaultVariantID: new(int64(0)),
+			}},
+		)
+		assert.Zero(t, res.(*flag.PutFlagOK).Payload.DefaultVariantID)
+		assert.Empty(t, res.(*flag.PutFlagOK).Payload.DefaultVariantKey)
+	})
+
 	t.Run("it should be able to get all the flags' EntityType", func(t *testing.T) {
 		res = c.GetFlagEntityTypes(flag.GetFlagEntityTypesParams{})
 		assert.NotZero(t, len(res.(*flag.GetFlagEntityTypesOK).Payload))
@@ -251,6 +319,16 @@ func TestCrudFlagsWithFailures(t *testing.T) {
 		db.Error = nil
 	})
 
+	t.Run("PutFlag - invalid BucketBy", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagRequest{
+				BucketBy: new("company["),
+			}},
+		)
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+	})
+
 	t.Run("PutFlag - cannot set duplicate flag_key", func(t *testing.T) {
 		res = c.PutFlag(flag.PutFlagParams{
 			FlagID: int64(2),
@@ -350,7 +428,7 @@ func TestFindFlags(t *testing.T) {
 			FlagID: int64(1),
 			Body: &models.CreateSegmentRequest{
 				Description:    new("segment1"),
-				RolloutPercent: new(int64(100)),
+				RolloutPercent: new(float64(100)),
 			},
 		})
 		c.CreateVariant(variant.CreateVariantParams{
@@ -377,7 +455,7 @@ func TestFindFlags(t *testing.T) {
 			FlagID: 1,
 			Body: &models.CreateSegmentRequest{
 				Description:    new("segment2"),
-				RolloutPercent: new(int64(100)),
+				RolloutPercent: new(float64(100)),
 			},
 		})
 		c.CreateVariant(variant.CreateVariantParams{
@@ -565,7 +643,7 @@ func TestCrudSegments(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 	assert.NotZero(t, res.(*segment.CreateSegmentOK).Payload)
@@ -573,7 +651,7 @@ func TestCrudSegments(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment2"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 	assert.NotZero(t, res.(*segment.CreateSegmentOK).Payload)
@@ -588,7 +666,25 @@ func TestCrudSegments(t *testing.T) {
 		SegmentID: int64(1),
 		Body: &models.PutSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(0)),
+			RolloutPercent: new(0.5),
+		},
+	})
+	assert.Equal(t, 0.5, *res.(*segment.PutSegmentOK).Payload.RolloutPercent)
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.PutSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(0.55),
+		},
+	})
+	assert.NotZero(t, res.(*segment.PutSegmentDefault).Payload)
+	res = c.PutSegment(segment.PutSegmentParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.PutSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(0)),
 		},
 	})
 	assert.NotZero(t, res.(*segment.PutSegmentOK).Payload.ID)
@@ -646,20 +742,31 @@ func TestCrudSegmentsWithFailures(t *testing.T) {
 			FlagID: int64(1),
 			Body: &models.CreateSegmentRequest{
 				Description:    new("segment1"),
-				RolloutPercent: new(int64(100)),
+				RolloutPercent: new(float64(100)),
 			},
 		})
 		assert.NotZero(t, res.(*segment.CreateSegmentDefault).Payload)
 		db.Error = nil
 	})
 
+	t.Run("CreateSegment - rolloutPercent finer than 0.1", func(t *testing.T) {
+		res = c.CreateSegment(segment.CreateSegmentParams{
+			FlagID: int64(1),
+			Body: &models.CreateSegmentRequest{
+				Description:    new("segment1"),
+				RolloutPercent: new(0.05),
+			},
+		})
+		assert.NotZero(t, res.(*segment.CreateSegmentDefault).Payload)
+	})
+
 	t.Run("PutSegments - put on a non-existing segment", func(t *testing.T) {
 		res = c.PutSegment(segment.PutSegmentParams{
 			FlagID:    int64(1),
 			SegmentID: int64(999999),
 			Body: &models.PutSegmentRequest{
 				Description:    new("segment1"),
-				RolloutPercent: new(int64(0)),
+				RolloutPercent: new(float64(0)),
 			},
 		})
 		assert.NotZero(t, res.(*segment.PutSegmentDefault).Payload)
@@ -710,7 +817,7 @@ func TestCrudConstraints(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 
@@ -753,6 +860,25 @@ func TestCrudConstraints(t *testing.T) {
 	})
 	assert.NotZero(t, res.(*constraint.PutConstraintOK).Payload.ID)
 
+	// step 4.1. it should be able to move the constraint to another group
+	res = c.PutConstraint(constraint.PutConstraintParams{
+		FlagID:       int64(1),
+		SegmentID:    int64(1),
+		ConstraintID: int64(1),
+		Body: &models.CreateConstraintRequest{
+			Operator: new("EQ"),
+			Property: new("beta_tester"),
+			Value:    new(`true`),
+			Group:    2,
+		},
+	})
+	assert.Equal(t, int64(2), res.(*constraint.PutConstraintOK).Payload.Group)
+	res = c.FindConstraints(constraint.FindConstraintsParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+	})
+	assert.Equal(t, int64(2), res.(*constraint.FindConstraintsOK).Payload[0].Group)
+
 	// step 5. it should be able to update the constraint
 	variousPropertyNames := []string{"test", "test-dash", "test_underscore", "@test", "@@test"}
 	for _, propertyName := range variousPropertyNames {
@@ -800,7 +926,7 @@ func TestCrudConstraintsFailures(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 	c.CreateConstraint(constraint.CreateConstraintParams{
@@ -879,6 +1005,34 @@ func TestCrudConstraintsFailures(t *testing.T) {
 		assert.NotZero(t, res.(*constraint.PutConstraintDefault).Payload)
 	})
 
+	t.Run("PutConstraint - put unparsable semver literal", func(t *testing.T) {
+		res = c.PutConstraint(constraint.PutConstraintParams{
+			FlagID:       int64(1),
+			SegmentID:    int64(1),
+			ConstraintID: int64(1),
+			Body: &models.CreateConstraintRequest{
+				Operator: new(models.ConstraintOperatorSEMVERGT),
+				Property: new("app_version"),
+				Value:    new(`"1.9.x"`),
+			},
+		})
+		assert.NotZero(t, res.(*constraint.PutConstraintDefault).Payload)
+	})
+
+	t.Run("PutConstraint - put invalid CIDR", func(t *testing.T) {
+		res = c.PutConstraint(constraint.PutConstraintParams{
+			FlagID:       int64(1),
+			SegmentID:    int64(1),
+			ConstraintID: int64(1),
+			Body: &models.CreateConstraintRequest{
+				Operator: new(models.ConstraintOperatorINCIDR),
+				Property: new("ip"),
+				Value:    new(`["10.0.0.0/8", "10.0.0.256/32"]`),
+			},
+		})
+		assert.NotZero(t, res.(*constraint.PutConstraintDefault).Payload)
+	})
+
 	t.Run("DeleteConstraint - db generic error", func(t *testing.T) {
 		db.Error = fmt.Errorf("generic db error")
 		res = c.DeleteConstraint(constraint.DeleteConstraintParams{
@@ -1100,6 +1254,133 @@ func TestCrudVariantsWithFailures(t *testing.T) {
 	})
 }
 
+func TestCrudVariantsWithAttachmentSchema(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(1),
+		Body: &models.CreateVariantRequest{
+			Key:        new("control"),
+			Attachment: map[string]any{"color": "green"},
+		},
+	})
+
+	schema := map[string]any{
+		"type":     "object",
+		"required": []any{"color"},
+		"properties": map[string]any{
+			"color": map[string]any{"type": "string", "enum": []any{"red", "blue"}},
+		},
+	}
+
+	t.Run("it should not put an invalid schema", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body:   &models.PutFlagRequest{AttachmentSchema: map[string]any{"type": "objekt"}},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+	})
+
+	t.Run("it should not put a schema the existing variants don't conform to", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body:   &models.PutFlagRequest{AttachmentSchema: schema},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagDefault).Payload)
+		assert.Contains(t, *res.(*flag.PutFlagDefault).Payload.Message, "variant control does not conform")
+	})
+
+	t.Run("it should put the schema", func(t *testing.T) {
+		res = c.PutVariant(variant.PutVariantParams{
+			FlagID:    int64(1),
+			VariantID: int64(1),
+			Body: &models.PutVariantRequest{
+				Key:        new("control"),
+				Attachment: map[string]any{"color": "red"},
+			},
+		})
+		assert.NotNil(t, res.(*variant.PutVariantOK).Payload)
+
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body:   &models.PutFlagRequest{AttachmentSchema: schema},
+		})
+		assert.Equal(t, schema, res.(*flag.PutFlagOK).Payload.AttachmentSchema)
+
+		res = c.GetFlagSnapshots(flag.GetFlagSnapshotsParams{FlagID: int64(1)})
+		assert.NotNil(t, res.(*flag.GetFlagSnapshotsOK).Payload[0].Flag.AttachmentSchema)
+	})
+
+	t.Run("it should reject the attachments that don't conform to the schema", func(t *testing.T) {
+		res = c.CreateVariant(variant.CreateVariantParams{
+			FlagID: int64(1),
+			Body: &models.CreateVariantRequest{
+				Key:        new("treatment"),
+				Attachment: map[string]any{"color": "green"},
+			},
+		})
+		assert.NotZero(t, res.(*variant.CreateVariantDefault).Payload)
+
+		res = c.CreateVariant(variant.CreateVariantParams{
+			FlagID: int64(1),
+			Body:   &models.CreateVariantRequest{Key: new("treatment")},
+		})
+		assert.NotZero(t, res.(*variant.CreateVariantDefault).Payload)
+
+		res = c.CreateVariant(variant.CreateVariantParams{
+			FlagID: int64(1),
+			Body: &models.CreateVariantRequest{
+				Key:        new("treatment"),
+				Attachment: map[string]any{"color": "blue"},
+			},
+		})
+		assert.NotZero(t, res.(*variant.CreateVariantOK).Payload.ID)
+
+		res = c.PutVariant(variant.PutVariantParams{
+			FlagID:    int64(1),
+			VariantID: int64(1),
+			Body: &models.PutVariantRequest{
+				Key:        new("control"),
+				Attachment: map[string]any{"color": 1},
+			},
+		})
+		assert.NotZero(t, res.(*variant.PutVariantDefault).Payload)
+	})
+
+	t.Run("it should remove the schema with an empty object", func(t *testing.T) {
+		res = c.PutFlag(flag.PutFlagParams{
+			FlagID: int64(1),
+			Body:   &models.PutFlagRequest{AttachmentSchema: map[string]any{}},
+		})
+		assert.Nil(t, res.(*flag.PutFlagOK).Payload.AttachmentSchema)
+
+		res = c.PutVariant(variant.PutVariantParams{
+			FlagID:    int64(1),
+			VariantID: int64(1),
+			Body: &models.PutVariantRequest{
+				Key:        new("control"),
+				Attachment: map[string]any{"color": 1},
+			},
+		})
+		assert.NotNil(t, res.(*variant.PutVariantOK).Payload)
+	})
+}
+
 func TestCrudTags(t *testing.T) {
 	var res middleware.Responder
 	db := entity.NewTestDB()
@@ -1209,6 +1490,310 @@ func TestCrudTagsWithFailures(t *testing.T) {
 	})
 }
 
+func TestCrudFlagPrerequisites(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	for _, key := range []string{"new_checkout", "new_payments_backend", "new_payments_db"} {
+		c.CreateFlag(flag.CreateFlagParams{
+			Body: &models.CreateFlagRequest{
+				Description: new("funny flag"),
+				Key:         key,
+				Template:    "simple_boolean_flag",
+			},
+		})
+	}
+	putPrerequisites := func(flagID int64, ps ...*models.FlagPrerequisite) middleware.Responder {
+		return c.PutFlagPrerequisites(flag.PutFlagPrerequisitesParams{
+			FlagID: flagID,
+			Body:   &models.PutFlagPrerequisitesRequest{Prerequisites: ps},
+		})
+	}
+
+	// step 1. it should be able to put the prerequisites
+	res = putPrerequisites(1, &models.FlagPrerequisite{
+		FlagKey:     new("new_payments_backend"),
+		VariantKeys: []string{"on"},
+	})
+	f := res.(*flag.PutFlagPrerequisitesOK).Payload
+	assert.Len(t, f.Prerequisites, 1)
+	assert.Equal(t, "new_payments_backend", *f.Prerequisites[0].FlagKey)
+	assert.Equal(t, []string{"on"}, f.Prerequisites[0].VariantKeys)
+
+	res = putPrerequisites(2, &models.FlagPrerequisite{
+		FlagKey:     new("new_payments_db"),
+		VariantKeys: []string{"on"},
+	})
+	assert.Len(t, res.(*flag.PutFlagPrerequisitesOK).Payload.Prerequisites, 1)
+
+	// step 2. it should show the prerequisites in the flag and its snapshot
+	res = c.GetFlag(flag.GetFlagParams{FlagID: int64(1)})
+	assert.Len(t, res.(*flag.GetFlagOK).Payload.Prerequisites, 1)
+
+	res = c.GetFlagSnapshots(flag.GetFlagSnapshotsParams{FlagID: int64(1)})
+	assert.Len(t, res.(*flag.GetFlagSnapshotsOK).Payload[0].Flag.Prerequisites, 1)
+
+	// step 3. it should reject the prerequisites that form a cycle
+	res = putPrerequisites(3, &models.FlagPrerequisite{
+		FlagKey:     new("new_checkout"),
+		VariantKeys: []string{"on"},
+	})
+	assert.Contains(t, *res.(*flag.PutFlagPrerequisitesDefault).Payload.Message,
+		"new_checkout -> new_payments_backend -> new_payments_db -> new_checkout")
+
+	res = putPrerequisites(1, &models.FlagPrerequisite{
+		FlagKey:     new("new_checkout"),
+		VariantKeys: []string{"on"},
+	})
+	assert.Contains(t, *res.(*flag.PutFlagPrerequisitesDefault).Payload.Message, "prerequisite of itself")
+
+	// step 4. it should be able to remove the prerequisites
+	res = putPrerequisites(1)
+	assert.Len(t, res.(*flag.PutFlagPrerequisitesOK).Payload.Prerequisites, 0)
+
+	res = putPrerequisites(3, &models.FlagPrerequisite{
+		FlagKey:     new("new_checkout"),
+		VariantKeys: []string{"on"},
+	})
+	assert.Len(t, res.(*flag.PutFlagPrerequisitesOK).Payload.Prerequisites, 1)
+
+	// step 5. the flags that have a flag as a prerequisite follow its key change
+	res = c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(1),
+		Body:   &models.PutFlagRequest{Key: new("checkout_v2")},
+	})
+	assert.Equal(t, "checkout_v2", res.(*flag.PutFlagOK).Payload.Key)
+	res = c.GetFlag(flag.GetFlagParams{FlagID: int64(3)})
+	assert.Equal(t, "checkout_v2", *res.(*flag.GetFlagOK).Payload.Prerequisites[0].FlagKey)
+	res = c.GetFlagSnapshots(flag.GetFlagSnapshotsParams{FlagID: int64(3)})
+	assert.Equal(t, "checkout_v2", *res.(*flag.GetFlagSnapshotsOK).Payload[0].Flag.Prerequisites[0].FlagKey)
+
+	// step 6. it should reject a key change that forms a cycle through a
+	// prerequisite on a flag key that doesn't exist
+	res = putPrerequisites(3)
+	assert.Len(t, res.(*flag.PutFlagPrerequisitesOK).Payload.Prerequisites, 0)
+	assert.NoError(t, db.Create(&entity.FlagPrerequisite{FlagID: 3, FlagKey: "missing_flag", VariantKeys: []string{"on"}}).Error)
+	res = c.PutFlag(flag.PutFlagParams{
+		FlagID: int64(2),
+		Body:   &models.PutFlagRequest{Key: new("missing_flag")},
+	})
+	assert.Contains(t, *res.(*flag.PutFlagDefault).Payload.Message,
+		"missing_flag -> new_payments_db -> missing_flag")
+
+	// step 7. it should reject deleting a flag that is a prerequisite
+	res = c.DeleteFlag(flag.DeleteFlagParams{FlagID: int64(3)})
+	assert.Contains(t, *res.(*flag.DeleteFlagDefault).Payload.Message,
+		"cannot delete flag new_payments_db. it is a prerequisite of flags: new_payments_backend")
+	res = c.DeleteFlag(flag.DeleteFlagParams{FlagID: int64(2)})
+	assert.IsType(t, &flag.DeleteFlagOK{}, res)
+	res = c.DeleteFlag(flag.DeleteFlagParams{FlagID: int64(3)})
+	assert.IsType(t, &flag.DeleteFlagOK{}, res)
+}
+
+func TestCrudFlagPrerequisitesWithFailures(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	for _, key := range []string{"new_checkout", "new_payments_backend"} {
+		c.CreateFlag(flag.CreateFlagParams{
+			Body: &models.CreateFlagRequest{
+				Description: new("funny flag"),
+				Key:         key,
+				Template:    "simple_boolean_flag",
+			},
+		})
+	}
+
+	t.Run("PutFlagPrerequisites - flag not found", func(t *testing.T) {
+		res = c.PutFlagPrerequisites(flag.PutFlagPrerequisitesParams{
+			FlagID: int64(999),
+			Body:   &models.PutFlagPrerequisitesRequest{},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagPrerequisitesDefault).Payload)
+	})
+
+	t.Run("PutFlagPrerequisites - prerequisite flag not found", func(t *testing.T) {
+		res = c.PutFlagPrerequisites(flag.PutFlagPrerequisitesParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagPrerequisitesRequest{
+				Prerequisites: []*models.FlagPrerequisite{
+					{FlagKey: new("unknown_flag"), VariantKeys: []string{"on"}},
+				},
+			},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagPrerequisitesDefault).Payload)
+	})
+
+	t.Run("PutFlagPrerequisites - prerequisite variant not found", func(t *testing.T) {
+		res = c.PutFlagPrerequisites(flag.PutFlagPrerequisitesParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagPrerequisitesRequest{
+				Prerequisites: []*models.FlagPrerequisite{
+					{FlagKey: new("new_payments_backend"), VariantKeys: []string{"off"}},
+				},
+			},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagPrerequisitesDefault).Payload)
+	})
+
+	t.Run("PutFlagPrerequisites - empty variant keys", func(t *testing.T) {
+		res = c.PutFlagPrerequisites(flag.PutFlagPrerequisitesParams{
+			FlagID: int64(1),
+			Body: &models.PutFlagPrerequisitesRequest{
+				Prerequisites: []*models.FlagPrerequisite{
+					{FlagKey: new("new_payments_backend")},
+				},
+			},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagPrerequisitesDefault).Payload)
+	})
+
+	t.Run("PutFlagPrerequisites - db generic error", func(t *testing.T) {
+		db.Error = fmt.Errorf("db generic error")
+		res = c.PutFlagPrerequisites(flag.PutFlagPrerequisitesParams{
+			FlagID: int64(1),
+			Body:   &models.PutFlagPrerequisitesRequest{},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagPrerequisitesDefault).Payload)
+		db.Error = nil
+	})
+}
+
+func TestCrudActivationWindows(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+		},
+	})
+	c.CreateSegment(segment.CreateSegmentParams{
+		FlagID: int64(1),
+		Body: &models.CreateSegmentRequest{
+			Description:    new("segment1"),
+			RolloutPercent: new(float64(100)),
+		},
+	})
+	endAt := strfmt.DateTime(time.Date(2026, 12, 31, 0, 0, 0, 0, time.UTC))
+
+	// step 1. it should be able to put the activation window of the flag
+	res = c.PutFlagActivationWindow(flag.PutFlagActivationWindowParams{
+		FlagID: int64(1),
+		Body: &models.ActivationWindow{
+			Weekdays:  []string{"mon", "tue"},
+			StartTime: "09:00",
+			EndTime:   "17:00",
+			Timezone:  "Europe/Berlin",
+		},
+	})
+	w := res.(*flag.PutFlagActivationWindowOK).Payload.ActivationWindow
+	assert.Equal(t, []string{"mon", "tue"}, w.Weekdays)
+	assert.Equal(t, "Europe/Berlin", w.Timezone)
+
+	res = c.PutFlagActivationWindow(flag.PutFlagActivationWindowParams{
+		FlagID: int64(1),
+		Body:   &models.ActivationWindow{EndAt: &endAt},
+	})
+	w = res.(*flag.PutFlagActivationWindowOK).Payload.ActivationWindow
+	assert.Equal(t, endAt.String(), w.EndAt.String())
+	assert.Empty(t, w.Weekdays)
+	assert.Empty(t, w.Timezone)
+
+	// step 2. it should be able to put the activation window of the segment
+	res = c.PutSegmentActivationWindow(segment.PutSegmentActivationWindowParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body:      &models.ActivationWindow{StartTime: "22:00", EndTime: "06:00"},
+	})
+	assert.Equal(t, "22:00", res.(*segment.PutSegmentActivationWindowOK).Payload.ActivationWindow.StartTime)
+
+	// step 3. it should show the activation windows in the flag and its snapshot
+	res = c.GetFlag(flag.GetFlagParams{FlagID: int64(1)})
+	f := res.(*flag.GetFlagOK).Payload
+	assert.NotNil(t, f.ActivationWindow)
+	assert.NotNil(t, f.Segments[0].ActivationWindow)
+
+	res = c.GetFlagSnapshots(flag.GetFlagSnapshotsParams{FlagID: int64(1)})
+	assert.NotNil(t, res.(*flag.GetFlagSnapshotsOK).Payload[0].Flag.Segments[0].ActivationWindow)
+
+	// step 4. it should be able to delete the activation windows
+	res = c.DeleteSegmentActivationWindow(segment.DeleteSegmentActivationWindowParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+	})
+	assert.Nil(t, res.(*segment.DeleteSegmentActivationWindowOK).Payload.ActivationWindow)
+
+	res = c.DeleteFlagActivationWindow(flag.DeleteFlagActivationWindowParams{FlagID: int64(1)})
+	assert.Nil(t, res.(*flag.DeleteFlagActivationWindowOK).Payload.ActivationWindow)
+
+	t.Run("invalid activation window", func(t *testing.T) {
+		res = c.PutFlagActivationWindow(flag.PutFlagActivationWindowParams{
+			FlagID: int64(1),
+			Body:   &models.ActivationWindow{StartTime: "09:00", EndTime: "09:00"},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagActivationWindowDefault).Payload)
+
+		res = c.PutSegmentActivationWindow(segment.PutSegmentActivationWindowParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body:      &models.ActivationWindow{Timezone: "Nowhere/Special"},
+		})
+		assert.NotZero(t, res.(*segment.PutSegmentActivationWindowDefault).Payload)
+	})
+
+	t.Run("not found", func(t *testing.T) {
+		res = c.PutFlagActivationWindow(flag.PutFlagActivationWindowParams{
+			FlagID: int64(999),
+			Body:   &models.ActivationWindow{},
+		})
+		assert.NotZero(t, res.(*flag.PutFlagActivationWindowDefault).Payload)
+
+		res = c.DeleteFlagActivationWindow(flag.DeleteFlagActivationWindowParams{FlagID: int64(999)})
+		assert.NotZero(t, res.(*flag.DeleteFlagActivationWindowDefault).Payload)
+
+		res = c.PutSegmentActivationWindow(segment.PutSegmentActivationWindowParams{
+			FlagID:    int64(999),
+			SegmentID: int64(1),
+			Body:      &models.ActivationWindow{},
+		})
+		assert.NotZero(t, res.(*segment.PutSegmentActivationWindowDefault).Payload)
+
+		res = c.DeleteSegmentActivationWindow(segment.DeleteSegmentActivationWindowParams{
+			FlagID:    int64(1),
+			SegmentID: int64(999),
+		})
+		assert.NotZero(t, res.(*segment.DeleteSegmentActivationWindowDefault).Payload)
+	})
+}
+
 func TestFindAllTags(t *testing.T) {
 	var res middleware.Responder
 	db := entity.NewTestDB()
@@ -1287,7 +1872,7 @@ func TestCrudDistributions(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -1311,7 +1896,7 @@ func TestCrudDistributions(t *testing.T) {
 		Body: &models.PutDistributionsRequest{
 			Distributions: []*models.Distribution{
 				{
-					Percent:    new(int64(100)),
+					Percent:    new(float64(100)),
 					VariantID:  new(int64(1)),
 					VariantKey: new("control"),
 				},
@@ -1350,7 +1935,7 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -1365,7 +1950,7 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 		Body: &models.PutDistributionsRequest{
 			Distributions: []*models.Distribution{
 				{
-					Percent:    new(int64(100)),
+					Percent:    new(float64(100)),
 					VariantID:  new(int64(1)),
 					VariantKey: new("control"),
 				},
@@ -1390,7 +1975,7 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    new(int64(50)), // not adds up to 100
+						Percent:    new(float64(50)), // not adds up to 100
 						VariantID:  new(int64(1)),
 						VariantKey: new("control"),
 					},
@@ -1409,7 +1994,7 @@ func TestCrudDistributionsWithFailures(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    new(int64(100)),
+						Percent:    new(float64(100)),
 						VariantID:  new(int64(1)),
 						VariantKey: new("control"),
 					},
@@ -1502,3 +2087,147 @@ func TestAllMutationHandlersCallSaveFlagSnapshot(t *testing.T) {
 		}
 	}
 }
+
+func TestCrudTargets(t *testing.T) {
+	var res middleware.Responder
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+			Key:         "flag_key_1",
+		},
+	})
+	c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(1),
+		Body:   &models.CreateVariantRequest{Key: new("control")},
+	})
+	c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(1),
+		Body:   &models.CreateVariantRequest{Key: new("treatment")},
+	})
+
+	t.Run("it should be able to create a target", func(t *testing.T) {
+		res = c.CreateTarget(target.CreateTargetParams{
+			FlagID: int64(1),
+			Body: &models.CreateTargetRequest{
+				EntityID:   new("qa_account"),
+				VariantKey: new("treatment"),
+			},
+		})
+		payload := res.(*target.CreateTargetOK).Payload
+		assert.NotZero(t, payload.ID)
+		assert.Equal(t, int64(2), payload.VariantID)
+
+		res = c.GetFlagSnapshots(flag.GetFlagSnapshotsParams{FlagID: int64(1)})
+		assert.Len(t, res.(*flag.GetFlagSnapshotsOK).Payload[0].Flag.Targets, 1)
+	})
+
+	t.Run("it should not target an entity twice", func(t *testing.T) {
+		res = c.CreateTarget(target.CreateTargetParams{
+			FlagID: int64(1),
+			Body: &models.CreateTargetRequest{
+				EntityID:   new("qa_account"),
+				VariantKey: new("control"),
+			},
+		})
+		assert.NotZero(t, res.(*target.CreateTargetDefault).Payload)
+	})
+
+	t.Run("it should not target an unknown variant", func(t *testing.T) {
+		res = c.CreateTarget(target.CreateTargetParams{
+			FlagID: int64(1),
+			Body: &models.CreateTargetRequest{
+				EntityID:   new("vip"),
+				VariantKey: new("unknown"),
+			},
+		})
+		assert.NotZero(t, res.(*target.CreateTargetDefault).Payload)
+
+		res = c.CreateTarget(target.CreateTargetParams{
+			FlagID: int64(999),
+			Body: &models.CreateTargetRequest{
+				EntityID:   new("vip"),
+				VariantKey: new("control"),
+			},
+		})
+		assert.NotZero(t, res.(*target.CreateTargetDefault).Payload)
+	})
+
+	t.Run("it should be able to replace the targets", func(t *testing.T) {
+		res = c.PutTargets(target.PutTargetsParams{
+			FlagID: int64(1),
+			Body: &models.PutTargetsRequest{
+				Targets: []*models.Target{
+					{EntityID: new("vip_1"), VariantKey: new("control")},
+					{EntityID: new("vip_2"), VariantKey: new("treatment")},
+				},
+			},
+		})
+		assert.Len(t, res.(*target.PutTargetsOK).Payload, 2)
+
+		res = c.FindTargets(target.FindTargetsParams{FlagID: int64(1)})
+		ts := res.(*target.FindTargetsOK).Payload
+		assert.Len(t, ts, 2)
+		assert.Equal(t, "vip_1", *ts[0].EntityID)
+		assert.Equal(t, int64(1), ts[0].VariantID)
+
+		res = c.GetFlag(flag.GetFlagParams{FlagID: int64(1)})
+		assert.Len(t, res.(*flag.GetFlagOK).Payload.Targets, 2)
+	})
+
+	t.Run("it should reject duplicated targets", func(t *testing.T) {
+		res = c.PutTargets(target.PutTargetsParams{
+			FlagID: int64(1),
+			Body: &models.PutTargetsRequest{
+				Targets: []*models.Target{
+					{EntityID: new("vip_1"), VariantKey: new("control")},
+					{EntityID: new("vip_1"), VariantKey: new("treatment")},
+				},
+			},
+		})
+		assert.NotZero(t, res.(*target.PutTargetsDefault).Payload)
+	})
+
+	t.Run("it should sync the variant key of the targets", func(t *testing.T) {
+		c.PutVariant(variant.PutVariantParams{
+			FlagID:    int64(1),
+			VariantID: int64(1),
+			Body:      &models.PutVariantRequest{Key: new("off")},
+		})
+		res = c.FindTargets(target.FindTargetsParams{FlagID: int64(1)})
+		assert.Equal(t, "off", *res.(*target.FindTargetsOK).Payload[0].VariantKey)
+	})
+
+	t.Run("it should not delete a targeted variant", func(t *testing.T) {
+		res = c.DeleteVariant(variant.DeleteVariantParams{FlagID: int64(1), VariantID: int64(1)})
+		assert.NotZero(t, res.(*variant.DeleteVariantDefault).Payload)
+	})
+
+	t.Run("it should be able to delete a target", func(t *testing.T) {
+		res = c.FindTargets(target.FindTargetsParams{FlagID: int64(1)})
+		id := res.(*target.FindTargetsOK).Payload[0].ID
+
+		res = c.DeleteTarget(target.DeleteTargetParams{FlagID: int64(1), TargetID: id})
+		assert.NotZero(t, res.(*target.DeleteTargetOK))
+
+		res = c.FindTargets(target.FindTargetsParams{FlagID: int64(1)})
+		assert.Len(t, res.(*target.FindTargetsOK).Payload, 1)
+	})
+
+	t.Run("it should fail to find the targets with db errors", func(t *testing.T) {
+		db.Error = fmt.Errorf("db generic error")
+		res = c.FindTargets(target.FindTargetsParams{FlagID: int64(1)})
+		assert.NotZero(t, res.(*target.FindTargetsDefault).Payload)
+		db.Error = nil
+	})
+}
@@ -61,6 +61,24 @@ func TestFrameOutput(t *testing.T) {
 	})
 }
 
+func TestFrameOutputWithHoldout(t *testing.T) {
+	frame := DataRecordFrame{
+		evalResult: models.EvalResult{
+			EvalContext: &models.EvalContext{EntityID: "123"},
+			FlagID:      1,
+			VariantID:   1,
+			VariantKey:  "control",
+			HoldoutID:   3,
+			HoldoutKey:  "global",
+		},
+		options: DataRecordFrameOptions{FrameOutputMode: frameOutputModePayloadRawJSON},
+	}
+	output, err := frame.Output()
+	assert.NoError(t, err)
+	assert.Contains(t, string(output), `"holdoutID":3`)
+	assert.Contains(t, string(output), `"holdoutKey":"global"`)
+}
+
 func TestGetPartitionKey(t *testing.T) {
 
 	t.Run("empty evalResult", func(t *testing.T) {
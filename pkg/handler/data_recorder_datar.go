@@ -19,7 +19,7 @@ type datarRecorder struct {
 }
 
 func (d *datarRecorder) AsyncRecord(r models.EvalResult) {
-	d.engine.Record(r.FlagID, r.VariantID, r.SegmentID)
+	d.engine.Record(r.FlagID, r.VariantID, r.SegmentID, r.HoldoutID)
 }
 
 func (d *datarRecorder) NewDataRecordFrame(_ models.EvalResult) DataRecordFrame {
@@ -161,11 +161,12 @@ func TestDatarRecorder_AsyncRecord(t *testing.T) {
 	r.AsyncRecord(models.EvalResult{FlagID: 1, VariantID: 10, SegmentID: 20})
 	r.AsyncRecord(models.EvalResult{FlagID: 1, VariantID: 10, SegmentID: 20})
 	r.AsyncRecord(models.EvalResult{FlagID: 2, VariantID: 1, SegmentID: 5})
+	r.AsyncRecord(models.EvalResult{FlagID: 2, VariantID: 1, HoldoutID: 3})
 
 	// Verify engine buffer has the right counts.
 	d := GetDatar()
 	assert.NotNil(t, d)
-	assert.Equal(t, 2, d.Len(), "2 distinct keys")
+	assert.Equal(t, 3, d.Len(), "3 distinct keys")
 }
 
 func TestDatarRecorder_NewDataRecordFrame(t *testing.T) {
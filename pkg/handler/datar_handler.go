@@ -176,6 +176,11 @@ func HandleGetDatarFlagSummary(params datarapi.GetDatarFlagSummaryParams) middle
 		segs[i] = &models.DatarSegmentEntry{SegmentID: s.SegmentID, Count: s.Count}
 	}
 
+	holdouts := make([]*models.DatarHoldoutEntry, len(summary.Holdouts))
+	for i, h := range summary.Holdouts {
+		holdouts[i] = &models.DatarHoldoutEntry{HoldoutID: h.HoldoutID, Count: h.Count}
+	}
+
 	days := make([]*models.DatarDayEntry, 0, len(summary.Days))
 	for _, d := range summary.Days {
 		if entry := toSwaggerDay(d); entry != nil {
@@ -187,6 +192,7 @@ func HandleGetDatarFlagSummary(params datarapi.GetDatarFlagSummaryParams) middle
 		FlagID:           summary.FlagID,
 		TrafficByVariant: variants,
 		TrafficBySegment: segs,
+		TrafficByHoldout: holdouts,
 		TrafficByDay:     days,
 	})
 }
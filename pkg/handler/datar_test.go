@@ -128,6 +128,7 @@ func TestDatarEndpoints_FlagSummary(t *testing.T) {
 	assert.NoError(t, db.Exec(`INSERT INTO datar_hourly_events (flag_id, variant_id, segment_id, bucket_hour, eval_count) VALUES (1, 1, 10, ?, 100)`, now).Error)
 	assert.NoError(t, db.Exec(`INSERT INTO datar_hourly_events (flag_id, variant_id, segment_id, bucket_hour, eval_count) VALUES (1, 2, 10, ?, 50)`, now).Error)
 	assert.NoError(t, db.Exec(`INSERT INTO datar_hourly_events (flag_id, variant_id, segment_id, bucket_hour, eval_count) VALUES (1, 1, 10, ?, 25)`, prev).Error)
+	assert.NoError(t, db.Exec(`INSERT INTO datar_hourly_events (flag_id, variant_id, holdout_id, bucket_hour, eval_count) VALUES (1, 1, 3, ?, 5)`, now).Error)
 
 	resp := HandleGetDatarFlagSummary(datarapi.GetDatarFlagSummaryParams{FlagID: 1})
 	assert.NotNil(t, resp)
@@ -140,7 +141,7 @@ func TestDatarEndpoints_FlagSummary(t *testing.T) {
 	assert.Equal(t, int64(1), okResp.Payload.FlagID)
 	if assert.Len(t, okResp.Payload.TrafficByVariant, 2, "variant totals") {
 		assert.Equal(t, int64(1), okResp.Payload.TrafficByVariant[0].VariantID)
-		assert.Equal(t, int64(125), okResp.Payload.TrafficByVariant[0].Count, "variant 1 count")
+		assert.Equal(t, int64(130), okResp.Payload.TrafficByVariant[0].Count, "variant 1 count")
 		assert.Equal(t, int64(2), okResp.Payload.TrafficByVariant[1].VariantID)
 		assert.Equal(t, int64(50), okResp.Payload.TrafficByVariant[1].Count, "variant 2 count")
 	}
@@ -148,6 +149,11 @@ func TestDatarEndpoints_FlagSummary(t *testing.T) {
 	assert.Len(t, okResp.Payload.TrafficBySegment, 1)
 	assert.Equal(t, int64(10), okResp.Payload.TrafficBySegment[0].SegmentID)
 
+	if assert.Len(t, okResp.Payload.TrafficByHoldout, 1) {
+		assert.Equal(t, int64(3), okResp.Payload.TrafficByHoldout[0].HoldoutID)
+		assert.Equal(t, int64(5), okResp.Payload.TrafficByHoldout[0].Count)
+	}
+
 	assert.Len(t, okResp.Payload.TrafficByDay, 2, "should have 2 daily buckets")
 }
 
@@ -3,8 +3,13 @@ package handler
 import (
 	"encoding/json"
 	"fmt"
+	"maps"
 	"math/rand"
+	"runtime"
+	"slices"
+	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/foxdalas/flagr/pkg/config"
@@ -25,6 +30,8 @@ type Eval interface {
 	GetEvaluationBatch(evaluation.GetEvaluationBatchParams) middleware.Responder
 	PostEvaluation(evaluation.PostEvaluationParams) middleware.Responder
 	PostEvaluationBatch(evaluation.PostEvaluationBatchParams) middleware.Responder
+	PostEvaluationExplain(evaluation.PostEvaluationExplainParams) middleware.Responder
+	PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams) middleware.Responder
 }
 
 // NewEval creates a new Eval instance
@@ -41,15 +48,18 @@ func (e *eval) GetEvaluationBatch(params evaluation.GetEvaluationBatchParams) mi
 	}
 
 	var ctx = make(map[string]any)
-	for k, v := range params.HTTPRequest.URL.Query() {
+	query := params.HTTPRequest.URL.Query()
+	// sorted, so that device is set before device.os is merged into it
+	for _, k := range slices.Sorted(maps.Keys(query)) {
 		if k == "entityId" || k == "flagId" || k == "flagKey" || k == "flagTag" || k == "flagTagQuery" {
 			continue
 		}
+		v := query[k]
 		var value any
 		if err := json.Unmarshal([]byte(v[0]), &value); err != nil {
 			continue
 		}
-		ctx[k] = value
+		setQueryContextValue(ctx, k, value)
 	}
 	evaluationEntity := models.EvaluationEntity{
 		EntityID:      *params.EntityID,
@@ -61,42 +71,48 @@ func (e *eval) GetEvaluationBatch(params evaluation.GetEvaluationBatchParams) mi
 		flagTagsOperator = "ALL"
 	}
 
-	results := &models.EvaluationBatchResponse{}
+	evalContexts := make([]models.EvalContext, 0, len(params.FlagID)+len(params.FlagKey)+1)
 	if len(params.FlagTag) > 0 {
-		evalContext := models.EvalContext{
+		evalContexts = append(evalContexts, models.EvalContext{
 			EnableDebug:      false,
 			EntityContext:    evaluationEntity.EntityContext,
 			EntityID:         evaluationEntity.EntityID,
 			EntityType:       evaluationEntity.EntityType,
 			FlagTags:         params.FlagTag,
 			FlagTagsOperator: &flagTagsOperator,
-		}
-		evalResults := EvalFlagsByTags(evalContext)
-		results.EvaluationResults = append(results.EvaluationResults, evalResults...)
+		})
 	}
 	for _, flagID := range params.FlagID {
-		evalContext := models.EvalContext{
+		evalContexts = append(evalContexts, models.EvalContext{
 			EnableDebug:   false,
 			EntityContext: evaluationEntity.EntityContext,
 			EntityID:      evaluationEntity.EntityID,
 			EntityType:    evaluationEntity.EntityType,
 			FlagID:        flagID,
-		}
-
-		evalResult := EvalFlag(evalContext)
-		results.EvaluationResults = append(results.EvaluationResults, evalResult)
+		})
 	}
 	for _, flagKey := range params.FlagKey {
-		evalContext := models.EvalContext{
+		evalContexts = append(evalContexts, models.EvalContext{
 			EnableDebug:   false,
 			EntityContext: evaluationEntity.EntityContext,
 			EntityID:      evaluationEntity.EntityID,
 			EntityType:    evaluationEntity.EntityType,
 			FlagKey:       flagKey,
+		})
+	}
+	for _, evalContext := range evalContexts {
+		if err := checkEntityContext(evalContext); err != nil {
+			return evaluation.NewGetEvaluationBatchDefault(400).WithPayload(ErrorMessage("%s", err))
 		}
+	}
 
-		evalResult := EvalFlag(evalContext)
-		results.EvaluationResults = append(results.EvaluationResults, evalResult)
+	results := &models.EvaluationBatchResponse{}
+	for _, evalContext := range evalContexts {
+		if len(evalContext.FlagTags) > 0 {
+			results.EvaluationResults = append(results.EvaluationResults, EvalFlagsByTags(evalContext)...)
+			continue
+		}
+		results.EvaluationResults = append(results.EvaluationResults, EvalFlag(evalContext))
 	}
 
 	resp := evaluation.NewGetEvaluationBatchOK()
@@ -105,6 +121,36 @@ func (e *eval) GetEvaluationBatch(params evaluation.GetEvaluationBatchParams) mi
 	return resp
 }
 
+// setQueryContextValue sets a query param of the GET batch evaluation in the
+// entity context. Dotted keys like device.os are expanded into nested maps,
+// so that they can be referenced by nested constraint properties. A key that
+// conflicts with a non-map value is kept flat.
+func setQueryContextValue(ctx map[string]any, key string, value any) {
+	parts := strings.Split(key, ".")
+	if slices.Contains(parts, "") {
+		ctx[key] = value
+		return
+	}
+
+	current := ctx
+	for _, part := range parts[:len(parts)-1] {
+		next, ok := current[part]
+		if !ok {
+			m := map[string]any{}
+			current[part] = m
+			current = m
+			continue
+		}
+		m, ok := next.(map[string]any)
+		if !ok {
+			ctx[key] = value
+			return
+		}
+		current = m
+	}
+	current[parts[len(parts)-1]] = value
+}
+
 func (e *eval) PostEvaluation(params evaluation.PostEvaluationParams) middleware.Responder {
 	evalContext := params.Body
 	if evalContext == nil {
@@ -112,6 +158,10 @@ func (e *eval) PostEvaluation(params evaluation.PostEvaluationParams) middleware
 			ErrorMessage("empty body"))
 	}
 
+	if err := checkEntityContext(*evalContext); err != nil {
+		return evaluation.NewPostEvaluationDefault(400).WithPayload(ErrorMessage("%s", err))
+	}
+
 	evalResult := EvalFlag(*evalContext)
 	resp := evaluation.NewPostEvaluationOK()
 	resp.SetPayload(evalResult)
@@ -171,45 +221,43 @@ func (e *eval) PostEvaluationBatch(params evaluation.PostEvaluationBatchParams)
 		}
 	}
 
-	// TODO make it concurrent
+	jobs := make([]models.EvalContext, 0, len(entities)*(len(flagIDs)+len(flagKeys)+1))
 	for _, entity := range entities {
 		if len(flagTags) > 0 {
-			evalContext := models.EvalContext{
+			jobs = append(jobs, models.EvalContext{
 				EnableDebug:      params.Body.EnableDebug,
 				EntityContext:    entity.EntityContext,
 				EntityID:         entity.EntityID,
 				EntityType:       entity.EntityType,
 				FlagTags:         flagTags,
 				FlagTagsOperator: flagTagsOperator,
-			}
-			evalResults := EvalFlagsByTags(evalContext)
-			results.EvaluationResults = append(results.EvaluationResults, evalResults...)
+			})
 		}
 		for _, flagID := range flagIDs {
-			evalContext := models.EvalContext{
+			jobs = append(jobs, models.EvalContext{
 				EnableDebug:   params.Body.EnableDebug,
 				EntityContext: entity.EntityContext,
 				EntityID:      entity.EntityID,
 				EntityType:    entity.EntityType,
 				FlagID:        flagID,
-			}
-
-			evalResult := EvalFlag(evalContext)
-			results.EvaluationResults = append(results.EvaluationResults, evalResult)
+			})
 		}
 		for _, flagKey := range flagKeys {
-			evalContext := models.EvalContext{
+			jobs = append(jobs, models.EvalContext{
 				EnableDebug:   params.Body.EnableDebug,
 				EntityContext: entity.EntityContext,
 				EntityID:      entity.EntityID,
 				EntityType:    entity.EntityType,
 				FlagKey:       flagKey,
-			}
-
-			evalResult := EvalFlag(evalContext)
-			results.EvaluationResults = append(results.EvaluationResults, evalResult)
+			})
 		}
 	}
+	for _, job := range jobs {
+		if err := checkEntityContext(job); err != nil {
+			return evaluation.NewPostEvaluationBatchDefault(400).WithPayload(ErrorMessage("%s", err))
+		}
+	}
+	results.EvaluationResults = evalBatch(jobs, config.Config.EvalBatchConcurrency)
 
 	resp := evaluation.NewPostEvaluationBatchOK()
 	resp.SetETag(etag)
@@ -217,6 +265,54 @@ func (e *eval) PostEvaluationBatch(params evaluation.PostEvaluationBatchParams)
 	return resp
 }
 
+// evalBatch evaluates the eval contexts on a bounded pool of workers, and
+// returns their results in the order of the eval contexts. An eval context
+// with FlagTags is evaluated for all the flags of the tags. A concurrency of 0
+// uses GOMAXPROCS workers.
+func evalBatch(evalContexts []models.EvalContext, concurrency int) []*models.EvalResult {
+	if concurrency <= 0 {
+		concurrency = runtime.GOMAXPROCS(0)
+	}
+	concurrency = min(concurrency, len(evalContexts))
+
+	evalOne := func(evalContext models.EvalContext) []*models.EvalResult {
+		if len(evalContext.FlagTags) > 0 {
+			return EvalFlagsByTags(evalContext)
+		}
+		return []*models.EvalResult{EvalFlag(evalContext)}
+	}
+
+	// each worker writes only the slots of the eval contexts it takes, so
+	// the order doesn't depend on which worker finishes first
+	slots := make([][]*models.EvalResult, len(evalContexts))
+	if concurrency <= 1 {
+		for i, evalContext := range evalContexts {
+			slots[i] = evalOne(evalContext)
+		}
+	} else {
+		var next atomic.Int64
+		var wg sync.WaitGroup
+		for range concurrency {
+			wg.Go(func() {
+				for {
+					i := int(next.Add(1) - 1)
+					if i >= len(evalContexts) {
+						return
+					}
+					slots[i] = evalOne(evalContexts[i])
+				}
+			})
+		}
+		wg.Wait()
+	}
+
+	var evalResults []*models.EvalResult
+	for _, slot := range slots {
+		evalResults = append(evalResults, slot...)
+	}
+	return evalResults
+}
+
 // BlankResult creates a blank result
 func BlankResult(f *entity.Flag, evalContext models.EvalContext, msg string) *models.EvalResult {
 	flagID := uint(0)
@@ -247,6 +343,9 @@ func BlankResult(f *entity.Flag, evalContext models.EvalContext, msg string) *mo
 	}
 }
 
+// evalTimeNow is the time the activation windows are checked against
+var evalTimeNow = time.Now
+
 var LookupFlag = func(evalContext models.EvalContext) *entity.Flag {
 	cache := GetEvalCache()
 	flagID := util.SafeUint(evalContext.FlagID)
@@ -274,60 +373,297 @@ var EvalFlag = func(evalContext models.EvalContext) *models.EvalResult {
 }
 
 var EvalFlagWithContext = func(flag *entity.Flag, evalContext models.EvalContext) *models.EvalResult {
-	flagID := util.SafeUint(evalContext.FlagID)
-	flagKey := util.SafeString(evalContext.FlagKey)
-
+	d := decideFlag(flag, evalContext, nil, nil)
 	if flag == nil {
+		flagID := util.SafeUint(evalContext.FlagID)
+		flagKey := util.SafeString(evalContext.FlagKey)
 		emptyFlag := &entity.Flag{Model: gorm.Model{ID: flagID}, Key: flagKey}
-		return BlankResult(emptyFlag, evalContext, fmt.Sprintf("flagID %v not found or deleted", flagID))
+		return BlankResult(emptyFlag, evalContext, d.msg)
+	}
+
+	evalResult := BlankResult(flag, d.evalContext, d.msg)
+	if v := d.variant(flag); v != nil {
+		evalResult.VariantID = int64(v.ID)
+		evalResult.VariantKey = v.Key
+		evalResult.VariantAttachment = v.Attachment
+	} else {
+		evalResult.VariantID = int64(d.variantID)
+	}
+	evalResult.DefaultVariant = d.defaultVariant
+	if !d.assigned {
+		return evalResult
+	}
+
+	evalResult.IndividualTarget = d.individualTarget
+	if d.holdout != nil {
+		evalResult.HoldoutID = int64(d.holdout.ID)
+		evalResult.HoldoutKey = d.holdout.Key
+	}
+	if d.segmentsEvaluated {
+		evalResult.EvalDebugLog.SegmentDebugLogs = d.segmentLogs
+		evalResult.BucketKey = d.bucketKey
+		evalResult.SegmentID = d.lastSegmentID
+	}
+
+	logEvalResult(evalResult, flag.DataRecordsEnabled)
+	evalResult.DataRecordsEnabled = flag.DataRecordsEnabled
+	return evalResult
+}
+
+// flagDecision is how the evaluation of a flag decided on the variant of an
+// entity. EvalFlagWithContext, the explanations, the simulations and the
+// prerequisites are all built from it, so that they cannot disagree.
+type flagDecision struct {
+	reason      string             // models.EvalExplanationReason*
+	msg         string             // the debug message
+	evalContext models.EvalContext // as evaluated, e.g. with the entity type of the flag
+
+	// assigned is set once the entity is assigned by its target, its
+	// holdout or the segments, which is logged and recorded
+	assigned          bool
+	variantID         uint
+	defaultVariant    bool
+	individualTarget  bool
+	holdout           *entity.Holdout
+	bucketKey         string
+	segmentsEvaluated bool
+	segmentID         int64 // the segment that matched
+	lastSegmentID     int64 // the last segment evaluated
+	segmentLogs       []*models.SegmentDebugLog
+
+	// segments are only recorded by a segmentExplainer
+	segments []*models.SegmentExplanation
+}
+
+// decideFlag evaluates the flag for the entity step by step, see
+// docs/flagr_evaluation.md. If ex is set, the evaluated segments are explained
+// by it. visited holds the flag keys of the prerequisites being evaluated, nil
+// for the flag that is evaluated on its own.
+func decideFlag(flag *entity.Flag, evalContext models.EvalContext, ex *segmentExplainer, visited map[string]bool) *flagDecision {
+	d := &flagDecision{evalContext: evalContext}
+	if flag == nil {
+		d.reason = models.EvalExplanationReasonFLAGNOTFOUND
+		d.msg = fmt.Sprintf("flagID %v not found or deleted", util.SafeUint(evalContext.FlagID))
+		return d
 	}
 
 	if !flag.Enabled {
-		return BlankResult(flag, evalContext, fmt.Sprintf("flagID %v is not enabled", flag.ID))
+		d.reason = models.EvalExplanationReasonFLAGDISABLED
+		d.msg = fmt.Sprintf("flagID %v is not enabled", flag.ID)
+		return d.withDefaultVariant(flag)
+	}
+
+	if w := flag.ActivationWindow; w != nil && !w.IsActive(evalTimeNow()) {
+		d.reason = models.EvalExplanationReasonFLAGNOTACTIVE
+		d.msg = fmt.Sprintf("flagID %v is not active. activation window: %s", flag.ID, w)
+		return d.withDefaultVariant(flag)
+	}
+
+	debug := ex != nil || config.Config.EvalDebugEnabled && evalContext.EnableDebug
+	if v := flag.FlagEvaluation.TargetsMap[evalContext.EntityID]; v != nil {
+		if flag.EntityType != "" {
+			d.evalContext.EntityType = flag.EntityType
+		}
+		d.reason = models.EvalExplanationReasonINDIVIDUALTARGET
+		if debug {
+			d.msg = fmt.Sprintf("entity %s is individually targeted to variant %s", evalContext.EntityID, v.Key)
+		}
+		d.assigned = true
+		d.variantID = v.ID
+		d.individualTarget = true
+		return d
 	}
 
 	if len(flag.Segments) == 0 {
-		return BlankResult(flag, evalContext, fmt.Sprintf("flagID %v has no segments", flag.ID))
+		d.reason = models.EvalExplanationReasonNOSEGMENTS
+		d.msg = fmt.Sprintf("flagID %v has no segments", flag.ID)
+		return d.withDefaultVariant(flag)
 	}
 
-	if evalContext.EntityID == "" {
-		evalContext.EntityID = fmt.Sprintf("randomly_generated_%d", rand.Int31())
+	if d.evalContext.EntityID == "" {
+		d.evalContext.EntityID = fmt.Sprintf("randomly_generated_%d", rand.Int31())
 	}
 
 	if flag.EntityType != "" {
-		evalContext.EntityType = flag.EntityType
+		d.evalContext.EntityType = flag.EntityType
 	}
 
-	logs := []*models.SegmentDebugLog{}
-	var vID int64
-	var sID int64
+	bucketContext, bucketMsg := bucketingContext(flag, d.evalContext)
+	d.bucketKey = bucketContext.EntityID
+	if slot, ok := flag.LayerSlot(bucketContext.EntityID); !ok {
+		d.reason = models.EvalExplanationReasonLAYERSLOTNOTOWNED
+		d.msg = fmt.Sprintf("flagID %v doesn't own slot %d of layer %s", flag.ID, slot, flag.Layer.Key)
+		return d.withDefaultVariant(flag)
+	}
 
+	if visited == nil {
+		visited = map[string]bool{flag.Key: true}
+	}
+	if msg, ok := evalPrerequisites(flag, d.evalContext, visited); !ok {
+		d.reason = models.EvalExplanationReasonPREREQUISITENOTMET
+		d.msg = msg
+		return d.withDefaultVariant(flag)
+	}
+
+	d.assigned = true
+	if flag.InHoldout(d.evalContext.EntityID) {
+		// the entity is excluded from the experiment, its segments are not evaluated
+		d.reason = models.EvalExplanationReasonHOLDOUT
+		if debug {
+			d.msg = fmt.Sprintf("entity is in holdout %s", flag.Holdout.Key)
+		}
+		d.holdout = flag.Holdout
+		d.variantID = flag.HoldoutVariantID
+		return d
+	}
+
+	if debug {
+		d.msg = bucketMsg
+	}
+	d.segmentsEvaluated = true
+	vID, matched := evalSegments(flag, bucketContext, d, ex)
+	d.variantID = util.SafeUint(vID)
+	switch {
+	case !matched:
+		d.reason = models.EvalExplanationReasonNOSEGMENTMATCH
+		d.withDefaultVariant(flag)
+	case vID == 0:
+		d.reason = models.EvalExplanationReasonNOTROLLEDOUT
+	default:
+		d.reason = models.EvalExplanationReasonSEGMENTMATCH
+	}
+	return d
+}
+
+// withDefaultVariant sets the default variant of the flag, if it has one, on
+// a decision that gives the entity no variant: the flag is disabled, not
+// active, has no segments, doesn't own the entity's layer slot, its
+// prerequisites are not met or no segment matched. The holdout has its own,
+// required variant.
+func (d *flagDecision) withDefaultVariant(flag *entity.Flag) *flagDecision {
+	if flag.DefaultVariantID == 0 || flag.FlagEvaluation.VariantsMap[flag.DefaultVariantID] == nil {
+		return d
+	}
+	d.variantID = flag.DefaultVariantID
+	d.defaultVariant = true
+	return d
+}
+
+// variant returns the variant the flag of the decision assigned, if any
+func (d *flagDecision) variant(flag *entity.Flag) *entity.Variant {
+	if flag == nil {
+		return nil
+	}
+	return flag.FlagEvaluation.VariantsMap[d.variantID]
+}
+
+// bucketingContext returns the evalContext with the EntityID replaced by the
+// value the entity is bucketed by, and a debug message. It's the BucketBy
+// attribute of the flag if present in the EntityContext, so that e.g. all the
+// users of a company get the same variant, and the EntityID otherwise.
+func bucketingContext(flag *entity.Flag, evalContext models.EvalContext) (models.EvalContext, string) {
+	if flag.BucketBy == "" {
+		return evalContext, ""
+	}
+
+	m, _ := evalContext.EntityContext.(map[string]any)
+	key, err := flag.BucketKey(m)
+	if err != nil {
+		return evalContext, fmt.Sprintf("bucketBy %s not usable, bucketing by entityID. %s", flag.BucketBy, err)
+	}
+	evalContext.EntityID = key
+	return evalContext, fmt.Sprintf("bucketing by %s %q", flag.BucketBy, key)
+}
+
+// evalSegments evaluates the segments of the flag in order, records them in
+// the decision, and returns the variant the entity is assigned to and whether
+// any segment matched. Segments outside of their activation window are
+// skipped.
+func evalSegments(flag *entity.Flag, evalContext models.EvalContext, d *flagDecision, ex *segmentExplainer) (vID int64, matched bool) {
+	d.segmentLogs = []*models.SegmentDebugLog{}
+	debug := config.Config.EvalDebugEnabled && evalContext.EnableDebug
 	for _, segment := range flag.Segments {
-		sID = int64(segment.ID)
+		if matched {
+			if ex != nil {
+				d.segments = append(d.segments, newSegmentExplanation(segment))
+			}
+			continue
+		}
+
+		if w := segment.ActivationWindow; w != nil && !w.IsActive(evalTimeNow()) {
+			if debug {
+				d.segmentLogs = append(d.segmentLogs, &models.SegmentDebugLog{
+					Msg:       fmt.Sprintf("segment_id %v is not active. activation window: %s", segment.ID, w),
+					SegmentID: int64(segment.ID),
+				})
+			}
+			if ex != nil {
+				d.segments = append(d.segments, ex.inactiveSegment(segment))
+			}
+			continue
+		}
+
+		d.lastSegmentID = int64(segment.ID)
 		variantID, log, evalNextSegment := evalSegment(flag.ID, evalContext, segment)
-		if config.Config.EvalDebugEnabled && evalContext.EnableDebug {
-			logs = append(logs, log)
+		if debug {
+			d.segmentLogs = append(d.segmentLogs, log)
 		}
 		if variantID != nil {
 			vID = int64(*variantID)
 		}
-		if !evalNextSegment {
-			break
+		matched = !evalNextSegment
+		if matched {
+			d.segmentID = int64(segment.ID)
+		}
+		if ex != nil {
+			d.segments = append(d.segments, ex.evaluatedSegment(flag, evalContext, segment, variantID, log, matched))
 		}
 	}
-	evalResult := BlankResult(flag, evalContext, "")
-	evalResult.EvalDebugLog.SegmentDebugLogs = logs
-	evalResult.SegmentID = sID
-	evalResult.VariantID = vID
-	v := flag.FlagEvaluation.VariantsMap[util.SafeUint(vID)]
-	if v != nil {
-		evalResult.VariantAttachment = v.Attachment
-		evalResult.VariantKey = v.Key
-	}
+	return vID, matched
+}
 
-	logEvalResult(evalResult, flag.DataRecordsEnabled)
-	evalResult.DataRecordsEnabled = flag.DataRecordsEnabled
-	return evalResult
+// evalPrerequisites evaluates the prerequisite flags of the flag for the same
+// entity, and reports whether all of them got one of the required variants.
+// The prerequisite flags are looked up in the EvalCache and their results are
+// not logged. visited holds the flag keys on the current path, so that a cycle
+// that slipped past the validation cannot recurse forever.
+func evalPrerequisites(flag *entity.Flag, evalContext models.EvalContext, visited map[string]bool) (string, bool) {
+	for _, p := range flag.Prerequisites {
+		if visited[p.FlagKey] {
+			return fmt.Sprintf("flagID %v prerequisite flag %s forms a cycle", flag.ID, p.FlagKey), false
+		}
+
+		pf := GetEvalCache().GetByFlagKey(p.FlagKey)
+		if pf == nil {
+			return fmt.Sprintf("flagID %v prerequisite flag %s not found or deleted", flag.ID, p.FlagKey), false
+		}
+
+		visited[p.FlagKey] = true
+		pd := decideFlag(pf, evalContext, nil, visited)
+		delete(visited, p.FlagKey)
+
+		// a disabled or inactive prerequisite flag is not met, even with a
+		// default variant
+		switch pd.reason {
+		case models.EvalExplanationReasonFLAGDISABLED:
+			return fmt.Sprintf("flagID %v prerequisite flag %s is not enabled", flag.ID, p.FlagKey), false
+		case models.EvalExplanationReasonFLAGNOTACTIVE:
+			return fmt.Sprintf("flagID %v prerequisite flag %s is not active", flag.ID, p.FlagKey), false
+		case models.EvalExplanationReasonLAYERSLOTNOTOWNED, models.EvalExplanationReasonPREREQUISITENOTMET:
+			if !pd.defaultVariant {
+				return fmt.Sprintf("flagID %v prerequisite flag %s not met. %s", flag.ID, p.FlagKey, pd.msg), false
+			}
+		}
+
+		variantKey := ""
+		if v := pf.FlagEvaluation.VariantsMap[pd.variantID]; v != nil {
+			variantKey = v.Key
+		}
+		if !slices.Contains(p.VariantKeys, variantKey) {
+			return fmt.Sprintf("flagID %v prerequisite flag %s not met. got variant %q, expecting one of %v", flag.ID, p.FlagKey, variantKey, []string(p.VariantKeys)), false
+		}
+	}
+	return "", true
 }
 
 var logEvalResult = func(r *models.EvalResult, dataRecordsEnabled bool) {
@@ -390,7 +726,9 @@ var evalSegment = func(
 	log *models.SegmentDebugLog,
 	evalNextSegment bool,
 ) {
-	if len(segment.Constraints) != 0 {
+	matchedMsg := "matched all constraints. "
+	se := segment.SegmentEvaluation
+	if len(segment.Constraints) != 0 || len(se.Audiences) != 0 {
 		m, ok := evalContext.EntityContext.(map[string]any)
 		if !ok {
 			log = &models.SegmentDebugLog{
@@ -400,36 +738,46 @@ var evalSegment = func(
 			return nil, log, true
 		}
 
-		expr := segment.SegmentEvaluation.ConditionsExpr
-		match, err := conditions.Evaluate(expr, m)
-		if err != nil {
-			if config.Config.EvalDebugEnabled && evalContext.EnableDebug {
-				log = &models.SegmentDebugLog{
-					Msg:       err.Error(),
-					SegmentID: int64(segment.ID),
+		debug := config.Config.EvalDebugEnabled && evalContext.EnableDebug
+		if len(se.ConstraintGroups) != 0 {
+			msg, ok := matchConstraintGroups(se.ConstraintGroups, m, debug)
+			if !ok {
+				if debug {
+					log = &models.SegmentDebugLog{
+						Msg:       msg,
+						SegmentID: int64(segment.ID),
+					}
 				}
+				return nil, log, true
 			}
-			return nil, log, true
+			matchedMsg = msg
 		}
-		if !match {
-			if config.Config.EvalDebugEnabled && evalContext.EnableDebug {
-				log = &models.SegmentDebugLog{
-					Msg:       debugConstraintMsg(evalContext.EnableDebug, expr, m),
-					SegmentID: int64(segment.ID),
+
+		for _, a := range se.Audiences {
+			if len(a.ConstraintGroups) == 0 {
+				continue
+			}
+			msg, ok := matchConstraintGroups(a.ConstraintGroups, m, debug)
+			if !ok {
+				if debug {
+					log = &models.SegmentDebugLog{
+						Msg:       fmt.Sprintf("audience %s not match. %s", a.Key, msg),
+						SegmentID: int64(segment.ID),
+					}
 				}
+				return nil, log, true
 			}
-			return nil, log, true
 		}
 	}
 
 	vID, debugMsg := segment.SegmentEvaluation.DistributionArray.Rollout(
 		evalContext.EntityID,
-		segment.SegmentEvaluation.FlagIDStr, // pre-formatted during PrepareEvaluation
+		segment.SegmentEvaluation.Salt, // pre-formatted during PrepareEvaluation
 		segment.RolloutPercent,
 	)
 
 	log = &models.SegmentDebugLog{
-		Msg:       "matched all constraints. " + debugMsg,
+		Msg:       matchedMsg + debugMsg,
 		SegmentID: int64(segment.ID),
 	}
 
@@ -438,11 +786,64 @@ var evalSegment = func(
 	return vID, log, false
 }
 
-func debugConstraintMsg(enableDebug bool, expr conditions.Expr, m map[string]any) string {
+// matchConstraintGroups matches the constraint groups in order, the first
+// group that matches wins. If a group matches, it returns the debug message
+// prefix naming the matched group. Otherwise, if debug is enabled, it returns
+// the reason why each group didn't match.
+func matchConstraintGroups(groups []entity.ConstraintGroupEvaluation, m map[string]any, debug bool) (string, bool) {
+	msgs := make([]string, 0, len(groups))
+	for _, g := range groups {
+		match, constraint, err := matchConstraintGroup(g, m)
+		if match {
+			msg := "matched all constraints. "
+			if len(groups) > 1 {
+				msg = fmt.Sprintf("matched all constraints of group %d. ", g.Group)
+			}
+			return msg, true
+		}
+		if !debug {
+			continue
+		}
+		if err != nil {
+			msgs = append(msgs, err.Error())
+		} else {
+			msgs = append(msgs, debugConstraintMsg(debug, constraint, m))
+		}
+	}
+
+	if len(msgs) <= 1 {
+		return strings.Join(msgs, ""), false
+	}
+	for i, g := range groups {
+		msgs[i] = fmt.Sprintf("group %d: %s", g.Group, msgs[i])
+	}
+	return "constraint not match in any group. " + strings.Join(msgs, " "), false
+}
+
+// matchConstraintGroup evaluates the conditions expression and then the native
+// constraint matchers of the group. It returns the first constraint that
+// doesn't match or fails to evaluate.
+func matchConstraintGroup(g entity.ConstraintGroupEvaluation, m map[string]any) (bool, fmt.Stringer, error) {
+	if g.ConditionsExpr != nil {
+		match, err := conditions.Evaluate(g.ConditionsExpr, m)
+		if err != nil || !match {
+			return false, g.ConditionsExpr, err
+		}
+	}
+	for _, cm := range g.ConstraintMatchers {
+		match, err := cm.Match(m)
+		if err != nil || !match {
+			return false, cm, err
+		}
+	}
+	return true, nil, nil
+}
+
+func debugConstraintMsg(enableDebug bool, constraint fmt.Stringer, m map[string]any) string {
 	if !enableDebug {
 		return ""
 	}
-	return fmt.Sprintf("constraint not match. constraint: %s, entity_context: %+v.", expr, m)
+	return fmt.Sprintf("constraint not match. constraint: %s, resolved: %s, entity_context: %+v.", constraint, entity.ResolvedProperties(constraint, m), m)
 }
 
 var rateLimitMap = sync.Map{}
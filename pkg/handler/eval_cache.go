@@ -26,6 +26,12 @@ type cacheContainer struct {
 	idCache  map[string]*entity.Flag
 	keyCache map[string]*entity.Flag
 	tagCache map[string]map[uint]*entity.Flag
+
+	lists    []entity.List
+	listSets map[string]entity.ListSet
+
+	entityTypes      []entity.FlagEntityType
+	entityAttributes map[string]entity.EntityAttributes
 }
 
 // getFetcher returns the flag data fetcher, creating and caching it on first
@@ -52,6 +58,11 @@ type EvalCache struct {
 	refreshTimeout  time.Duration
 	refreshInterval time.Duration
 
+	// fullReloadInterval is the interval of the full reloads. In between, the
+	// reloads only fetch the flags with new flag snapshots. 0 disables the
+	// incremental reloads.
+	fullReloadInterval time.Duration
+
 	// fetcher is the source of flag data. It is created once in Start()
 	// and reused across refresh cycles. For DB mode it wraps *gorm.DB;
 	// for eval-only mode (json_file, json_http) it reads from the
@@ -64,6 +75,9 @@ type EvalCache struct {
 	// lastSnapshotMaxID > 0 indicates at least one successful load has occurred.
 	lastSnapshotMaxID uint
 
+	// lastFullReload is the time of the last successful full reload
+	lastFullReload time.Time
+
 	version atomic.Int64 // unix timestamp (ms) of last successful cache reload, used for ETag
 }
 
@@ -74,6 +88,8 @@ var GetEvalCache = func() *EvalCache {
 			cache:           &cacheContainer{},
 			refreshTimeout:  config.Config.EvalCacheRefreshTimeout,
 			refreshInterval: config.Config.EvalCacheRefreshInterval,
+
+			fullReloadInterval: config.Config.EvalCacheFullReloadInterval,
 		}
 		singletonEvalCache = ec
 	})
@@ -180,6 +196,23 @@ func (ec *EvalCache) GetByFlagKeyOrID(keyOrID any) *entity.Flag {
 	return f
 }
 
+// GetByFlagKey gets the flag by Key
+func (ec *EvalCache) GetByFlagKey(key string) *entity.Flag {
+	ec.cacheMutex.RLock()
+	defer ec.cacheMutex.RUnlock()
+
+	return ec.cache.keyCache[key]
+}
+
+// GetEntityAttributes gets the declared attributes of the entity contexts of
+// the entity type, nil if the entity type has none
+func (ec *EvalCache) GetEntityAttributes(entityType string) entity.EntityAttributes {
+	ec.cacheMutex.RLock()
+	defer ec.cacheMutex.RUnlock()
+
+	return ec.cache.entityAttributes[entityType]
+}
+
 // getSnapshotMaxID queries the latest flag_snapshot id. Returns 0 on error.
 // This is the lightweight change indicator used by the EvalCache to decide
 // whether a full reload is needed.
@@ -212,7 +245,8 @@ func (ec *EvalCache) shortCircuitReload(snapshotMaxID uint) bool {
 // reloadMapCache reloads the evaluation cache from the database. It short-circuits
 // when no new flag_snapshots have been created, since every API mutation that
 // affects evaluation data (flags, segments, variants, constraints, distributions,
-// tags) creates a flag_snapshot row.
+// tags) creates a flag_snapshot row. Otherwise, it only reloads the flags with
+// new flag_snapshots, see incrementalFetcher.
 func (ec *EvalCache) reloadMapCache() error {
 	if config.Config.NewRelicEnabled {
 		defer config.Global.NewrelicApp.StartTransaction("eval_cache_reload").End()
@@ -228,18 +262,25 @@ func (ec *EvalCache) reloadMapCache() error {
 	}
 
 	_, _, err := withtimeout.Do(ec.refreshTimeout, func() (any, error) {
-		idCache, keyCache, tagCache, err := ec.loadAndBuildCaches()
+		var cache *cacheContainer
+		var err error
+		fetcher, lastSnapshotMaxID, incremental := ec.incrementalFetcher(preFetchMaxID)
+		if incremental {
+			cache, err = ec.loadAndPatchCaches(fetcher, lastSnapshotMaxID)
+		} else {
+			cache, err = ec.loadAndBuildCaches()
+		}
 		if err != nil {
 			return nil, err
 		}
 
 		ec.cacheMutex.Lock()
-		ec.cache = &cacheContainer{
-			idCache:  idCache,
-			keyCache: keyCache,
-			tagCache: tagCache,
-		}
+		ec.cache = cache
+		entity.SetListSets(cache.listSets)
 		ec.lastSnapshotMaxID = preFetchMaxID
+		if !incremental {
+			ec.lastFullReload = time.Now()
+		}
 		ec.version.Store(time.Now().UnixMilli())
 		ec.cacheMutex.Unlock()
 
@@ -249,6 +290,27 @@ func (ec *EvalCache) reloadMapCache() error {
 	return err
 }
 
+// incrementalFetcher returns the fetcher and the lastSnapshotMaxID to reload
+// only the changed flags with, and false when all the flags must be reloaded:
+// on the first load, when the fetcher can't fetch the changed flags, when the
+// flag_snapshots went backwards, and every fullReloadInterval, as a safety net
+// for the changes that an incremental reload can miss, like a flag_snapshot
+// committed after one with a higher ID.
+func (ec *EvalCache) incrementalFetcher(snapshotMaxID uint) (evalCacheIncrementalFetcher, uint, bool) {
+	fetcher, ok := ec.getFetcher().(evalCacheIncrementalFetcher)
+	if !ok || ec.fullReloadInterval <= 0 {
+		return nil, 0, false
+	}
+
+	ec.cacheMutex.RLock()
+	defer ec.cacheMutex.RUnlock()
+
+	if ec.lastSnapshotMaxID == 0 || snapshotMaxID < ec.lastSnapshotMaxID || time.Since(ec.lastFullReload) >= ec.fullReloadInterval {
+		return nil, 0, false
+	}
+	return fetcher, ec.lastSnapshotMaxID, true
+}
+
 // GetETag returns an ETag string based on the unix millisecond timestamp of the last cache reload.
 // Using a timestamp ensures the ETag survives application restarts without false 304 responses.
 func (ec *EvalCache) GetETag() string {
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"io"
+	"maps"
 	"net/http"
 	"os"
 
@@ -16,9 +17,13 @@ import (
 	"gorm.io/gorm"
 )
 
-// EvalCacheJSON is the JSON serialization format of EvalCache's flags
+// EvalCacheJSON is the JSON serialization format of EvalCache's flags, the
+// lists referenced by their IN_LIST and NOT_IN_LIST constraints, and the
+// entity types that declare the attributes of their entity contexts
 type EvalCacheJSON struct {
-	Flags []entity.Flag
+	Flags       []entity.Flag
+	Lists       []entity.List           `json:",omitempty"`
+	EntityTypes []entity.FlagEntityType `json:",omitempty"`
 }
 
 func (ec *EvalCache) export(query export.GetExportEvalCacheJSONParams) EvalCacheJSON {
@@ -42,6 +47,8 @@ func (ec *EvalCache) export(query export.GetExportEvalCacheJSONParams) EvalCache
 	defer ec.cacheMutex.RUnlock()
 
 	idCache := ec.cache.idCache
+	lists := ec.cache.lists
+	entityTypes := ec.cache.entityTypes
 
 	// IDs have highest priority — direct O(k) lookup
 	if targetIDs != nil {
@@ -51,7 +58,7 @@ func (ec *EvalCache) export(query export.GetExportEvalCacheJSONParams) EvalCache
 				fs = append(fs, *f)
 			}
 		}
-		return EvalCacheJSON{Flags: fs}
+		return EvalCacheJSON{Flags: fs, Lists: lists, EntityTypes: entityTypes}
 	}
 
 	// Keys have second priority — direct O(k) lookup
@@ -63,7 +70,7 @@ func (ec *EvalCache) export(query export.GetExportEvalCacheJSONParams) EvalCache
 				fs = append(fs, *f)
 			}
 		}
-		return EvalCacheJSON{Flags: fs}
+		return EvalCacheJSON{Flags: fs, Lists: lists, EntityTypes: entityTypes}
 	}
 
 	// Tags — use tagCache for direct lookup instead of O(n) scan
@@ -76,7 +83,7 @@ func (ec *EvalCache) export(query export.GetExportEvalCacheJSONParams) EvalCache
 			for i, tag := range query.Tags {
 				fSet, ok := tagCache[tag]
 				if !ok {
-					return EvalCacheJSON{Flags: []entity.Flag{}}
+					return EvalCacheJSON{Flags: []entity.Flag{}, Lists: lists, EntityTypes: entityTypes}
 				}
 				if i == 0 {
 					for fID, f := range fSet {
@@ -89,7 +96,7 @@ func (ec *EvalCache) export(query export.GetExportEvalCacheJSONParams) EvalCache
 						}
 					}
 					if len(candidates) == 0 {
-						return EvalCacheJSON{Flags: []entity.Flag{}}
+						return EvalCacheJSON{Flags: []entity.Flag{}, Lists: lists, EntityTypes: entityTypes}
 					}
 				}
 			}
@@ -112,7 +119,7 @@ func (ec *EvalCache) export(query export.GetExportEvalCacheJSONParams) EvalCache
 			}
 			fs = append(fs, *f)
 		}
-		return EvalCacheJSON{Flags: fs}
+		return EvalCacheJSON{Flags: fs, Lists: lists, EntityTypes: entityTypes}
 	}
 
 	// Enabled-only or no filters — O(n) scan
@@ -123,25 +130,31 @@ func (ec *EvalCache) export(query export.GetExportEvalCacheJSONParams) EvalCache
 		}
 		fs = append(fs, *f)
 	}
-	return EvalCacheJSON{Flags: fs}
+	return EvalCacheJSON{Flags: fs, Lists: lists, EntityTypes: entityTypes}
 }
 
 // loadAndBuildCaches fetches all flags from the configured fetcher and builds
-// the three lookup caches (idCache, keyCache, tagCache) used by the EvalCache.
-func (ec *EvalCache) loadAndBuildCaches() (idCache map[string]*entity.Flag, keyCache map[string]*entity.Flag, tagCache map[string]map[uint]*entity.Flag, err error) {
-	fs, err := ec.getFetcher().fetch()
+// the three lookup caches (idCache, keyCache, tagCache) used by the EvalCache,
+// together with the sets of the lists.
+func (ec *EvalCache) loadAndBuildCaches() (*cacheContainer, error) {
+	fetcher := ec.getFetcher()
+	fs, err := fetcher.fetch()
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, err
+	}
+	cache, err := loadListsAndEntityTypes(fetcher, nil)
+	if err != nil {
+		return nil, err
 	}
 
-	idCache = make(map[string]*entity.Flag)
-	keyCache = make(map[string]*entity.Flag)
-	tagCache = make(map[string]map[uint]*entity.Flag)
+	idCache := make(map[string]*entity.Flag)
+	keyCache := make(map[string]*entity.Flag)
+	tagCache := make(map[string]map[uint]*entity.Flag)
 
 	for i := range fs {
 		f := &fs[i]
 		if err := f.PrepareEvaluation(); err != nil {
-			return nil, nil, nil, err
+			return nil, err
 		}
 
 		if f.ID != 0 {
@@ -159,13 +172,188 @@ func (ec *EvalCache) loadAndBuildCaches() (idCache map[string]*entity.Flag, keyC
 			}
 		}
 	}
-	return idCache, keyCache, tagCache, nil
+	cache.idCache = idCache
+	cache.keyCache = keyCache
+	cache.tagCache = tagCache
+	return cache, nil
+}
+
+// loadAndPatchCaches fetches only the flags that have flag snapshots newer
+// than lastSnapshotMaxID, and patches them into a copy of the current caches.
+// A changed flag that isn't fetched anymore has been deleted. Only the values
+// of the lists that changed since are fetched, see loadListsAndEntityTypes.
+func (ec *EvalCache) loadAndPatchCaches(fetcher evalCacheIncrementalFetcher, lastSnapshotMaxID uint) (*cacheContainer, error) {
+	flagIDs, fs, err := fetcher.fetchChanged(lastSnapshotMaxID)
+	if err != nil {
+		return nil, err
+	}
+	for i := range fs {
+		if err := fs[i].PrepareEvaluation(); err != nil {
+			return nil, err
+		}
+	}
+
+	ec.cacheMutex.RLock()
+	current := ec.cache
+	ec.cacheMutex.RUnlock()
+
+	cache, err := loadListsAndEntityTypes(ec.getFetcher(), current)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.idCache = maps.Clone(current.idCache)
+	cache.keyCache = maps.Clone(current.keyCache)
+	cache.tagCache = maps.Clone(current.tagCache)
+	p := &cacheContainerPatch{cacheContainer: cache, clonedTags: map[string]bool{}}
+	for _, id := range flagIDs {
+		p.removeFlag(id)
+	}
+	for i := range fs {
+		p.addFlag(&fs[i])
+	}
+	return cache, nil
+}
+
+// loadListsAndEntityTypes returns a cacheContainer with the lists and the
+// entity types of the fetcher, if it provides them. Given the current
+// cacheContainer, the lists that are still at the same version keep their
+// values and sets, and the fetcher only fetches the values of the others if it
+// can, because the lists can be large.
+func loadListsAndEntityTypes(fetcher evalCacheFetcher, current *cacheContainer) (*cacheContainer, error) {
+	var err error
+	var lists []entity.List
+	if cf, ok := fetcher.(evalCacheChangedListFetcher); ok && current != nil {
+		if lists, err = cf.fetchChangedLists(current.lists); err != nil {
+			return nil, err
+		}
+	} else if lf, ok := fetcher.(evalCacheListFetcher); ok {
+		if lists, err = lf.fetchLists(); err != nil {
+			return nil, err
+		}
+	}
+
+	var loaded map[string]*entity.List
+	if current != nil {
+		loaded = make(map[string]*entity.List, len(current.lists))
+		for i := range current.lists {
+			loaded[current.lists[i].Key] = &current.lists[i]
+		}
+	}
+	listSets := make(map[string]entity.ListSet, len(lists))
+	for i, l := range lists {
+		if prev, ok := loaded[l.Key]; ok && prev.SameVersion(&lists[i]) {
+			if set, ok := current.listSets[l.Key]; ok {
+				listSets[l.Key] = set
+				continue
+			}
+		}
+		listSets[l.Key] = entity.NewListSet(l.Values)
+	}
+
+	var entityTypes []entity.FlagEntityType
+	if tf, ok := fetcher.(evalCacheEntityTypeFetcher); ok {
+		if entityTypes, err = tf.fetchEntityTypes(); err != nil {
+			return nil, err
+		}
+	}
+	entityAttributes := make(map[string]entity.EntityAttributes, len(entityTypes))
+	for _, et := range entityTypes {
+		entityAttributes[et.Key] = et.Attributes
+	}
+
+	return &cacheContainer{
+		lists:    lists,
+		listSets: listSets,
+
+		entityTypes:      entityTypes,
+		entityAttributes: entityAttributes,
+	}, nil
+}
+
+// cacheContainerPatch patches the shallow copies of the caches of a
+// cacheContainer. The flag sets of tagCache are still shared with the
+// original, so they are cloned before their first change, because the
+// evaluations may be reading the original while it's patched.
+type cacheContainerPatch struct {
+	*cacheContainer
+	clonedTags map[string]bool
+}
+
+func (p *cacheContainerPatch) tagSet(tag string) map[uint]*entity.Flag {
+	set := p.tagCache[tag]
+	if !p.clonedTags[tag] {
+		set = maps.Clone(set)
+		p.clonedTags[tag] = true
+	}
+	if set == nil {
+		set = make(map[uint]*entity.Flag)
+	}
+	p.tagCache[tag] = set
+	return set
+}
+
+// removeFlag removes the cached flag of the ID from the caches, under its
+// cached key and tags, which may differ from the ones of its new version
+func (p *cacheContainerPatch) removeFlag(id uint) {
+	f, ok := p.idCache[util.SafeString(id)]
+	if !ok {
+		return
+	}
+	delete(p.idCache, util.SafeString(id))
+	if p.keyCache[f.Key] == f {
+		delete(p.keyCache, f.Key)
+	}
+	for _, t := range f.Tags {
+		set := p.tagSet(t.Value)
+		delete(set, f.ID)
+		if len(set) == 0 {
+			delete(p.tagCache, t.Value)
+		}
+	}
+}
+
+func (p *cacheContainerPatch) addFlag(f *entity.Flag) {
+	if f.ID != 0 {
+		p.idCache[util.SafeString(f.ID)] = f
+	}
+	if f.Key != "" {
+		p.keyCache[f.Key] = f
+	}
+	for _, t := range f.Tags {
+		p.tagSet(t.Value)[f.ID] = f
+	}
 }
 
 type evalCacheFetcher interface {
 	fetch() ([]entity.Flag, error)
 }
 
+// evalCacheListFetcher is implemented by the fetchers that also provide the
+// lists referenced by IN_LIST and NOT_IN_LIST constraints
+type evalCacheListFetcher interface {
+	fetchLists() ([]entity.List, error)
+}
+
+// evalCacheChangedListFetcher is implemented by the list fetchers that can
+// reuse the values of the already loaded lists that haven't changed since
+type evalCacheChangedListFetcher interface {
+	fetchChangedLists(loaded []entity.List) ([]entity.List, error)
+}
+
+// evalCacheIncrementalFetcher is implemented by the fetchers that can fetch
+// only the flags changed since a flag snapshot. It returns the IDs of the
+// changed flags, and the ones of them that still exist.
+type evalCacheIncrementalFetcher interface {
+	fetchChanged(lastSnapshotMaxID uint) ([]uint, []entity.Flag, error)
+}
+
+// evalCacheEntityTypeFetcher is implemented by the fetchers that also provide
+// the entity types with the attributes of their entity contexts
+type evalCacheEntityTypeFetcher interface {
+	fetchEntityTypes() ([]entity.FlagEntityType, error)
+}
+
 func newFetcher() (evalCacheFetcher, error) {
 	if !config.Config.EvalOnlyMode {
 		return &dbFetcher{db: getDB()}, nil
@@ -194,6 +382,11 @@ var fetchAllFlags = func() ([]entity.Flag, error) {
 
 type jsonFileFetcher struct {
 	filePath string
+
+	// lists and entity types are read from the same file as the flags on the
+	// last fetch
+	lists       []entity.List
+	entityTypes []entity.FlagEntityType
 }
 
 func (ff *jsonFileFetcher) fetch() ([]entity.Flag, error) {
@@ -201,11 +394,30 @@ func (ff *jsonFileFetcher) fetch() ([]entity.Flag, error) {
 	if err != nil {
 		return nil, err
 	}
-	return unmarshalFlags(b)
+	ecj, err := unmarshalEvalCacheJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	ff.lists = ecj.Lists
+	ff.entityTypes = ecj.EntityTypes
+	return ecj.Flags, nil
+}
+
+func (ff *jsonFileFetcher) fetchLists() ([]entity.List, error) {
+	return ff.lists, nil
+}
+
+func (ff *jsonFileFetcher) fetchEntityTypes() ([]entity.FlagEntityType, error) {
+	return ff.entityTypes, nil
 }
 
 type jsonHTTPFetcher struct {
 	url string
+
+	// lists and entity types are read from the same response as the flags on
+	// the last fetch
+	lists       []entity.List
+	entityTypes []entity.FlagEntityType
 }
 
 func (hf *jsonHTTPFetcher) fetch() ([]entity.Flag, error) {
@@ -219,10 +431,24 @@ func (hf *jsonHTTPFetcher) fetch() ([]entity.Flag, error) {
 	if err != nil {
 		return nil, err
 	}
-	return unmarshalFlags(b)
+	ecj, err := unmarshalEvalCacheJSON(b)
+	if err != nil {
+		return nil, err
+	}
+	hf.lists = ecj.Lists
+	hf.entityTypes = ecj.EntityTypes
+	return ecj.Flags, nil
+}
+
+func (hf *jsonHTTPFetcher) fetchLists() ([]entity.List, error) {
+	return hf.lists, nil
 }
 
-// unmarshalFlags parses JSON bytes into EvalCacheJSON and returns the flags.
+func (hf *jsonHTTPFetcher) fetchEntityTypes() ([]entity.FlagEntityType, error) {
+	return hf.entityTypes, nil
+}
+
+// unmarshalEvalCacheJSON parses JSON bytes into EvalCacheJSON.
 // It auto-assigns IDs to any entities with zero IDs, which is essential for
 // hand-edited JSON files where picking unique IDs for every entity is impractical.
 //
@@ -230,7 +456,7 @@ func (hf *jsonHTTPFetcher) fetch() ([]entity.Flag, error) {
 // lenient to allow incremental flag authoring. Validation errors, however,
 // DO prevent loading: a flag definition with broken references or missing
 // required fields would produce incorrect evaluation results.
-func unmarshalFlags(b []byte) ([]entity.Flag, error) {
+func unmarshalEvalCacheJSON(b []byte) (*EvalCacheJSON, error) {
 	ecj := &EvalCacheJSON{}
 	if err := json.Unmarshal(b, ecj); err != nil {
 		return nil, err
@@ -238,7 +464,7 @@ func unmarshalFlags(b []byte) ([]entity.Flag, error) {
 
 	// Validate after parsing — operates on entity structs directly,
 	// giving actionable warnings for hand-edited files.
-	result := ValidateFlags(ecj.Flags)
+	result := ValidateEvalCacheJSON(*ecj)
 	if !result.OK() {
 		for _, e := range result.Errors {
 			logrus.Errorf("flag validation error: %s", e)
@@ -250,7 +476,7 @@ func unmarshalFlags(b []byte) ([]entity.Flag, error) {
 	}
 
 	normalizeIDs(ecj.Flags)
-	return ecj.Flags, nil
+	return ecj, nil
 }
 
 // setIfZeroAndBumpNext evaluates *target: if zero, sets it to next and
@@ -277,6 +503,7 @@ func setIfZeroAndBumpNext(target *uint, next uint) uint {
 //   - Every entity type has globally unique IDs
 //   - Distribution.VariantID matches a Variant.ID in the same flag
 //   - Segment.FlagID, Constraint.SegmentID, Distribution.SegmentID are set
+//   - FlagTarget.VariantID is resolved from its VariantKey
 func normalizeIDs(flags []entity.Flag) {
 	// Pass 1: find the max existing ID per type so we never collide
 	var nextFlagID, nextVariantID, nextSegmentID, nextConstraintID, nextDistributionID, nextTagID uint = 1, 1, 1, 1, 1, 1
@@ -349,6 +576,19 @@ func normalizeIDs(flags []entity.Flag) {
 		for j := range flags[i].Tags {
 			nextTagID = setIfZeroAndBumpNext(&flags[i].Tags[j].ID, nextTagID)
 		}
+		for j := range flags[i].Targets {
+			t := &flags[i].Targets[j]
+			t.FlagID = flags[i].ID
+			// Targets are usually written by hand with just the VariantKey
+			if t.VariantID == 0 && t.VariantKey != "" {
+				for _, v := range flags[i].Variants {
+					if v.Key == t.VariantKey {
+						t.VariantID = v.ID
+						break
+					}
+				}
+			}
+		}
 	}
 }
 
@@ -363,3 +603,31 @@ func (df *dbFetcher) fetch() ([]entity.Flag, error) {
 	err := entity.PreloadSegmentsVariantsTags(df.db).Find(&fs).Error
 	return fs, err
 }
+
+func (df *dbFetcher) fetchChanged(lastSnapshotMaxID uint) ([]uint, []entity.Flag, error) {
+	flagIDs := []uint{}
+	if err := df.db.Model(&entity.FlagSnapshot{}).
+		Where("id > ?", lastSnapshotMaxID).
+		Distinct().
+		Pluck("flag_id", &flagIDs).Error; err != nil {
+		return nil, nil, err
+	}
+	fs := []entity.Flag{}
+	if len(flagIDs) == 0 {
+		return flagIDs, fs, nil
+	}
+	err := entity.PreloadSegmentsVariantsTags(df.db).Where("id IN ?", flagIDs).Find(&fs).Error
+	return flagIDs, fs, err
+}
+
+func (df *dbFetcher) fetchLists() ([]entity.List, error) {
+	return entity.LoadLists(df.db)
+}
+
+func (df *dbFetcher) fetchChangedLists(loaded []entity.List) ([]entity.List, error) {
+	return entity.LoadChangedLists(df.db, loaded)
+}
+
+func (df *dbFetcher) fetchEntityTypes() ([]entity.FlagEntityType, error) {
+	return entity.LoadEntityTypeSchemas(df.db)
+}
@@ -158,6 +158,21 @@ func TestNormalizeIDs_VariantKeyResolution(t *testing.T) {
 	assert.Equal(t, uint(2), d1.VariantID) // treatment
 }
 
+func TestNormalizeIDs_TargetVariantKeyResolution(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key:      "my-flag",
+			Variants: []entity.Variant{{Key: "control"}, {Key: "treatment"}},
+			Targets:  []entity.FlagTarget{{EntityID: "qa_account", VariantKey: "treatment"}},
+		},
+	}
+
+	normalizeIDs(flags)
+
+	assert.Equal(t, uint(1), flags[0].Targets[0].FlagID)
+	assert.Equal(t, uint(2), flags[0].Targets[0].VariantID)
+}
+
 func TestNormalizeIDs_EmptyFlags(t *testing.T) {
 	flags := []entity.Flag{}
 	normalizeIDs(flags)
@@ -350,8 +365,9 @@ func TestUnmarshalFlags_NoIDs(t *testing.T) {
 		}]
 	}`
 
-	flags, err := unmarshalFlags([]byte(jsonData))
+	ecj, err := unmarshalEvalCacheJSON([]byte(jsonData))
 	assert.NoError(t, err)
+	flags := ecj.Flags
 	assert.Len(t, flags, 1)
 
 	f := flags[0]
@@ -370,9 +386,10 @@ func TestUnmarshalFlags_NoIDs(t *testing.T) {
 }
 
 func TestUnmarshalFlags_EmptyFlags(t *testing.T) {
-	flags, err := unmarshalFlags([]byte(`{"Flags": []}`))
+	ecj, err := unmarshalEvalCacheJSON([]byte(`{"Flags": []}`))
 	assert.NoError(t, err)
-	assert.Empty(t, flags)
+	assert.Empty(t, ecj.Flags)
+	assert.Empty(t, ecj.Lists)
 }
 
 func TestNormalizeIDs_DistributionWithExplicitVariantID(t *testing.T) {
@@ -445,8 +462,9 @@ func TestNormalizeIDs_ComplexHandEditedFile(t *testing.T) {
 		]
 	}`
 
-	flags, err := unmarshalFlags([]byte(jsonData))
+	ecj, err := unmarshalEvalCacheJSON([]byte(jsonData))
 	assert.NoError(t, err)
+	flags := ecj.Flags
 	assert.Len(t, flags, 1)
 	f := flags[0]
 
@@ -527,20 +545,20 @@ func TestNormalizeIDs_SampleDataUnchanged(t *testing.T) {
 }
 
 func TestUnmarshalFlags_InvalidJSON(t *testing.T) {
-	_, err := unmarshalFlags([]byte(`{bad json`))
+	_, err := unmarshalEvalCacheJSON([]byte(`{bad json`))
 	assert.Error(t, err)
 }
 
 func TestUnmarshalFlags_ValidationErrors(t *testing.T) {
 	// Valid JSON with validation errors must be rejected.
-	_, err := unmarshalFlags([]byte(`{"Flags": [{"Key": ""}]}`))
+	_, err := unmarshalEvalCacheJSON([]byte(`{"Flags": [{"Key": ""}]}`))
 	assert.Error(t, err)
 	assert.Contains(t, err.Error(), "flag validation failed")
 }
 
 func TestUnmarshalFlags_WarningsAreAllowed(t *testing.T) {
 	// Warnings (e.g. no segments, no variants) should not prevent loading.
-	flags, err := unmarshalFlags([]byte(`{
+	ecj, err := unmarshalEvalCacheJSON([]byte(`{
 		"Flags": [{
 			"Key": "my-flag",
 			"Enabled": true,
@@ -549,8 +567,8 @@ func TestUnmarshalFlags_WarningsAreAllowed(t *testing.T) {
 		}]
 	}`))
 	assert.NoError(t, err)
-	assert.Len(t, flags, 1)
-	assert.Equal(t, "my-flag", flags[0].Key)
+	assert.Len(t, ecj.Flags, 1)
+	assert.Equal(t, "my-flag", ecj.Flags[0].Key)
 }
 
 func TestNormalizeIDs_UnknownVariantKey(t *testing.T) {
@@ -1,8 +1,10 @@
 package handler
 
 import (
+	"reflect"
 	"slices"
 	"testing"
+	"time"
 
 	"github.com/foxdalas/flagr/pkg/config"
 	"github.com/foxdalas/flagr/pkg/entity"
@@ -90,6 +92,109 @@ func (c *countingFetcher) fetch() ([]entity.Flag, error) {
 	return c.wrapped.fetch()
 }
 
+// incrementalCountingFetcher counts the full and the incremental fetches
+type incrementalCountingFetcher struct {
+	dbFetcher
+	count        int
+	changedCount int
+}
+
+func (c *incrementalCountingFetcher) fetch() ([]entity.Flag, error) {
+	c.count++
+	return c.dbFetcher.fetch()
+}
+
+func (c *incrementalCountingFetcher) fetchChanged(lastSnapshotMaxID uint) ([]uint, []entity.Flag, error) {
+	c.changedCount++
+	return c.dbFetcher.fetchChanged(lastSnapshotMaxID)
+}
+
+func TestReloadMapCacheIncremental(t *testing.T) {
+	fixtureFlag := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(fixtureFlag)
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Fatalf("Failed to get database")
+	}
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer entity.SetListSets(nil)
+
+	// the other flag shares tag2 with the fixture flag
+	other := entity.GenFixtureFlagWithTags(101, "flag_key_101", true, nil)
+	assert.NoError(t, db.Where("value = ?", "tag2").Find(&other.Tags).Error)
+	assert.NoError(t, db.Create(&other).Error)
+	snapshot := func(flagID uint) {
+		entity.SaveFlagSnapshot(db, flagID, "test",
+			notification.OperationUpdate, notification.ComponentFlag, flagID, "")
+	}
+	snapshot(fixtureFlag.ID)
+	snapshot(other.ID)
+
+	spy := &incrementalCountingFetcher{dbFetcher: dbFetcher{db: db}}
+	ec := &EvalCache{
+		cache:              &cacheContainer{},
+		refreshTimeout:     time.Minute,
+		fullReloadInterval: time.Hour,
+		fetcher:            spy,
+	}
+
+	// 1st reload: full
+	assert.NoError(t, ec.reloadMapCache())
+	assert.Equal(t, 1, spy.count)
+	assert.Equal(t, 0, spy.changedCount)
+	assert.Len(t, ec.GetByTags([]string{"tag2"}, nil), 2)
+
+	// the key and the tags of a flag change
+	f := &entity.Flag{}
+	assert.NoError(t, db.First(f, fixtureFlag.ID).Error)
+	assert.NoError(t, db.Model(f).Update("key", "flag_key_renamed").Error)
+	assert.NoError(t, db.Model(f).Association("Tags").Replace([]entity.Tag{{Value: "tag3"}}))
+	snapshot(fixtureFlag.ID)
+	oldTagCache := ec.cache.tagCache
+
+	// 2nd reload: incremental, patching the key and the tags
+	assert.NoError(t, ec.reloadMapCache())
+	assert.Equal(t, 1, spy.count)
+	assert.Equal(t, 1, spy.changedCount)
+	assert.Nil(t, ec.GetByFlagKey("flag_key_100"))
+	assert.Equal(t, "flag_key_renamed", ec.GetByFlagKeyOrID(fixtureFlag.ID).Key)
+	assert.Empty(t, ec.GetByTags([]string{"tag1"}, nil))
+	assert.Len(t, ec.GetByTags([]string{"tag2"}, nil), 1)
+	assert.Len(t, ec.GetByTags([]string{"tag3"}, nil), 1)
+	assert.NotNil(t, ec.GetByFlagKey("flag_key_101"))
+	assert.NotNil(t, ec.GetByFlagKeyOrID(fixtureFlag.ID).FlagEvaluation.VariantsMap)
+	// the caches that the evaluations may still be reading are unchanged
+	assert.Len(t, oldTagCache["tag1"], 1)
+	assert.Len(t, oldTagCache["tag2"], 2)
+
+	// 3rd reload: incremental, removing a deleted flag
+	assert.NoError(t, db.Delete(&entity.Flag{}, other.ID).Error)
+	snapshot(other.ID)
+	assert.NoError(t, ec.reloadMapCache())
+	assert.Equal(t, 1, spy.count)
+	assert.Equal(t, 2, spy.changedCount)
+	assert.Nil(t, ec.GetByFlagKeyOrID(other.ID))
+	assert.Nil(t, ec.GetByFlagKey("flag_key_101"))
+	assert.Empty(t, ec.GetByTags([]string{"tag2"}, nil))
+
+	// 4th reload: full, after the full reload interval
+	ec.lastFullReload = time.Now().Add(-2 * time.Hour)
+	snapshot(fixtureFlag.ID)
+	assert.NoError(t, ec.reloadMapCache())
+	assert.Equal(t, 2, spy.count)
+	assert.Equal(t, 2, spy.changedCount)
+	assert.NotNil(t, ec.GetByFlagKey("flag_key_renamed"))
+
+	// with a full reload interval of 0, every reload is full
+	ec.fullReloadInterval = 0
+	snapshot(fixtureFlag.ID)
+	assert.NoError(t, ec.reloadMapCache())
+	assert.Equal(t, 3, spy.count)
+	assert.Equal(t, 2, spy.changedCount)
+}
+
 func TestReloadMapCacheShortCircuit(t *testing.T) {
 	fixtureFlag := entity.GenFixtureFlag()
 	db := entity.PopulateTestDB(fixtureFlag)
@@ -310,3 +415,88 @@ func withID(id uint) func(entity.Flag) bool {
 		return f.ID == id
 	}
 }
+
+func TestReloadMapCacheLists(t *testing.T) {
+	fixtureFlag := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(fixtureFlag)
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Fatalf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer entity.SetListSets(nil)
+
+	l := entity.List{Key: "beta_users", Size: 2}
+	assert.NoError(t, db.Create(&l).Error)
+	assert.NoError(t, db.Create(&[]entity.ListItem{
+		{ListID: l.ID, Value: "u1"},
+		{ListID: l.ID, Value: "u2"},
+	}).Error)
+
+	ec := GetEvalCache()
+	ec.fetcher = &dbFetcher{db: db}
+	ec.lastSnapshotMaxID = 0
+	assert.NoError(t, ec.reloadMapCache())
+
+	c := entity.Constraint{Property: "user_id", Operator: models.ConstraintOperatorINLIST, Value: `"beta_users"`}
+	m, err := c.ToMatcher()
+	assert.NoError(t, err)
+	ok, err := m.Match(map[string]any{"user_id": "u2"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+
+	ecj := ec.export(export.GetExportEvalCacheJSONParams{})
+	assert.Len(t, ecj.Lists, 1)
+	assert.Equal(t, []string{"u1", "u2"}, ecj.Lists[0].Values)
+}
+
+func TestReloadMapCacheIncrementalLists(t *testing.T) {
+	fixtureFlag := entity.GenFixtureFlag()
+	db := entity.PopulateTestDB(fixtureFlag)
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Fatalf("Failed to get database")
+	}
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+	defer entity.SetListSets(nil)
+
+	l1 := entity.List{Key: "l1", Size: 1}
+	l2 := entity.List{Key: "l2", Size: 1}
+	assert.NoError(t, db.Create(&l1).Error)
+	assert.NoError(t, db.Create(&l2).Error)
+	assert.NoError(t, db.Create(&[]entity.ListItem{
+		{ListID: l1.ID, Value: "a"},
+		{ListID: l2.ID, Value: "b"},
+	}).Error)
+	snapshot := func() {
+		entity.SaveFlagSnapshot(db, fixtureFlag.ID, "test",
+			notification.OperationUpdate, notification.ComponentFlag, fixtureFlag.ID, "")
+	}
+	snapshot()
+
+	ec := &EvalCache{
+		cache:              &cacheContainer{},
+		refreshTimeout:     time.Minute,
+		fullReloadInterval: time.Hour,
+		fetcher:            &dbFetcher{db: db},
+	}
+	assert.NoError(t, ec.reloadMapCache())
+	set1, set2 := ec.cache.listSets["l1"], ec.cache.listSets["l2"]
+
+	// only the values of l2 change
+	assert.NoError(t, db.Where("list_id = ?", l2.ID).Delete(&entity.ListItem{}).Error)
+	assert.NoError(t, db.Create(&entity.ListItem{ListID: l2.ID, Value: "c"}).Error)
+	assert.NoError(t, db.Model(&l2).Update("version", 1).Error)
+	snapshot()
+
+	assert.NoError(t, ec.reloadMapCache())
+	assert.Equal(t, reflect.ValueOf(set1).Pointer(), reflect.ValueOf(ec.cache.listSets["l1"]).Pointer())
+	assert.NotEqual(t, reflect.ValueOf(set2).Pointer(), reflect.ValueOf(ec.cache.listSets["l2"]).Pointer())
+	assert.Contains(t, ec.cache.listSets["l2"], "c")
+	assert.NotContains(t, ec.cache.listSets["l2"], "b")
+}
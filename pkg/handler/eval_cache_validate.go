@@ -3,7 +3,9 @@ package handler
 import (
 	"encoding/json"
 	"fmt"
+	"slices"
 	"sort"
+	"strings"
 
 	"github.com/foxdalas/flagr/pkg/entity"
 )
@@ -23,7 +25,8 @@ func (r ValidationResult) HasWarnings() bool { return len(r.Warnings) > 0 }
 // ValidateFlags validates a set of entity.Flag structs.
 // It performs semantic validation: required fields, key uniqueness,
 // constraint expressions, distribution integrity, variant references,
-// and percentage ranges.
+// percentage ranges, activation windows, prerequisite references and
+// cycles, layer slot ranges, holdout variants, and attachment schemas.
 func ValidateFlags(flags []entity.Flag) ValidationResult {
 	var r ValidationResult
 
@@ -44,9 +47,111 @@ func ValidateFlags(flags []entity.Flag) ValidationResult {
 		}
 	}
 
+	validatePrerequisites(&r, flags)
+	validateLayers(&r, flags)
+
+	return r
+}
+
+// ValidateEvalCacheJSON validates the flags, the lists and the entity types
+// of an EvalCacheJSON, including the list references of IN_LIST and
+// NOT_IN_LIST constraints, and the constraint properties of the flags of
+// entity types that declare attributes.
+func ValidateEvalCacheJSON(ecj EvalCacheJSON) ValidationResult {
+	r := ValidateFlags(ecj.Flags)
+	validateLists(&r, ecj.Lists, ecj.Flags)
+	validateEntityTypes(&r, ecj.EntityTypes, ecj.Flags)
 	return r
 }
 
+func validateEntityTypes(r *ValidationResult, entityTypes []entity.FlagEntityType, flags []entity.Flag) {
+	attributes := make(map[string]entity.EntityAttributes, len(entityTypes))
+	keys := make([]string, 0, len(entityTypes))
+	for i, et := range entityTypes {
+		if et.Key == "" {
+			r.Errors = append(r.Errors, fmt.Sprintf("entity type[%d]: missing or empty Key", i))
+			continue
+		}
+		keys = append(keys, et.Key)
+		if err := et.Attributes.Validate(); err != nil {
+			r.Errors = append(r.Errors, fmt.Sprintf("entity type %q: %v", et.Key, err))
+			continue
+		}
+		if _, ok := attributes[et.Key]; !ok {
+			attributes[et.Key] = et.Attributes
+		}
+	}
+	for _, d := range duplicates(keys) {
+		r.Errors = append(r.Errors, fmt.Sprintf("duplicate entity type key %q", d))
+	}
+
+	for _, f := range flags {
+		as := attributes[f.EntityType]
+		if f.Key == "" || len(as) == 0 {
+			continue
+		}
+		for i, seg := range f.Segments {
+			segDesc := seg.Description
+			if segDesc == "" {
+				segDesc = fmt.Sprintf("segment[%d]", i)
+			}
+			for _, c := range seg.Constraints {
+				if err := as.ValidateConstraint(c); err != nil {
+					r.Errors = append(r.Errors, fmt.Sprintf("flag %q, %s: entity type %q: %v", f.Key, segDesc, f.EntityType, err))
+				}
+			}
+			for _, a := range seg.Audiences {
+				for _, c := range a.Constraints {
+					if err := as.ValidateConstraint(c); err != nil {
+						r.Errors = append(r.Errors, fmt.Sprintf("flag %q, %s, audience %q: entity type %q: %v", f.Key, segDesc, a.Key, f.EntityType, err))
+					}
+				}
+			}
+		}
+	}
+}
+
+func validateLists(r *ValidationResult, lists []entity.List, flags []entity.Flag) {
+	known := make(map[string]bool, len(lists))
+	listKeys := make([]string, 0, len(lists))
+	for i, l := range lists {
+		if l.Key == "" {
+			r.Errors = append(r.Errors, fmt.Sprintf("list[%d]: missing or empty Key", i))
+			continue
+		}
+		known[l.Key] = true
+		listKeys = append(listKeys, l.Key)
+	}
+	for _, d := range duplicates(listKeys) {
+		r.Errors = append(r.Errors, fmt.Sprintf("duplicate list key %q", d))
+	}
+
+	checkRefs := func(prefix string, cs entity.ConstraintArray) {
+		for _, c := range cs {
+			if key, ok := c.ListKey(); ok && !known[key] {
+				r.Errors = append(r.Errors, fmt.Sprintf("%s: constraint %q %s %q references unknown list %q",
+					prefix, c.Property, c.Operator, c.Value, key))
+			}
+		}
+	}
+	for _, f := range flags {
+		if f.Key == "" {
+			continue
+		}
+		for i, seg := range f.Segments {
+			segDesc := seg.Description
+			if segDesc == "" {
+				segDesc = fmt.Sprintf("segment[%d]", i)
+			}
+			prefix := fmt.Sprintf("flag %q, %s", f.Key, segDesc)
+			checkRefs(prefix, seg.Constraints)
+			for _, a := range seg.Audiences {
+				checkRefs(fmt.Sprintf("%s, audience %q", prefix, a.Key), a.Constraints)
+			}
+		}
+	}
+}
+
 func validateFlag(r *ValidationResult, f entity.Flag, idx int) {
 	if f.Key == "" {
 		r.Errors = append(r.Errors, fmt.Sprintf("flag[%d]: missing or empty Key", idx))
@@ -54,6 +159,36 @@ func validateFlag(r *ValidationResult, f entity.Flag, idx int) {
 	}
 	prefix := fmt.Sprintf("flag %q", f.Key)
 
+	validateActivationWindow(r, prefix, f.ActivationWindow)
+	if err := entity.ValidateBucketBy(f.BucketBy); err != nil {
+		r.Errors = append(r.Errors, fmt.Sprintf("%s: %v", prefix, err))
+	}
+	if f.Holdout != nil && !slices.ContainsFunc(f.Variants, func(v entity.Variant) bool { return v.ID == f.HoldoutVariantID }) {
+		r.Errors = append(r.Errors, fmt.Sprintf("%s: holdout %q references unknown variant ID %d", prefix, f.Holdout.Key, f.HoldoutVariantID))
+	}
+	if f.DefaultVariantID != 0 && !slices.ContainsFunc(f.Variants, func(v entity.Variant) bool { return v.ID == f.DefaultVariantID }) {
+		r.Errors = append(r.Errors, fmt.Sprintf("%s: default variant references unknown variant ID %d", prefix, f.DefaultVariantID))
+	}
+	targeted := make(map[string]bool, len(f.Targets))
+	for _, t := range f.Targets {
+		if err := t.Validate(); err != nil {
+			r.Errors = append(r.Errors, fmt.Sprintf("%s: %v", prefix, err))
+			continue
+		}
+		if targeted[t.EntityID] {
+			r.Errors = append(r.Errors, fmt.Sprintf("%s: entity %q is targeted more than once", prefix, t.EntityID))
+		}
+		targeted[t.EntityID] = true
+		if !slices.ContainsFunc(f.Variants, func(v entity.Variant) bool { return v.Key == t.VariantKey }) {
+			r.Errors = append(r.Errors, fmt.Sprintf("%s: target %q references unknown variant key %q", prefix, t.EntityID, t.VariantKey))
+		}
+	}
+
+	schemaErr := f.AttachmentSchema.Validate()
+	if schemaErr != nil {
+		r.Errors = append(r.Errors, fmt.Sprintf("%s: %v", prefix, schemaErr))
+	}
+
 	if len(f.Variants) == 0 {
 		r.Warnings = append(r.Warnings, fmt.Sprintf("%s: no variants defined", prefix))
 	}
@@ -72,6 +207,11 @@ func validateFlag(r *ValidationResult, f entity.Flag, idx int) {
 				r.Errors = append(r.Errors, fmt.Sprintf("%s, variant %q: invalid Attachment JSON: %v", prefix, v.Key, err))
 			}
 		}
+		if schemaErr == nil {
+			if err := f.AttachmentSchema.ValidateAttachment(v.Attachment); err != nil {
+				r.Errors = append(r.Errors, fmt.Sprintf("%s, variant %q: %v", prefix, v.Key, err))
+			}
+		}
 	}
 	if dupes := duplicates(variantKeys); len(dupes) > 0 {
 		for _, d := range dupes {
@@ -89,11 +229,21 @@ func validateFlag(r *ValidationResult, f entity.Flag, idx int) {
 		}
 		segPrefix := fmt.Sprintf("%s, %s", prefix, segDesc)
 
-		if seg.RolloutPercent > 100 {
-			r.Errors = append(r.Errors, fmt.Sprintf("%s: RolloutPercent %d out of range (0-100)", segPrefix, seg.RolloutPercent))
+		if err := entity.ValidatePercent(seg.RolloutPercent); err != nil {
+			r.Errors = append(r.Errors, fmt.Sprintf("%s: RolloutPercent: %v", segPrefix, err))
 		}
 		validateDistributions(r, segPrefix, seg, variantKeySet)
 		validateConstraints(r, segPrefix, seg)
+		validateActivationWindow(r, segPrefix, seg.ActivationWindow)
+	}
+}
+
+func validateActivationWindow(r *ValidationResult, prefix string, w *entity.ActivationWindow) {
+	if w == nil {
+		return
+	}
+	if err := w.Validate(); err != nil {
+		r.Errors = append(r.Errors, fmt.Sprintf("%s: %v", prefix, err))
 	}
 }
 
@@ -103,12 +253,13 @@ func validateDistributions(r *ValidationResult, prefix string, seg entity.Segmen
 		return
 	}
 
+	// summed up in buckets, so that 33.3+33.3+33.4 adds up to exactly 100
 	sum := uint(0)
 	for _, d := range seg.Distributions {
-		sum += d.Percent
+		sum += entity.PercentBuckets(d.Percent)
 
-		if d.Percent > 100 {
-			r.Errors = append(r.Errors, fmt.Sprintf("%s: distribution percent %d out of range (0-100)", prefix, d.Percent))
+		if err := entity.ValidatePercent(d.Percent); err != nil {
+			r.Errors = append(r.Errors, fmt.Sprintf("%s: distribution %v", prefix, err))
 		}
 
 		if d.VariantKey == "" && d.VariantID == 0 {
@@ -121,13 +272,20 @@ func validateDistributions(r *ValidationResult, prefix string, seg entity.Segmen
 		}
 	}
 
-	if sum != 100 {
-		r.Errors = append(r.Errors, fmt.Sprintf("%s: distribution sum is %d (expected 100)", prefix, sum))
+	if sum != entity.TotalBucketNum {
+		r.Errors = append(r.Errors, fmt.Sprintf("%s: distribution sum is %v (expected 100)", prefix, float64(sum)/float64(entity.PercentMultiplier)))
 	}
 }
 
 func validateConstraints(r *ValidationResult, prefix string, seg entity.Segment) {
-	for _, c := range seg.Constraints {
+	validateConstraintArray(r, prefix, seg.Constraints)
+	for _, a := range seg.Audiences {
+		validateConstraintArray(r, fmt.Sprintf("%s, audience %q", prefix, a.Key), a.Constraints)
+	}
+}
+
+func validateConstraintArray(r *ValidationResult, prefix string, cs entity.ConstraintArray) {
+	for _, c := range cs {
 		entityConstraint := entity.Constraint{
 			Property: c.Property,
 			Operator: c.Operator,
@@ -140,6 +298,82 @@ func validateConstraints(r *ValidationResult, prefix string, seg entity.Segment)
 	}
 }
 
+func validatePrerequisites(r *ValidationResult, flags []entity.Flag) {
+	flagsByKey := make(map[string]*entity.Flag, len(flags))
+	for i := range flags {
+		flagsByKey[flags[i].Key] = &flags[i]
+	}
+
+	deps := make(map[string][]string)
+	for _, f := range flags {
+		if f.Key == "" {
+			continue
+		}
+		prefix := fmt.Sprintf("flag %q", f.Key)
+		for _, p := range f.Prerequisites {
+			if err := p.Validate(); err != nil {
+				r.Errors = append(r.Errors, fmt.Sprintf("%s: %v", prefix, err))
+				continue
+			}
+			deps[f.Key] = append(deps[f.Key], p.FlagKey)
+
+			pf, ok := flagsByKey[p.FlagKey]
+			if !ok {
+				r.Errors = append(r.Errors, fmt.Sprintf("%s: prerequisite references unknown flag key %q", prefix, p.FlagKey))
+				continue
+			}
+			for _, k := range p.VariantKeys {
+				if !slices.ContainsFunc(pf.Variants, func(v entity.Variant) bool { return v.Key == k }) {
+					r.Errors = append(r.Errors, fmt.Sprintf("%s: prerequisite flag %q has no variant key %q", prefix, p.FlagKey, k))
+				}
+			}
+		}
+	}
+
+	if cycle := entity.PrerequisiteCycle(deps); cycle != nil {
+		r.Errors = append(r.Errors, fmt.Sprintf("prerequisites form a cycle: %s", strings.Join(cycle, " -> ")))
+	}
+}
+
+// validateLayers validates that the flags in the same layer own disjoint slot
+// ranges within the layer, and bucket by the same attribute
+func validateLayers(r *ValidationResult, flags []entity.Flag) {
+	layers := map[uint]*entity.Layer{}
+	allocations := map[uint][]entity.LayerAllocation{}
+	bucketBys := map[uint][]string{}
+	for _, f := range flags {
+		if f.LayerID == nil || f.Layer == nil {
+			continue
+		}
+		id := *f.LayerID
+		layers[id] = f.Layer
+		allocations[id] = append(allocations[id], entity.LayerAllocation{
+			FlagID:    f.ID,
+			FlagKey:   f.Key,
+			SlotStart: f.LayerSlotStart,
+			SlotEnd:   f.LayerSlotEnd,
+		})
+		if !slices.Contains(bucketBys[id], f.BucketBy) {
+			bucketBys[id] = append(bucketBys[id], f.BucketBy)
+		}
+	}
+
+	ids := make([]uint, 0, len(layers))
+	for id := range layers {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	for _, id := range ids {
+		prefix := fmt.Sprintf("layer %q", layers[id].Key)
+		if err := entity.ValidateLayerAllocations(layers[id].Slots, allocations[id]); err != nil {
+			r.Errors = append(r.Errors, fmt.Sprintf("%s: %v", prefix, err))
+		}
+		if len(bucketBys[id]) > 1 {
+			r.Errors = append(r.Errors, fmt.Sprintf("%s: flags bucket by different attributes %q", prefix, bucketBys[id]))
+		}
+	}
+}
+
 // duplicates returns the duplicate values in a string slice, sorted.
 func duplicates(ss []string) []string {
 	seen := make(map[string]int, len(ss))
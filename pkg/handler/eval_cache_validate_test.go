@@ -6,6 +6,7 @@ import (
 
 	"github.com/foxdalas/flagr/pkg/entity"
 	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
 )
 
 // --- Basic structural tests ---
@@ -280,6 +281,40 @@ func TestValidateFlags_DistributionSumNot100(t *testing.T) {
 	assert.True(t, found, "should have distribution sum error: %v", r.Errors)
 }
 
+func TestValidateFlags_FractionalPercents(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key: "my-flag",
+			Variants: []entity.Variant{
+				{Key: "a"},
+				{Key: "b"},
+				{Key: "c"},
+			},
+			Segments: []entity.Segment{
+				{
+					Description:    "all",
+					RolloutPercent: 0.5,
+					Distributions: []entity.Distribution{
+						{VariantKey: "a", Percent: 33.3},
+						{VariantKey: "b", Percent: 33.3},
+						{VariantKey: "c", Percent: 33.4},
+					},
+				},
+			},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.True(t, r.OK(), "errors: %v", r.Errors)
+
+	flags[0].Segments[0].RolloutPercent = 0.25
+	flags[0].Segments[0].Distributions[2].Percent = 33.35
+	r = ValidateFlags(flags)
+	assert.Equal(t, []string{
+		`flag "my-flag", all: RolloutPercent: percent 0.25 is not a multiple of 0.1`,
+		`flag "my-flag", all: distribution percent 33.35 is not a multiple of 0.1`,
+	}, r.Errors)
+}
+
 func TestValidateFlags_UnknownVariantKey(t *testing.T) {
 	flags := []entity.Flag{
 		{
@@ -556,6 +591,122 @@ func TestValidateFlags_InvalidConstraintRegex(t *testing.T) {
 	assert.True(t, found, "should have constraint error: %v", r.Errors)
 }
 
+func TestValidateFlags_InvalidConstraintSemver(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key: "my-flag",
+			Variants: []entity.Variant{
+				{Key: "on"},
+			},
+			Segments: []entity.Segment{
+				{
+					Description:    "all",
+					RolloutPercent: 100,
+					Distributions: []entity.Distribution{
+						{VariantKey: "on", Percent: 100},
+					},
+					Constraints: []entity.Constraint{
+						{Property: "app_version", Operator: "SEMVER_GTE", Value: "\"1.2.0\""},
+						{Property: "app_version", Operator: "SEMVER_LT", Value: "\"2.x\""},
+					},
+				},
+			},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 1)
+	assert.Contains(t, r.Errors[0], "SEMVER_LT")
+}
+
+func TestValidateFlags_InvalidConstraintCIDR(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key: "my-flag",
+			Variants: []entity.Variant{
+				{Key: "on"},
+			},
+			Segments: []entity.Segment{
+				{
+					Description:    "all",
+					RolloutPercent: 100,
+					Distributions: []entity.Distribution{
+						{VariantKey: "on", Percent: 100},
+					},
+					Constraints: []entity.Constraint{
+						{Property: "ip", Operator: "IN_CIDR", Value: `["10.0.0.0/8", "2001:db8::/32"]`},
+						{Property: "ip", Operator: "NOT_IN_CIDR", Value: `["192.168.0.0/33"]`},
+					},
+				},
+			},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 1)
+	assert.Contains(t, r.Errors[0], "192.168.0.0/33")
+}
+
+func TestValidateFlags_InvalidConstraintString(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key: "my-flag",
+			Variants: []entity.Variant{
+				{Key: "on"},
+			},
+			Segments: []entity.Segment{
+				{
+					Description:    "all",
+					RolloutPercent: 100,
+					Distributions: []entity.Distribution{
+						{VariantKey: "on", Percent: 100},
+					},
+					Constraints: []entity.Constraint{
+						{Property: "email", Operator: "ENDS_WITH", Value: `["@example.com", "@example.org"]`},
+						{Property: "email", Operator: "EQ_IGNORE_CASE", Value: `"Jane@Example.com"`},
+						{Property: "state", Operator: "IN_IGNORE_CASE", Value: `"CA"`},
+						{Property: "email", Operator: "STARTS_WITH", Value: `""`},
+					},
+				},
+			},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 2)
+	assert.Contains(t, r.Errors[0], "IN_IGNORE_CASE")
+	assert.Contains(t, r.Errors[1], "STARTS_WITH")
+}
+
+func TestValidateFlags_InvalidConstraintTime(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key: "my-flag",
+			Variants: []entity.Variant{
+				{Key: "on"},
+			},
+			Segments: []entity.Segment{
+				{
+					Description:    "all",
+					RolloutPercent: 100,
+					Distributions: []entity.Distribution{
+						{VariantKey: "on", Percent: 100},
+					},
+					Constraints: []entity.Constraint{
+						{Property: "created_at", Operator: "AFTER", Value: "\"2026-01-01T00:00:00Z\""},
+						{Property: "trial_ends_at", Operator: "BEFORE", Value: "\"now+7d\""},
+						{Property: "trial_ends_at", Operator: "BEFORE", Value: "\"next week\""},
+					},
+				},
+			},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 1)
+	assert.Contains(t, r.Errors[0], "next week")
+}
+
 func TestValidateFlags_ValidConstraintEQ(t *testing.T) {
 	flags := []entity.Flag{
 		{
@@ -631,6 +782,106 @@ func TestValidateFlags_ValidConstraintRegex(t *testing.T) {
 	assert.True(t, r.OK())
 }
 
+// --- Prerequisite tests ---
+
+func TestValidateFlags_ValidPrerequisites(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key:      "new-checkout",
+			Variants: []entity.Variant{{Key: "on"}},
+			Prerequisites: []entity.FlagPrerequisite{
+				{FlagKey: "new-payments-backend", VariantKeys: []string{"on"}},
+			},
+		},
+		{
+			Key:      "new-payments-backend",
+			Variants: []entity.Variant{{Key: "on"}, {Key: "off"}},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.True(t, r.OK())
+}
+
+func TestValidateFlags_UnknownPrerequisite(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key:      "new-checkout",
+			Variants: []entity.Variant{{Key: "on"}},
+			Prerequisites: []entity.FlagPrerequisite{
+				{FlagKey: "unknown-flag", VariantKeys: []string{"on"}},
+				{FlagKey: "new-payments-backend", VariantKeys: []string{"on", "enabled"}},
+				{FlagKey: "new-payments-backend"},
+			},
+		},
+		{
+			Key:      "new-payments-backend",
+			Variants: []entity.Variant{{Key: "on"}, {Key: "off"}},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 3)
+	assert.Contains(t, r.Errors[0], `unknown flag key "unknown-flag"`)
+	assert.Contains(t, r.Errors[1], `no variant key "enabled"`)
+	assert.Contains(t, r.Errors[2], "has no variant keys")
+}
+
+func TestValidateFlags_PrerequisiteCycle(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key:      "flag-a",
+			Variants: []entity.Variant{{Key: "on"}},
+			Prerequisites: []entity.FlagPrerequisite{
+				{FlagKey: "flag-b", VariantKeys: []string{"on"}},
+			},
+		},
+		{
+			Key:      "flag-b",
+			Variants: []entity.Variant{{Key: "on"}},
+			Prerequisites: []entity.FlagPrerequisite{
+				{FlagKey: "flag-a", VariantKeys: []string{"on"}},
+			},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 1)
+	assert.Contains(t, r.Errors[0], "flag-a -> flag-b -> flag-a")
+}
+
+func TestValidateFlags_InvalidBucketBy(t *testing.T) {
+	flags := []entity.Flag{
+		{Key: "flag-a", Variants: []entity.Variant{{Key: "on"}}, BucketBy: "company["},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 1)
+	assert.Contains(t, r.Errors[0], `flag "flag-a": invalid BucketBy`)
+}
+
+func TestValidateFlags_InvalidActivationWindow(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key:              "flag-a",
+			Variants:         []entity.Variant{{Key: "on"}},
+			ActivationWindow: &entity.ActivationWindow{Timezone: "Nowhere/Special"},
+			Segments: []entity.Segment{
+				{
+					Description:      "business hours",
+					RolloutPercent:   100,
+					Distributions:    []entity.Distribution{{VariantKey: "on", Percent: 100}},
+					ActivationWindow: &entity.ActivationWindow{StartTime: "09:00"},
+				},
+			},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 2)
+	assert.Contains(t, r.Errors[0], `flag "flag-a": invalid activation window Timezone "Nowhere/Special"`)
+	assert.Contains(t, r.Errors[1], `flag "flag-a", business hours: activation window needs both StartTime and EndTime`)
+}
+
 // --- Composite tests ---
 
 func TestValidateFlags_ComplexValidFile(t *testing.T) {
@@ -687,3 +938,205 @@ func TestValidateFlags_MultipleErrors(t *testing.T) {
 	assert.False(t, r.OK())
 	assert.True(t, len(r.Errors) >= 1, "should have at least one error: %v", r.Errors)
 }
+
+func TestValidateFlags_Layers(t *testing.T) {
+	layer := &entity.Layer{Key: "checkout", Slots: 100}
+	layer.ID = 1
+	inLayer := func(key string, start, end uint, bucketBy string) entity.Flag {
+		return entity.Flag{
+			Key:            key,
+			Variants:       []entity.Variant{{Key: "on"}},
+			BucketBy:       bucketBy,
+			LayerID:        &layer.ID,
+			Layer:          layer,
+			LayerSlotStart: start,
+			LayerSlotEnd:   end,
+		}
+	}
+
+	r := ValidateFlags([]entity.Flag{
+		inLayer("flag-a", 0, 50, ""),
+		inLayer("flag-b", 50, 100, ""),
+	})
+	assert.True(t, r.OK(), r.Errors)
+
+	r = ValidateFlags([]entity.Flag{
+		inLayer("flag-a", 0, 60, ""),
+		inLayer("flag-b", 50, 100, "company.id"),
+	})
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 2)
+	assert.Contains(t, r.Errors[0], `layer "checkout": flag flag-b: slot range [50, 100) overlaps [0, 60) of flag flag-a`)
+	assert.Contains(t, r.Errors[1], `layer "checkout": flags bucket by different attributes`)
+}
+
+func TestValidateFlags_HoldoutVariant(t *testing.T) {
+	holdout := &entity.Holdout{Key: "global", Percent: 5}
+	flags := []entity.Flag{
+		{
+			Key:              "flag-a",
+			Variants:         []entity.Variant{{Model: gorm.Model{ID: 1}, Key: "on"}},
+			Holdout:          holdout,
+			HoldoutVariantID: 1,
+		},
+		{
+			Key:              "flag-b",
+			Variants:         []entity.Variant{{Model: gorm.Model{ID: 2}, Key: "on"}},
+			Holdout:          holdout,
+			HoldoutVariantID: 3,
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 1)
+	assert.Contains(t, r.Errors[0], `flag "flag-b": holdout "global" references unknown variant ID 3`)
+}
+
+func TestValidateFlags_DefaultVariant(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key:              "flag-a",
+			Variants:         []entity.Variant{{Model: gorm.Model{ID: 1}, Key: "on"}},
+			DefaultVariantID: 1,
+		},
+		{
+			Key:              "flag-b",
+			Variants:         []entity.Variant{{Model: gorm.Model{ID: 2}, Key: "on"}},
+			DefaultVariantID: 3,
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 1)
+	assert.Contains(t, r.Errors[0], `flag "flag-b": default variant references unknown variant ID 3`)
+}
+
+func TestValidateFlags_Targets(t *testing.T) {
+	flags := []entity.Flag{
+		{
+			Key:      "flag-a",
+			Variants: []entity.Variant{{Key: "on"}},
+			Targets: []entity.FlagTarget{
+				{EntityID: "qa_account", VariantKey: "on"},
+				{EntityID: "vip", VariantKey: "off"},
+				{EntityID: "qa_account", VariantKey: "on"},
+				{VariantKey: "on"},
+			},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 3)
+	assert.Contains(t, r.Errors[0], `flag "flag-a": target "vip" references unknown variant key "off"`)
+	assert.Contains(t, r.Errors[1], `flag "flag-a": entity "qa_account" is targeted more than once`)
+	assert.Contains(t, r.Errors[2], `flag "flag-a": empty target entityID`)
+}
+
+func TestValidateEvalCacheJSON_Lists(t *testing.T) {
+	ecj := EvalCacheJSON{
+		Flags: []entity.Flag{
+			{
+				Key:      "flag-a",
+				Variants: []entity.Variant{{Key: "on"}},
+				Segments: []entity.Segment{
+					{
+						Description:    "beta",
+						RolloutPercent: 100,
+						Constraints: entity.ConstraintArray{
+							{Property: "user_id", Operator: "IN_LIST", Value: `"beta_users"`},
+							{Property: "user_id", Operator: "NOT_IN_LIST", Value: `"blocked_users"`},
+						},
+					},
+				},
+			},
+		},
+		Lists: []entity.List{
+			{Key: "beta_users", Values: []string{"u1"}},
+			{Key: "beta_users"},
+			{},
+		},
+	}
+	r := ValidateEvalCacheJSON(ecj)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 3)
+	assert.Contains(t, r.Errors[0], `list[2]: missing or empty Key`)
+	assert.Contains(t, r.Errors[1], `duplicate list key "beta_users"`)
+	assert.Contains(t, r.Errors[2], `flag "flag-a", beta: constraint "user_id" NOT_IN_LIST "\"blocked_users\"" references unknown list "blocked_users"`)
+
+	ecj.Lists = ecj.Lists[:1]
+	ecj.Flags[0].Segments[0].Constraints = ecj.Flags[0].Segments[0].Constraints[:1]
+	assert.True(t, ValidateEvalCacheJSON(ecj).OK())
+}
+
+func TestValidateEvalCacheJSON_EntityTypes(t *testing.T) {
+	ecj := EvalCacheJSON{
+		Flags: []entity.Flag{
+			{
+				Key:        "flag-a",
+				EntityType: "user",
+				Variants:   []entity.Variant{{Key: "on"}},
+				Segments: []entity.Segment{
+					{
+						Description:    "ca",
+						RolloutPercent: 100,
+						Constraints: entity.ConstraintArray{
+							{Property: "state", Operator: "EQ", Value: `"CA"`},
+							{Property: "dl_state", Operator: "EQ", Value: `"CA"`},
+						},
+						Audiences: []entity.Audience{
+							{Key: "west_coast", Constraints: entity.ConstraintArray{
+								{Property: "sate", Operator: "IN", Value: `["CA", "OR"]`},
+							}},
+						},
+					},
+				},
+			},
+		},
+		EntityTypes: []entity.FlagEntityType{
+			{Key: "user", Attributes: entity.EntityAttributes{{Name: "state", Type: "string"}}},
+			{Key: "user"},
+			{Key: "device", Attributes: entity.EntityAttributes{{Name: "os", Type: "text"}}},
+			{},
+		},
+	}
+	r := ValidateEvalCacheJSON(ecj)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 5)
+	assert.Contains(t, r.Errors[0], `entity type "device": attribute "os": invalid type "text"`)
+	assert.Contains(t, r.Errors[1], `entity type[3]: missing or empty Key`)
+	assert.Contains(t, r.Errors[2], `duplicate entity type key "user"`)
+	assert.Contains(t, r.Errors[3], `flag "flag-a", ca: entity type "user": property "dl_state" is not a declared attribute`)
+	assert.Contains(t, r.Errors[4], `flag "flag-a", ca, audience "west_coast": entity type "user": property "sate" is not a declared attribute`)
+
+	ecj.EntityTypes = ecj.EntityTypes[:1]
+	ecj.Flags[0].Segments[0].Constraints = ecj.Flags[0].Segments[0].Constraints[:1]
+	ecj.Flags[0].Segments[0].Audiences[0].Constraints[0].Property = "state"
+	assert.True(t, ValidateEvalCacheJSON(ecj).OK())
+}
+
+func TestValidateFlags_AttachmentSchema(t *testing.T) {
+	schema := entity.AttachmentSchema{
+		"type":     "object",
+		"required": []any{"color"},
+	}
+	flags := []entity.Flag{
+		{
+			Key:              "flag-a",
+			AttachmentSchema: schema,
+			Variants: []entity.Variant{
+				{Model: gorm.Model{ID: 1}, Key: "on", Attachment: entity.Attachment{"color": "red"}},
+				{Model: gorm.Model{ID: 2}, Key: "off", Attachment: entity.Attachment{"size": float64(1)}},
+			},
+		},
+		{
+			Key:              "flag-b",
+			AttachmentSchema: entity.AttachmentSchema{"type": "objekt"},
+			Variants:         []entity.Variant{{Model: gorm.Model{ID: 3}, Key: "on"}},
+		},
+	}
+	r := ValidateFlags(flags)
+	assert.False(t, r.OK())
+	assert.Len(t, r.Errors, 2)
+	assert.Contains(t, r.Errors[0], `flag "flag-a", variant "off": attachment does not conform to the attachment schema`)
+	assert.Contains(t, r.Errors[1], `flag "flag-b": invalid attachment schema`)
+}
@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/foxdalas/flagr/pkg/config"
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/util"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+
+	"github.com/bsm/ratelimit"
+	"github.com/sirupsen/logrus"
+)
+
+// The modes of FLAGR_EVAL_ENTITY_CONTEXT_VALIDATION
+const (
+	entityContextValidationWarn   = "warn"
+	entityContextValidationReject = "reject"
+)
+
+// checkEntityContext validates the entity context of the evaluation against
+// the attributes of the entity types of the flags it evaluates. The violations
+// are logged in warn mode, and returned in reject mode.
+var checkEntityContext = func(evalContext models.EvalContext) error {
+	mode := config.Config.EvalEntityContextValidation
+	if mode != entityContextValidationWarn && mode != entityContextValidationReject {
+		return nil
+	}
+
+	cache := GetEvalCache()
+	var flags []*entity.Flag
+	if len(evalContext.FlagTags) > 0 {
+		flags = cache.GetByTags(evalContext.FlagTags, evalContext.FlagTagsOperator)
+	} else if f := LookupFlag(evalContext); f != nil {
+		flags = []*entity.Flag{f}
+	}
+
+	for _, f := range flags {
+		if f.EntityType == "" {
+			continue
+		}
+		err := cache.GetEntityAttributes(f.EntityType).ValidateEntityContext(evalContext.EntityContext)
+		if err == nil {
+			continue
+		}
+		if mode == entityContextValidationReject {
+			return fmt.Errorf("flag %s, entityID %s: %w", f.Key, evalContext.EntityID, err)
+		}
+		logEntityContextViolation(f, evalContext, err)
+	}
+	return nil
+}
+
+var entityContextViolationRateLimitMap = sync.Map{}
+
+var logEntityContextViolation = func(f *entity.Flag, evalContext models.EvalContext, err error) {
+	rl, _ := entityContextViolationRateLimitMap.LoadOrStore(f.ID, ratelimit.New(
+		config.Config.RateLimiterPerFlagPerSecondConsoleLogging,
+		time.Second,
+	))
+	if rl.(*ratelimit.RateLimiter).Limit() {
+		return
+	}
+	logrus.WithFields(logrus.Fields{
+		"flagID":     f.ID,
+		"flagKey":    f.Key,
+		"entityType": f.EntityType,
+		"entityID":   util.SafeString(evalContext.EntityID),
+	}).Warn(err)
+}
@@ -0,0 +1,95 @@
+package handler
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/foxdalas/flagr/pkg/config"
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func genFixtureEvalCacheWithEntityType() *EvalCache {
+	ec := GenFixtureEvalCache()
+	ec.cache.idCache["100"].EntityType = "user"
+	ec.cache.entityAttributes = map[string]entity.EntityAttributes{
+		"user": {
+			{Name: "dl_state", Type: models.EntityAttributeTypeString, Required: true},
+		},
+	}
+	return ec
+}
+
+func TestCheckEntityContext(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, genFixtureEvalCacheWithEntityType()).Reset()
+
+	var violations []string
+	defer gostub.Stub(&logEntityContextViolation, func(f *entity.Flag, evalContext models.EvalContext, err error) {
+		violations = append(violations, f.Key)
+	}).Reset()
+
+	valid := models.EvalContext{FlagID: 100, EntityContext: map[string]any{"dl_state": "CA"}}
+	invalid := models.EvalContext{FlagID: 100, EntityContext: map[string]any{"state": "CA"}}
+
+	t.Run("disabled", func(t *testing.T) {
+		assert.NoError(t, checkEntityContext(invalid))
+		assert.Empty(t, violations)
+	})
+
+	t.Run("warn", func(t *testing.T) {
+		defer gostub.Stub(&config.Config.EvalEntityContextValidation, "warn").Reset()
+		assert.NoError(t, checkEntityContext(valid))
+		assert.Empty(t, violations)
+		assert.NoError(t, checkEntityContext(invalid))
+		assert.Equal(t, []string{"flag_key_100"}, violations)
+	})
+
+	t.Run("reject", func(t *testing.T) {
+		defer gostub.Stub(&config.Config.EvalEntityContextValidation, "reject").Reset()
+		assert.NoError(t, checkEntityContext(valid))
+		assert.Error(t, checkEntityContext(invalid))
+		assert.Error(t, checkEntityContext(models.EvalContext{FlagTags: []string{"tag1"}, EntityContext: map[string]any{}}))
+		assert.NoError(t, checkEntityContext(models.EvalContext{FlagID: 999, EntityContext: map[string]any{}}))
+
+		e := NewEval()
+		res := e.PostEvaluation(evaluation.PostEvaluationParams{Body: &invalid})
+		assert.NotZero(t, res.(*evaluation.PostEvaluationDefault).Payload)
+
+		res = e.PostEvaluationBatch(evaluation.PostEvaluationBatchParams{
+			Body: &models.EvaluationBatchRequest{
+				Entities: []*models.EvaluationEntity{{EntityID: "entityID1", EntityContext: map[string]any{"state": "CA"}}},
+				FlagIDs:  []int64{100},
+			},
+		})
+		assert.NotZero(t, res.(*evaluation.PostEvaluationBatchDefault).Payload)
+
+		res = e.GetEvaluationBatch(evaluation.GetEvaluationBatchParams{
+			HTTPRequest: httptest.NewRequest(http.MethodGet, `/api/v1/evaluation/batch?state="CA"`, nil),
+			EntityID:    new("entityID1"),
+			FlagID:      []int64{100},
+		})
+		assert.NotZero(t, res.(*evaluation.GetEvaluationBatchDefault).Payload)
+
+		res = e.GetEvaluationBatch(evaluation.GetEvaluationBatchParams{
+			HTTPRequest: httptest.NewRequest(http.MethodGet, `/api/v1/evaluation/batch?dl_state="CA"`, nil),
+			EntityID:    new("entityID1"),
+			FlagID:      []int64{100},
+		})
+		assert.Len(t, res.(*evaluation.GetEvaluationBatchOK).Payload.EvaluationResults, 1)
+
+		h := newOFREPHandler()
+		for _, path := range []string{ofrepPrefix + "/flag_key_100", ofrepPrefix} {
+			body := `{"context":{"targetingKey":"user-1","state":"CA"}}`
+			w := httptest.NewRecorder()
+			h.ServeHTTP(w, httptest.NewRequest(http.MethodPost, path, bytes.NewBufferString(body)))
+			assert.Equal(t, http.StatusBadRequest, w.Code)
+			assert.Contains(t, w.Body.String(), `"errorCode":"INVALID_CONTEXT"`)
+		}
+	})
+}
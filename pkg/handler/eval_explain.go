@@ -0,0 +1,166 @@
+package handler
+
+import (
+	"fmt"
+
+	"github.com/foxdalas/flagr/pkg/config"
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// PostEvaluationExplain explains the evaluation of a flag for an entity as
+// structured JSON. The flag is evaluated like in PostEvaluation, but the
+// result is neither logged nor recorded.
+func (e *eval) PostEvaluationExplain(params evaluation.PostEvaluationExplainParams) middleware.Responder {
+	evalContext := params.Body
+	if evalContext == nil {
+		return evaluation.NewPostEvaluationExplainDefault(400).WithPayload(
+			ErrorMessage("empty body"))
+	}
+	if !config.Config.EvalDebugEnabled {
+		return evaluation.NewPostEvaluationExplainDefault(403).WithPayload(
+			ErrorMessage("explain requires eval debugging, see FLAGR_EVAL_DEBUG_ENABLED"))
+	}
+	if evalContext.EntityID == "" {
+		return evaluation.NewPostEvaluationExplainDefault(400).WithPayload(
+			ErrorMessage("entityID is required to explain the evaluation"))
+	}
+
+	resp := evaluation.NewPostEvaluationExplainOK()
+	resp.SetPayload(explainFlag(LookupFlag(*evalContext), *evalContext))
+	return resp
+}
+
+// explainFlag evaluates the flag like EvalFlagWithContext, and returns why the
+// entity got its variant
+func explainFlag(flag *entity.Flag, evalContext models.EvalContext) *models.EvalExplanation {
+	evalContext.EnableDebug = true
+	d := decideFlag(flag, evalContext, &segmentExplainer{}, nil)
+
+	e := &models.EvalExplanation{
+		FlagID:         evalContext.FlagID,
+		FlagKey:        evalContext.FlagKey,
+		EntityID:       evalContext.EntityID,
+		Reason:         d.reason,
+		Message:        d.msg,
+		BucketKey:      d.bucketKey,
+		SegmentID:      d.segmentID,
+		DefaultVariant: d.defaultVariant,
+		Segments:       d.segments,
+	}
+	if e.Segments == nil {
+		e.Segments = []*models.SegmentExplanation{}
+	}
+	if flag == nil {
+		return e
+	}
+	e.FlagID = int64(flag.ID)
+	e.FlagKey = flag.Key
+	e.VariantID = int64(d.variantID)
+	if v := d.variant(flag); v != nil {
+		e.VariantKey = v.Key
+	}
+	return e
+}
+
+// segmentExplainer explains the segments evaluated by decideFlag, with every
+// constraint of the segment and of its audiences on its own
+type segmentExplainer struct{}
+
+func newSegmentExplanation(segment entity.Segment) *models.SegmentExplanation {
+	return &models.SegmentExplanation{
+		SegmentID:      int64(segment.ID),
+		Rank:           int64(segment.Rank),
+		Description:    segment.Description,
+		RolloutPercent: segment.RolloutPercent,
+		Active:         segment.ActivationWindow == nil || segment.ActivationWindow.IsActive(evalTimeNow()),
+		Constraints:    []*models.ConstraintExplanation{},
+		Audiences:      []*models.AudienceExplanation{},
+	}
+}
+
+// inactiveSegment explains a segment skipped for its activation window
+func (ex *segmentExplainer) inactiveSegment(segment entity.Segment) *models.SegmentExplanation {
+	s := newSegmentExplanation(segment)
+	s.Message = fmt.Sprintf("segment is not active. activation window: %s", segment.ActivationWindow)
+	return s
+}
+
+// evaluatedSegment explains the result of evalSegment: the variant of the
+// entity, its debug log and whether it matched
+func (ex *segmentExplainer) evaluatedSegment(
+	flag *entity.Flag,
+	evalContext models.EvalContext,
+	segment entity.Segment,
+	variantID *uint,
+	log *models.SegmentDebugLog,
+	matched bool,
+) *models.SegmentExplanation {
+	s := newSegmentExplanation(segment)
+	s.Evaluated = true
+	s.Message = "constraints not match"
+	if log != nil && log.Msg != "" {
+		s.Message = log.Msg
+	}
+
+	se := segment.SegmentEvaluation
+	if m, ok := evalContext.EntityContext.(map[string]any); ok {
+		s.Constraints = explainConstraints(segment.Constraints, m)
+		for i, a := range segment.Audiences {
+			ae := &models.AudienceExplanation{
+				AudienceID:  int64(a.ID),
+				Key:         a.Key,
+				Constraints: explainConstraints(a.Constraints, m),
+				Matched:     true,
+			}
+			if i < len(se.Audiences) && len(se.Audiences[i].ConstraintGroups) != 0 {
+				_, ae.Matched = matchConstraintGroups(se.Audiences[i].ConstraintGroups, m, false)
+			}
+			s.Audiences = append(s.Audiences, ae)
+		}
+	}
+	if !matched {
+		return s
+	}
+
+	s.Matched = true
+	s.BucketNum = new(int64(entity.BucketNum(evalContext.EntityID, se.Salt)))
+	if variantID != nil {
+		s.RolledOut = true
+		s.VariantID = int64(*variantID)
+		if v := flag.FlagEvaluation.VariantsMap[*variantID]; v != nil {
+			s.VariantKey = v.Key
+		}
+	}
+	return s
+}
+
+// explainConstraints matches every constraint on its own, with the value its
+// property resolves to in the entity context
+func explainConstraints(cs entity.ConstraintArray, m map[string]any) []*models.ConstraintExplanation {
+	ces := make([]*models.ConstraintExplanation, 0, len(cs))
+	for _, c := range cs {
+		ce := &models.ConstraintExplanation{
+			ConstraintID: int64(c.ID),
+			Property:     c.Property,
+			Operator:     c.Operator,
+			Value:        c.Value,
+			Group:        int64(c.Group),
+		}
+		if v, err := c.ResolveProperty(m); err != nil {
+			ce.ResolveError = err.Error()
+		} else {
+			ce.ResolvedValue = v
+		}
+		match, err := c.Match(m)
+		ce.Matched = match
+		if err != nil {
+			ce.Error = err.Error()
+		}
+		ces = append(ces, ce)
+	}
+	return ces
+}
@@ -0,0 +1,288 @@
+package handler
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/foxdalas/flagr/pkg/config"
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExplainFlag(t *testing.T) {
+	newFlag := func() *entity.Flag {
+		f := entity.GenFixtureFlag()
+		f.Segments[0].Constraints = []entity.Constraint{
+			{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`},
+			{Property: "device.os", Operator: models.ConstraintOperatorSTARTSWITH, Value: `"io"`},
+		}
+		second := entity.GenFixtureSegment()
+		second.ID = 201
+		second.Constraints = nil
+		second.Audiences = []entity.Audience{
+			{
+				Key: "eu_users",
+				Constraints: []entity.Constraint{
+					{Property: "country", Operator: models.ConstraintOperatorIN, Value: `["DE", "FR"]`},
+				},
+			},
+		}
+		f.Segments = append(f.Segments, second)
+		assert.NoError(t, f.PrepareEvaluation())
+		return &f
+	}
+	evalContext := func(entityContext map[string]any) models.EvalContext {
+		return models.EvalContext{
+			EntityContext: entityContext,
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}
+	}
+
+	t.Run("flag not found", func(t *testing.T) {
+		e := explainFlag(nil, evalContext(nil))
+		assert.Equal(t, models.EvalExplanationReasonFLAGNOTFOUND, e.Reason)
+		assert.Empty(t, e.Segments)
+	})
+
+	t.Run("flag disabled with a default variant", func(t *testing.T) {
+		f := newFlag()
+		f.Enabled = false
+		f.DefaultVariantID = 301
+		assert.NoError(t, f.PrepareEvaluation())
+
+		e := explainFlag(f, evalContext(nil))
+		assert.Equal(t, models.EvalExplanationReasonFLAGDISABLED, e.Reason)
+		assert.Equal(t, "treatment", e.VariantKey)
+		assert.True(t, e.DefaultVariant)
+	})
+
+	t.Run("prerequisite not met with a default variant", func(t *testing.T) {
+		f := newFlag()
+		f.DefaultVariantID = 301
+		f.Prerequisites = []entity.FlagPrerequisite{
+			{FlagKey: "missing_flag", VariantKeys: []string{"on"}},
+		}
+		assert.NoError(t, f.PrepareEvaluation())
+		defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCacheWithFlags([]entity.Flag{*f})).Reset()
+
+		e := explainFlag(f, evalContext(nil))
+		assert.Equal(t, models.EvalExplanationReasonPREREQUISITENOTMET, e.Reason)
+		assert.Equal(t, "treatment", e.VariantKey)
+		assert.True(t, e.DefaultVariant)
+	})
+
+	t.Run("segment match", func(t *testing.T) {
+		e := explainFlag(newFlag(), evalContext(map[string]any{
+			"dl_state": "CA",
+			"device":   map[string]any{"os": "ios"},
+		}))
+		assert.Equal(t, models.EvalExplanationReasonSEGMENTMATCH, e.Reason)
+		assert.Equal(t, int64(200), e.SegmentID)
+		assert.NotEmpty(t, e.VariantKey)
+		assert.Len(t, e.Segments, 2)
+
+		s := e.Segments[0]
+		assert.True(t, s.Evaluated)
+		assert.True(t, s.Matched)
+		assert.True(t, s.RolledOut)
+		assert.Equal(t, e.VariantKey, s.VariantKey)
+		assert.Equal(t, int64(entity.BucketNum("entityID1", "100")), *s.BucketNum)
+		assert.Len(t, s.Constraints, 2)
+		assert.Equal(t, "CA", s.Constraints[0].ResolvedValue)
+		assert.True(t, s.Constraints[0].Matched)
+		assert.Equal(t, "ios", s.Constraints[1].ResolvedValue)
+		assert.True(t, s.Constraints[1].Matched)
+
+		assert.False(t, e.Segments[1].Evaluated)
+	})
+
+	t.Run("no segment match", func(t *testing.T) {
+		e := explainFlag(newFlag(), evalContext(map[string]any{"dl_state": "NY", "country": "US"}))
+		assert.Equal(t, models.EvalExplanationReasonNOSEGMENTMATCH, e.Reason)
+		assert.Zero(t, e.SegmentID)
+		assert.Zero(t, e.VariantID)
+
+		s := e.Segments[0]
+		assert.True(t, s.Evaluated)
+		assert.False(t, s.Matched)
+		assert.Nil(t, s.BucketNum)
+		assert.Equal(t, "NY", s.Constraints[0].ResolvedValue)
+		assert.False(t, s.Constraints[0].Matched)
+		assert.Nil(t, s.Constraints[1].ResolvedValue)
+		assert.Equal(t, "argument: device not found", s.Constraints[1].ResolveError)
+		assert.NotEmpty(t, s.Constraints[1].Error)
+
+		s = e.Segments[1]
+		assert.True(t, s.Evaluated)
+		assert.False(t, s.Matched)
+		assert.Len(t, s.Audiences, 1)
+		assert.Equal(t, "eu_users", s.Audiences[0].Key)
+		assert.False(t, s.Audiences[0].Matched)
+		assert.Equal(t, "US", s.Audiences[0].Constraints[0].ResolvedValue)
+	})
+
+	t.Run("audience match", func(t *testing.T) {
+		e := explainFlag(newFlag(), evalContext(map[string]any{"dl_state": "NY", "country": "DE"}))
+		assert.Equal(t, models.EvalExplanationReasonSEGMENTMATCH, e.Reason)
+		assert.Equal(t, int64(201), e.SegmentID)
+		assert.True(t, e.Segments[1].Audiences[0].Matched)
+	})
+
+	t.Run("not rolled out", func(t *testing.T) {
+		f := newFlag()
+		f.Segments[0].RolloutPercent = 0
+		assert.NoError(t, f.PrepareEvaluation())
+
+		e := explainFlag(f, evalContext(map[string]any{
+			"dl_state": "CA",
+			"device":   map[string]any{"os": "ios"},
+		}))
+		assert.Equal(t, models.EvalExplanationReasonNOTROLLEDOUT, e.Reason)
+		assert.Equal(t, int64(200), e.SegmentID)
+		assert.Zero(t, e.VariantID)
+		assert.True(t, e.Segments[0].Matched)
+		assert.False(t, e.Segments[0].RolledOut)
+		assert.NotNil(t, e.Segments[0].BucketNum)
+		assert.Contains(t, e.Segments[0].Message, "rollout no")
+	})
+
+	t.Run("individual target", func(t *testing.T) {
+		f := newFlag()
+		f.Targets = []entity.FlagTarget{{EntityID: "entityID1", VariantID: 300, VariantKey: "control"}}
+		assert.NoError(t, f.PrepareEvaluation())
+
+		e := explainFlag(f, evalContext(nil))
+		assert.Equal(t, models.EvalExplanationReasonINDIVIDUALTARGET, e.Reason)
+		assert.Equal(t, "control", e.VariantKey)
+		assert.Empty(t, e.Segments)
+	})
+
+	t.Run("agrees with the evaluation", func(t *testing.T) {
+		defer gostub.StubFunc(&logEvalResult).Reset()
+		f := newFlag()
+		f.Segments[0].RolloutPercent = 50
+		assert.NoError(t, f.PrepareEvaluation())
+
+		for i := range 200 {
+			ctx := evalContext(map[string]any{"dl_state": "CA", "device": map[string]any{"os": "ios"}, "country": "DE"})
+			ctx.EntityID = fmt.Sprintf("entity%d", i)
+			if i%2 == 0 {
+				ctx.EntityContext = map[string]any{"dl_state": "NY", "country": "DE"}
+			}
+
+			r := EvalFlagWithContext(f, ctx)
+			e := explainFlag(f, ctx)
+			assert.Equal(t, r.VariantID, e.VariantID, "entity %s", ctx.EntityID)
+			assert.Equal(t, r.VariantKey, e.VariantKey, "entity %s", ctx.EntityID)
+		}
+	})
+}
+
+func TestExplainAndSimulateAgreeWithTheEvaluation(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	layer := &entity.Layer{Key: "checkout", Slots: 100}
+	layer.ID = 1
+	holdout := &entity.Holdout{Key: "global", Percent: 20}
+	holdout.ID = 1
+	prerequisite := entity.GenFixtureFlag()
+	prerequisite.ID = 1
+	prerequisite.Key = "new_payments_backend"
+	prerequisite.EntityType = "user"
+	prerequisite.Segments[0].Constraints = nil
+	assert.NoError(t, prerequisite.PrepareEvaluation())
+
+	f := entity.GenFixtureFlag()
+	f.EntityType = "user"
+	f.DefaultVariantID = 300
+	f.LayerID, f.Layer = &layer.ID, layer
+	f.LayerSlotStart, f.LayerSlotEnd = 0, 50
+	f.HoldoutID, f.Holdout = &holdout.ID, holdout
+	f.HoldoutVariantID = 300
+	f.Prerequisites = []entity.FlagPrerequisite{
+		{FlagKey: "new_payments_backend", VariantKeys: []string{"treatment"}},
+	}
+	assert.NoError(t, f.PrepareEvaluation())
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCacheWithFlags([]entity.Flag{f, prerequisite})).Reset()
+
+	entities := make([]*models.EvaluationEntity, 300)
+	reasons := map[string]bool{}
+	for i := range entities {
+		entities[i] = &models.EvaluationEntity{
+			EntityID:      fmt.Sprintf("entity%d", i),
+			EntityContext: map[string]any{"dl_state": []string{"CA", "NY"}[i%2]},
+		}
+		ctx := models.EvalContext{
+			EntityID:      entities[i].EntityID,
+			EntityContext: entities[i].EntityContext,
+			FlagID:        int64(100),
+		}
+		r := EvalFlagWithContext(&f, ctx)
+		e := explainFlag(&f, ctx)
+		assert.Equal(t, r.VariantKey, e.VariantKey, "entity %s", ctx.EntityID)
+		assert.Equal(t, r.DefaultVariant, e.DefaultVariant, "entity %s", ctx.EntityID)
+		reasons[e.Reason] = true
+	}
+	assert.True(t, reasons[models.EvalExplanationReasonLAYERSLOTNOTOWNED])
+	assert.True(t, reasons[models.EvalExplanationReasonPREREQUISITENOTMET])
+	assert.True(t, reasons[models.EvalExplanationReasonSEGMENTMATCH])
+
+	counts := map[string]int64{}
+	for _, en := range entities {
+		r := EvalFlagWithContext(&f, models.EvalContext{
+			EntityID:      en.EntityID,
+			EntityContext: en.EntityContext,
+			FlagID:        int64(100),
+		})
+		counts[r.VariantKey]++
+	}
+	sim := simulateFlag(&f, &f, entities)
+	for _, vc := range sim.Variants {
+		assert.Equal(t, counts[vc.VariantKey], vc.Count, "variant %s", vc.VariantKey)
+	}
+	assert.Equal(t, counts[""], sim.Unassigned)
+}
+
+func TestPostEvaluationExplain(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+	e := NewEval()
+
+	t.Run("empty body", func(t *testing.T) {
+		resp := e.PostEvaluationExplain(evaluation.PostEvaluationExplainParams{})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationExplainDefault).Payload)
+	})
+
+	t.Run("empty entityID", func(t *testing.T) {
+		resp := e.PostEvaluationExplain(evaluation.PostEvaluationExplainParams{
+			Body: &models.EvalContext{FlagID: int64(100)},
+		})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationExplainDefault).Payload)
+	})
+
+	t.Run("eval debugging disabled", func(t *testing.T) {
+		defer gostub.Stub(&config.Config.EvalDebugEnabled, false).Reset()
+		resp := e.PostEvaluationExplain(evaluation.PostEvaluationExplainParams{
+			Body: &models.EvalContext{EntityID: "entityID1", FlagID: int64(100)},
+		})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationExplainDefault).Payload)
+	})
+
+	t.Run("happy code path", func(t *testing.T) {
+		resp := e.PostEvaluationExplain(evaluation.PostEvaluationExplainParams{
+			Body: &models.EvalContext{
+				EntityID:      "entityID1",
+				EntityContext: map[string]any{"dl_state": "CA"},
+				FlagKey:       "flag_key_100",
+			},
+		})
+		payload := resp.(*evaluation.PostEvaluationExplainOK).Payload
+		assert.Equal(t, int64(100), payload.FlagID)
+		assert.Equal(t, models.EvalExplanationReasonSEGMENTMATCH, payload.Reason)
+		assert.Equal(t, payload.VariantKey, payload.Segments[0].VariantKey)
+	})
+}
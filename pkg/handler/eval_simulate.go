@@ -0,0 +1,216 @@
+package handler
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/go-openapi/runtime/middleware"
+)
+
+// defaultSimulationEntityIDPrefix is the prefix of the generated entityIDs if
+// the request doesn't set one
+const defaultSimulationEntityIDPrefix = "entity"
+
+// PostEvaluationSimulate simulates the live flag, or a proposed draft of it,
+// on a sample of entities and compares the variants with the live flag. The
+// evaluations are neither logged nor recorded.
+func (e *eval) PostEvaluationSimulate(params evaluation.PostEvaluationSimulateParams) middleware.Responder {
+	req := params.Body
+	if req == nil {
+		return evaluation.NewPostEvaluationSimulateDefault(400).WithPayload(
+			ErrorMessage("empty body"))
+	}
+
+	live := LookupFlag(models.EvalContext{FlagID: req.FlagID, FlagKey: req.FlagKey})
+	flag := live
+	if req.Draft != nil {
+		draft, err := parseSimulationDraft(req.Draft, live)
+		if err != nil {
+			return evaluation.NewPostEvaluationSimulateDefault(400).WithPayload(
+				ErrorMessage("%s", err))
+		}
+		flag = draft
+	}
+	if flag == nil {
+		return evaluation.NewPostEvaluationSimulateDefault(404).WithPayload(
+			ErrorMessage("flag not found and no draft to simulate"))
+	}
+
+	entities := simulationEntities(req)
+	if len(entities) == 0 {
+		return evaluation.NewPostEvaluationSimulateDefault(400).WithPayload(
+			ErrorMessage("entities or generate is required to simulate the flag"))
+	}
+	for i, en := range entities {
+		if en == nil || en.EntityID == "" {
+			return evaluation.NewPostEvaluationSimulateDefault(400).WithPayload(
+				ErrorMessage("entities[%d]: entityID is required to simulate the flag", i))
+		}
+	}
+
+	result := simulateFlag(live, flag, entities)
+	result.Draft = req.Draft != nil
+	resp := evaluation.NewPostEvaluationSimulateOK()
+	resp.SetPayload(result)
+	return resp
+}
+
+// parseSimulationDraft parses a draft of the flag in the JSON flag spec, and
+// prepares it for the evaluation like a flag of a JSON eval cache. The draft
+// takes the ID of the live flag, so that the entities are bucketed alike.
+func parseSimulationDraft(draft any, live *entity.Flag) (*entity.Flag, error) {
+	b, err := json.Marshal(draft)
+	if err != nil {
+		return nil, fmt.Errorf("invalid draft: %s", err)
+	}
+	flags := []entity.Flag{{}}
+	if err := json.Unmarshal(b, &flags[0]); err != nil {
+		return nil, fmt.Errorf("invalid draft: %s", err)
+	}
+	if live != nil {
+		flags[0].ID = live.ID
+		if flags[0].Key == "" {
+			flags[0].Key = live.Key
+		}
+	}
+
+	if result := ValidateFlags(flags); !result.OK() {
+		return nil, fmt.Errorf("invalid draft: %s", strings.Join(result.Errors, "; "))
+	}
+	normalizeIDs(flags)
+	if err := flags[0].PrepareEvaluation(); err != nil {
+		return nil, fmt.Errorf("invalid draft: %s", err)
+	}
+	return &flags[0], nil
+}
+
+// simulationEntities returns the entities of the request, followed by the
+// generated ones
+func simulationEntities(req *models.SimulationRequest) []*models.EvaluationEntity {
+	entities := slices.Clone(req.Entities)
+	g := req.Generate
+	if g == nil || g.Count == nil {
+		return entities
+	}
+
+	prefix := g.EntityIDPrefix
+	if prefix == "" {
+		prefix = defaultSimulationEntityIDPrefix
+	}
+	for i := range *g.Count {
+		entities = append(entities, &models.EvaluationEntity{
+			EntityID:      fmt.Sprintf("%s%d", prefix, i),
+			EntityType:    g.EntityType,
+			EntityContext: g.EntityContext,
+		})
+	}
+	return entities
+}
+
+// simulateFlag evaluates the flag and the live flag for every entity, and
+// counts the matched segments of the flag and the variants of both. live is
+// nil if the flag is not in the eval cache yet.
+func simulateFlag(live, flag *entity.Flag, entities []*models.EvaluationEntity) *models.SimulationResult {
+	r := &models.SimulationResult{
+		FlagID:      int64(flag.ID),
+		FlagKey:     flag.Key,
+		Total:       int64(len(entities)),
+		Segments:    make([]*models.SimulationSegmentCount, 0, len(flag.Segments)),
+		Variants:    []*models.SimulationVariantCount{},
+		Transitions: []*models.SimulationTransition{},
+	}
+
+	segments := make(map[int64]*models.SimulationSegmentCount, len(flag.Segments))
+	for _, s := range flag.Segments {
+		sc := &models.SimulationSegmentCount{SegmentID: int64(s.ID), Description: s.Description}
+		r.Segments = append(r.Segments, sc)
+		segments[sc.SegmentID] = sc
+	}
+
+	// variants are keyed by variant key, which is stable between the live
+	// flag and a draft, unlike the IDs of the variants of a draft
+	variants := make(map[string]*models.SimulationVariantCount)
+	variant := func(id int64, key string) *models.SimulationVariantCount {
+		if vc := variants[key]; vc != nil {
+			return vc
+		}
+		vc := &models.SimulationVariantCount{VariantID: id, VariantKey: key}
+		r.Variants = append(r.Variants, vc)
+		variants[key] = vc
+		return vc
+	}
+	for _, v := range flag.Variants {
+		variant(int64(v.ID), v.Key)
+	}
+	if live != nil {
+		for _, v := range live.Variants {
+			variant(int64(v.ID), v.Key)
+		}
+	}
+
+	transitions := make(map[[2]string]int64)
+	for _, en := range entities {
+		evalContext := models.EvalContext{
+			EntityID:      en.EntityID,
+			EntityType:    en.EntityType,
+			EntityContext: en.EntityContext,
+			FlagID:        int64(flag.ID),
+			FlagKey:       flag.Key,
+		}
+		d := decideFlag(flag, evalContext, nil, nil)
+		l := decideFlag(live, evalContext, nil, nil)
+
+		if sc := segments[d.segmentID]; sc != nil {
+			sc.Matched++
+			if d.reason == models.EvalExplanationReasonSEGMENTMATCH {
+				sc.RolledOut++
+			}
+		}
+		v, lv := d.variant(flag), l.variant(live)
+		if v == nil {
+			r.Unassigned++
+		} else {
+			variant(int64(v.ID), v.Key).Count++
+		}
+		if lv == nil {
+			r.LiveUnassigned++
+		} else {
+			variant(int64(lv.ID), lv.Key).LiveCount++
+		}
+		if key, liveKey := variantKey(v), variantKey(lv); key != liveKey {
+			r.Changed++
+			transitions[[2]string{liveKey, key}]++
+		}
+	}
+
+	for k, n := range transitions {
+		r.Transitions = append(r.Transitions, &models.SimulationTransition{
+			FromVariantKey: k[0],
+			ToVariantKey:   k[1],
+			Count:          n,
+		})
+	}
+	slices.SortFunc(r.Transitions, func(a, b *models.SimulationTransition) int {
+		return cmp.Or(
+			cmp.Compare(b.Count, a.Count),
+			cmp.Compare(a.FromVariantKey, b.FromVariantKey),
+			cmp.Compare(a.ToVariantKey, b.ToVariantKey),
+		)
+	})
+	return r
+}
+
+// variantKey returns the key of the variant, or "" if there's none
+func variantKey(v *entity.Variant) string {
+	if v == nil {
+		return ""
+	}
+	return v.Key
+}
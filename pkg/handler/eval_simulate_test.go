@@ -0,0 +1,214 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/evaluation"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func genSimulationEntities(n int) []*models.EvaluationEntity {
+	entities := make([]*models.EvaluationEntity, 0, n)
+	for i := range n {
+		state := "CA"
+		if i%4 == 0 {
+			state = "NY"
+		}
+		entities = append(entities, &models.EvaluationEntity{
+			EntityID:      fmt.Sprintf("entity%d", i),
+			EntityContext: map[string]any{"dl_state": state},
+		})
+	}
+	return entities
+}
+
+func TestSimulateFlag(t *testing.T) {
+	entities := genSimulationEntities(400)
+
+	t.Run("live flag", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		r := simulateFlag(&f, &f, entities)
+		assert.Equal(t, int64(400), r.Total)
+		assert.Zero(t, r.Changed)
+		assert.Empty(t, r.Transitions)
+		assert.Equal(t, int64(100), r.Unassigned)
+		assert.Equal(t, r.Unassigned, r.LiveUnassigned)
+
+		assert.Len(t, r.Segments, 1)
+		assert.Equal(t, int64(300), r.Segments[0].Matched)
+		assert.Equal(t, int64(300), r.Segments[0].RolledOut)
+
+		assert.Len(t, r.Variants, 2)
+		assert.Equal(t, "control", r.Variants[0].VariantKey)
+		assert.Equal(t, int64(300), r.Variants[0].Count+r.Variants[1].Count)
+		for _, v := range r.Variants {
+			assert.NotZero(t, v.Count)
+			assert.Equal(t, v.Count, v.LiveCount)
+		}
+	})
+
+	t.Run("shifted distribution", func(t *testing.T) {
+		live := entity.GenFixtureFlag()
+		draft := entity.GenFixtureFlag()
+		draft.Segments[0].Distributions[0].Percent = 0
+		draft.Segments[0].Distributions[1].Percent = 100
+		assert.NoError(t, draft.PrepareEvaluation())
+
+		r := simulateFlag(&live, &draft, entities)
+		control, treatment := r.Variants[0], r.Variants[1]
+		assert.Zero(t, control.Count)
+		assert.Equal(t, int64(300), treatment.Count)
+		assert.Equal(t, control.LiveCount, r.Changed)
+		assert.Equal(t, []*models.SimulationTransition{
+			{FromVariantKey: "control", ToVariantKey: "treatment", Count: r.Changed},
+		}, r.Transitions)
+	})
+
+	t.Run("reduced rollout", func(t *testing.T) {
+		live := entity.GenFixtureFlag()
+		draft := entity.GenFixtureFlag()
+		draft.Segments[0].RolloutPercent = 0
+		assert.NoError(t, draft.PrepareEvaluation())
+
+		r := simulateFlag(&live, &draft, entities)
+		assert.Equal(t, int64(300), r.Segments[0].Matched)
+		assert.Zero(t, r.Segments[0].RolledOut)
+		assert.Equal(t, int64(400), r.Unassigned)
+		assert.Equal(t, int64(300), r.Changed)
+		for _, tr := range r.Transitions {
+			assert.Empty(t, tr.ToVariantKey)
+		}
+	})
+
+	t.Run("new flag", func(t *testing.T) {
+		draft := entity.GenFixtureFlag()
+		r := simulateFlag(nil, &draft, entities)
+		assert.Equal(t, int64(400), r.LiveUnassigned)
+		assert.Equal(t, int64(300), r.Changed)
+	})
+}
+
+func TestPostEvaluationSimulate(t *testing.T) {
+	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
+	e := NewEval()
+
+	draft := func(modify func(f *entity.Flag)) any {
+		f := entity.GenFixtureFlag()
+		modify(&f)
+		b, _ := json.Marshal(f)
+		var d any
+		_ = json.Unmarshal(b, &d)
+		return d
+	}
+
+	t.Run("empty body", func(t *testing.T) {
+		resp := e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationSimulateDefault).Payload)
+	})
+
+	t.Run("flag not found", func(t *testing.T) {
+		resp := e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{
+			Body: &models.SimulationRequest{FlagID: 999, Entities: genSimulationEntities(1)},
+		})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationSimulateDefault).Payload)
+	})
+
+	t.Run("no entities", func(t *testing.T) {
+		resp := e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{
+			Body: &models.SimulationRequest{FlagID: 100},
+		})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationSimulateDefault).Payload)
+	})
+
+	t.Run("entity without entityID", func(t *testing.T) {
+		resp := e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{
+			Body: &models.SimulationRequest{FlagID: 100, Entities: []*models.EvaluationEntity{{}}},
+		})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationSimulateDefault).Payload)
+	})
+
+	t.Run("invalid draft", func(t *testing.T) {
+		resp := e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{
+			Body: &models.SimulationRequest{
+				FlagID:   100,
+				Draft:    map[string]any{"Segments": "invalid"},
+				Entities: genSimulationEntities(1),
+			},
+		})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationSimulateDefault).Payload)
+
+		resp = e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{
+			Body: &models.SimulationRequest{
+				FlagID: 100,
+				Draft: draft(func(f *entity.Flag) {
+					f.Segments[0].Distributions[0].Percent = 10
+				}),
+				Entities: genSimulationEntities(1),
+			},
+		})
+		assert.NotNil(t, resp.(*evaluation.PostEvaluationSimulateDefault).Payload)
+	})
+
+	t.Run("generated entities", func(t *testing.T) {
+		resp := e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{
+			Body: &models.SimulationRequest{
+				FlagKey:  "flag_key_100",
+				Entities: genSimulationEntities(10),
+				Generate: &models.SimulationGenerate{
+					Count:         new(int64(90)),
+					EntityContext: map[string]any{"dl_state": "CA"},
+				},
+			},
+		})
+		payload := resp.(*evaluation.PostEvaluationSimulateOK).Payload
+		assert.Equal(t, int64(100), payload.FlagID)
+		assert.False(t, payload.Draft)
+		assert.Equal(t, int64(100), payload.Total)
+		assert.Equal(t, int64(97), payload.Segments[0].Matched)
+		assert.Zero(t, payload.Changed)
+	})
+
+	t.Run("draft buckets like the live flag", func(t *testing.T) {
+		resp := e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{
+			Body: &models.SimulationRequest{
+				FlagID: 100,
+				Draft: draft(func(f *entity.Flag) {
+					// a draft without IDs gets the ID of the live flag
+					f.ID = 0
+					for i := range f.Segments[0].Distributions {
+						f.Segments[0].Distributions[i].VariantID = 0
+					}
+				}),
+				Entities: genSimulationEntities(100),
+			},
+		})
+		payload := resp.(*evaluation.PostEvaluationSimulateOK).Payload
+		assert.True(t, payload.Draft)
+		assert.Equal(t, int64(100), payload.FlagID)
+		assert.Zero(t, payload.Changed)
+	})
+
+	t.Run("draft with a shifted distribution", func(t *testing.T) {
+		resp := e.PostEvaluationSimulate(evaluation.PostEvaluationSimulateParams{
+			Body: &models.SimulationRequest{
+				FlagID: 100,
+				Draft: draft(func(f *entity.Flag) {
+					f.Segments[0].Distributions[0].Percent = 30
+					f.Segments[0].Distributions[1].Percent = 70
+				}),
+				Entities: genSimulationEntities(100),
+			},
+		})
+		payload := resp.(*evaluation.PostEvaluationSimulateOK).Payload
+		assert.NotZero(t, payload.Changed)
+		assert.Len(t, payload.Transitions, 1)
+		assert.Equal(t, "control", payload.Transitions[0].FromVariantKey)
+		assert.Equal(t, "treatment", payload.Transitions[0].ToVariantKey)
+	})
+}
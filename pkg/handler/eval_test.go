@@ -3,7 +3,11 @@ package handler
 import (
 	"fmt"
 	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/dchest/uniuri"
 	"github.com/foxdalas/flagr/pkg/config"
@@ -29,7 +33,7 @@ func TestEvalSegment(t *testing.T) {
 
 	t.Run("test happy code path", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
+		s.RolloutPercent = 100
 		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
 			EnableDebug:   true,
 			EntityContext: map[string]any{"dl_state": "CA"},
@@ -45,7 +49,7 @@ func TestEvalSegment(t *testing.T) {
 
 	t.Run("test constraint evaluation error", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
+		s.RolloutPercent = 100
 		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
 			EnableDebug:   true,
 			EntityContext: map[string]any{},
@@ -61,7 +65,7 @@ func TestEvalSegment(t *testing.T) {
 
 	t.Run("test constraint not match", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
+		s.RolloutPercent = 100
 		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
 			EnableDebug:   true,
 			EntityContext: map[string]any{"dl_state": "NY"},
@@ -77,7 +81,7 @@ func TestEvalSegment(t *testing.T) {
 
 	t.Run("test evalContext wrong format", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
+		s.RolloutPercent = 100
 		vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
 			EnableDebug:   true,
 			EntityContext: nil,
@@ -93,7 +97,7 @@ func TestEvalSegment(t *testing.T) {
 
 	t.Run("test float comparison - 9990403>=9990404 evals to be false", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
+		s.RolloutPercent = 100
 		s.Constraints = []entity.Constraint{
 			{
 				Model:     gorm.Model{ID: 500},
@@ -120,7 +124,7 @@ func TestEvalSegment(t *testing.T) {
 
 	t.Run("test float comparison - 9990404>=9990403 evals to be true", func(t *testing.T) {
 		s := entity.GenFixtureSegment()
-		s.RolloutPercent = uint(100)
+		s.RolloutPercent = 100
 		s.Constraints = []entity.Constraint{
 			{
 				Model:     gorm.Model{ID: 500},
@@ -284,6 +288,307 @@ func TestEvalSegment_NestedEntityContext(t *testing.T) {
 	})
 }
 
+func TestEvalSegment_NestedEntityContextDebugLog(t *testing.T) {
+	s := entity.GenFixtureSegment()
+	s.Constraints = []entity.Constraint{
+		{Property: "device.os", Operator: models.ConstraintOperatorEQ, Value: `"ios"`},
+		{Property: "items[0].sku", Operator: models.ConstraintOperatorSTARTSWITH, Value: `"A-"`},
+	}
+	assert.NoError(t, s.PrepareEvaluation())
+
+	vID, log, evalNextSegment := evalSegment(100, models.EvalContext{
+		EnableDebug: true,
+		EntityContext: map[string]any{
+			"device": map[string]any{"os": "android"},
+			"items":  []any{map[string]any{"sku": "B-1"}},
+		},
+		EntityID: "entityID1",
+		FlagID:   int64(100),
+	}, s)
+
+	assert.Nil(t, vID)
+	assert.True(t, evalNextSegment)
+	assert.Contains(t, log.Msg, `resolved: device.os="android"`)
+}
+
+func TestSetQueryContextValue(t *testing.T) {
+	ctx := map[string]any{}
+	setQueryContextValue(ctx, "device", map[string]any{"model": "pixel"})
+	setQueryContextValue(ctx, "device.os", "android")
+	setQueryContextValue(ctx, "app.build.number", float64(42))
+	setQueryContextValue(ctx, "country", "US")
+	setQueryContextValue(ctx, "country.code", "US")
+	setQueryContextValue(ctx, ".hidden", true)
+
+	assert.Equal(t, map[string]any{
+		"device":       map[string]any{"model": "pixel", "os": "android"},
+		"app":          map[string]any{"build": map[string]any{"number": float64(42)}},
+		"country":      "US",
+		"country.code": "US",
+		".hidden":      true,
+	}, ctx)
+}
+
+func TestGetEvaluationBatch_NestedQueryContext(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	f.Segments[0].Constraints = []entity.Constraint{
+		{Property: "device.os", Operator: models.ConstraintOperatorEQ, Value: `"ios"`},
+	}
+	assert.NoError(t, f.PrepareEvaluation())
+	defer gostub.StubFunc(&GetEvalCache, &EvalCache{
+		cache: &cacheContainer{
+			idCache:  map[string]*entity.Flag{util.SafeString(f.ID): &f},
+			keyCache: map[string]*entity.Flag{f.Key: &f},
+		},
+	}).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	e := NewEval()
+	evaluate := func(query string) *models.EvalResult {
+		resp := e.GetEvaluationBatch(evaluation.GetEvaluationBatchParams{
+			HTTPRequest: httptest.NewRequest(http.MethodGet, "/api/v1/evaluation/batch?"+query, nil),
+			EntityID:    new("entityID1"),
+			FlagKey:     []string{f.Key},
+		})
+		ok, isOK := resp.(*evaluation.GetEvaluationBatchOK)
+		assert.True(t, isOK)
+		assert.Len(t, ok.Payload.EvaluationResults, 1)
+		return ok.Payload.EvaluationResults[0]
+	}
+
+	assert.NotZero(t, evaluate(`device.os="ios"`).VariantID)
+	assert.Zero(t, evaluate(`device.os="android"`).VariantID)
+	assert.Zero(t, evaluate(`device={"model":"iphone"}`).VariantID)
+	assert.NotZero(t, evaluate(`device={"model":"iphone"}&device.os="ios"`).VariantID)
+}
+
+func TestEvalSegment_SemverConstraints(t *testing.T) {
+	newSegment := func(constraints ...entity.Constraint) entity.Segment {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = 100
+		s.Constraints = constraints
+		assert.NoError(t, s.PrepareEvaluation())
+		return s
+	}
+	evalContext := func(entityContext map[string]any) models.EvalContext {
+		return models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: entityContext,
+			EntityID:      "entityID1",
+			EntityType:    "entityType1",
+			FlagID:        int64(100),
+		}
+	}
+
+	t.Run("1.10.0 is greater than 1.9.0", func(t *testing.T) {
+		s := newSegment(entity.Constraint{
+			Property: "app_version",
+			Operator: models.ConstraintOperatorSEMVERGT,
+			Value:    `"1.9.0"`,
+		})
+
+		vID, log, evalNextSegment := evalSegment(100, evalContext(map[string]any{"app_version": "1.10.0"}), s)
+		assert.NotNil(t, vID)
+		assert.NotEmpty(t, log)
+		assert.False(t, evalNextSegment)
+	})
+
+	t.Run("pre-release is lower than the release", func(t *testing.T) {
+		s := newSegment(entity.Constraint{
+			Property: "app_version",
+			Operator: models.ConstraintOperatorSEMVERGTE,
+			Value:    `"2.0.0"`,
+		})
+
+		vID, log, evalNextSegment := evalSegment(100, evalContext(map[string]any{"app_version": "2.0.0-rc.1"}), s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "SEMVER_GTE")
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("combined with conditions constraints", func(t *testing.T) {
+		s := newSegment(
+			entity.Constraint{Property: "dl_state", Operator: models.ConstraintOperatorEQ, Value: `"CA"`},
+			entity.Constraint{Property: "app_version", Operator: models.ConstraintOperatorSEMVERLT, Value: `"3.0.0"`},
+		)
+
+		vID, _, evalNextSegment := evalSegment(100, evalContext(map[string]any{"dl_state": "CA", "app_version": "2.9.9"}), s)
+		assert.NotNil(t, vID)
+		assert.False(t, evalNextSegment)
+
+		vID, _, evalNextSegment = evalSegment(100, evalContext(map[string]any{"dl_state": "NY", "app_version": "2.9.9"}), s)
+		assert.Nil(t, vID)
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("unparsable version in entity context", func(t *testing.T) {
+		s := newSegment(entity.Constraint{
+			Property: "app_version",
+			Operator: models.ConstraintOperatorSEMVEREQ,
+			Value:    `"1.0.0"`,
+		})
+
+		vID, log, evalNextSegment := evalSegment(100, evalContext(map[string]any{"app_version": "latest"}), s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "invalid semver")
+		assert.True(t, evalNextSegment)
+	})
+}
+
+func TestEvalSegment_TimeConstraints(t *testing.T) {
+	s := entity.GenFixtureSegment()
+	s.RolloutPercent = 100
+	s.Constraints = []entity.Constraint{
+		{Property: "created_at", Operator: models.ConstraintOperatorAFTER, Value: `"2026-01-01T00:00:00Z"`},
+		{Property: "trial_ends_at", Operator: models.ConstraintOperatorBEFORE, Value: `"now+7d"`},
+	}
+	assert.NoError(t, s.PrepareEvaluation())
+
+	evalContext := func(entityContext map[string]any) models.EvalContext {
+		return models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: entityContext,
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}
+	}
+
+	t.Run("matches", func(t *testing.T) {
+		vID, _, evalNextSegment := evalSegment(100, evalContext(map[string]any{
+			"created_at":    "2026-02-01T10:00:00Z",
+			"trial_ends_at": float64(time.Now().Add(24 * time.Hour).Unix()),
+		}), s)
+		assert.NotNil(t, vID)
+		assert.False(t, evalNextSegment)
+	})
+
+	t.Run("not matches", func(t *testing.T) {
+		vID, log, evalNextSegment := evalSegment(100, evalContext(map[string]any{
+			"created_at":    "2026-02-01T10:00:00Z",
+			"trial_ends_at": time.Now().Add(30 * 24 * time.Hour).Format(time.RFC3339),
+		}), s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "BEFORE")
+		assert.True(t, evalNextSegment)
+	})
+}
+
+func TestEvalSegment_ConstraintGroups(t *testing.T) {
+	s := entity.GenFixtureSegment()
+	s.RolloutPercent = 100
+	s.Constraints = []entity.Constraint{
+		{Property: "country", Operator: models.ConstraintOperatorIN, Value: `["US", "CA"]`, Group: 0},
+		{Property: "beta_tester", Operator: models.ConstraintOperatorEQ, Value: `true`, Group: 1},
+	}
+	assert.NoError(t, s.PrepareEvaluation())
+
+	evalContext := func(entityContext map[string]any) models.EvalContext {
+		return models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: entityContext,
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}
+	}
+
+	t.Run("first group matches", func(t *testing.T) {
+		vID, log, evalNextSegment := evalSegment(100, evalContext(map[string]any{"country": "US"}), s)
+		assert.NotNil(t, vID)
+		assert.Contains(t, log.Msg, "matched all constraints of group 0.")
+		assert.False(t, evalNextSegment)
+	})
+
+	t.Run("second group matches", func(t *testing.T) {
+		vID, log, evalNextSegment := evalSegment(100, evalContext(map[string]any{"country": "DE", "beta_tester": true}), s)
+		assert.NotNil(t, vID)
+		assert.Contains(t, log.Msg, "matched all constraints of group 1.")
+		assert.False(t, evalNextSegment)
+	})
+
+	t.Run("no group matches", func(t *testing.T) {
+		vID, log, evalNextSegment := evalSegment(100, evalContext(map[string]any{"country": "DE"}), s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "constraint not match in any group.")
+		assert.Contains(t, log.Msg, "group 0: constraint not match.")
+		assert.Contains(t, log.Msg, "group 1: argument: beta_tester not found")
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("constraints within a group are AND'ed", func(t *testing.T) {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = 100
+		s.Constraints = []entity.Constraint{
+			{Property: "country", Operator: models.ConstraintOperatorEQ, Value: `"US"`, Group: 3},
+			{Property: "app_version", Operator: models.ConstraintOperatorSEMVERGTE, Value: `"2.0.0"`, Group: 3},
+			{Property: "beta_tester", Operator: models.ConstraintOperatorEQ, Value: `true`, Group: 5},
+		}
+		assert.NoError(t, s.PrepareEvaluation())
+
+		vID, _, _ := evalSegment(100, evalContext(map[string]any{"country": "US", "app_version": "1.9.0", "beta_tester": false}), s)
+		assert.Nil(t, vID)
+
+		vID, log, _ := evalSegment(100, evalContext(map[string]any{"country": "US", "app_version": "2.1.0", "beta_tester": false}), s)
+		assert.NotNil(t, vID)
+		assert.Contains(t, log.Msg, "group 3")
+	})
+}
+
+func TestEvalSegment_Audiences(t *testing.T) {
+	newSegment := func(constraints ...entity.Constraint) entity.Segment {
+		s := entity.GenFixtureSegment()
+		s.RolloutPercent = 100
+		s.Constraints = constraints
+		s.Audiences = []entity.Audience{
+			{
+				Key: "eu_users",
+				Constraints: []entity.Constraint{
+					{Property: "country", Operator: models.ConstraintOperatorIN, Value: `["DE", "FR"]`},
+				},
+			},
+			{Key: "everyone"},
+		}
+		assert.NoError(t, s.PrepareEvaluation())
+		return s
+	}
+
+	evalContext := func(entityContext map[string]any) models.EvalContext {
+		return models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: entityContext,
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		}
+	}
+
+	t.Run("audience matches", func(t *testing.T) {
+		s := newSegment()
+		vID, _, evalNextSegment := evalSegment(100, evalContext(map[string]any{"country": "DE"}), s)
+		assert.NotNil(t, vID)
+		assert.False(t, evalNextSegment)
+	})
+
+	t.Run("audience not matches", func(t *testing.T) {
+		s := newSegment()
+		vID, log, evalNextSegment := evalSegment(100, evalContext(map[string]any{"country": "US"}), s)
+		assert.Nil(t, vID)
+		assert.Contains(t, log.Msg, "audience eu_users not match.")
+		assert.True(t, evalNextSegment)
+	})
+
+	t.Run("audiences and inline constraints are AND'ed", func(t *testing.T) {
+		s := newSegment(entity.Constraint{Property: "beta_tester", Operator: models.ConstraintOperatorEQ, Value: `true`})
+
+		vID, _, _ := evalSegment(100, evalContext(map[string]any{"country": "DE", "beta_tester": false}), s)
+		assert.Nil(t, vID)
+
+		vID, _, _ = evalSegment(100, evalContext(map[string]any{"country": "US", "beta_tester": true}), s)
+		assert.Nil(t, vID)
+
+		vID, _, _ = evalSegment(100, evalContext(map[string]any{"country": "FR", "beta_tester": true}), s)
+		assert.NotNil(t, vID)
+	})
+}
+
 func TestEvalFlag(t *testing.T) {
 	defer gostub.StubFunc(&logEvalResult).Reset()
 
@@ -395,7 +700,7 @@ func TestEvalFlag(t *testing.T) {
 		f := entity.GenFixtureFlag()
 		f.Segments = append(f.Segments, entity.GenFixtureSegment())
 		f.Segments[0].Constraints = []entity.Constraint{}
-		f.Segments[0].RolloutPercent = uint(0)
+		f.Segments[0].RolloutPercent = 0
 
 		f.PrepareEvaluation()
 		ec := &EvalCache{
@@ -507,6 +812,182 @@ func TestEvalFlag(t *testing.T) {
 	})
 }
 
+func TestEvalFlagWithPrerequisites(t *testing.T) {
+	// the prerequisite flag assigns "control" to the entities in CA and no
+	// variant to the others
+	genPrerequisiteFlag := func() entity.Flag {
+		pf := entity.GenFixtureFlag()
+		pf.ID = 1
+		pf.Key = "new_payments_backend"
+		pf.Segments[0].Distributions[0].Percent = 100
+		pf.Segments[0].Distributions[1].Percent = 0
+		return pf
+	}
+	genFlag := func() entity.Flag {
+		f := entity.GenFixtureFlag()
+		f.Segments[0].Constraints = []entity.Constraint{}
+		f.Prerequisites = []entity.FlagPrerequisite{
+			{FlagKey: "new_payments_backend", VariantKeys: []string{"control"}},
+		}
+		return f
+	}
+	evalFlag := func(flags []entity.Flag, state string) *models.EvalResult {
+		for i := range flags {
+			flags[i].PrepareEvaluation()
+		}
+		defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCacheWithFlags(flags)).Reset()
+		return EvalFlag(models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]any{"dl_state": state},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		})
+	}
+
+	t.Run("prerequisite met", func(t *testing.T) {
+		result := evalFlag([]entity.Flag{genFlag(), genPrerequisiteFlag()}, "CA")
+		assert.NotZero(t, result.VariantID)
+		assert.Empty(t, result.EvalDebugLog.Msg)
+	})
+
+	t.Run("prerequisite not met", func(t *testing.T) {
+		result := evalFlag([]entity.Flag{genFlag(), genPrerequisiteFlag()}, "NY")
+		assert.Zero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.Msg, `prerequisite flag new_payments_backend not met. got variant ""`)
+	})
+
+	t.Run("prerequisite flag not found", func(t *testing.T) {
+		result := evalFlag([]entity.Flag{genFlag()}, "CA")
+		assert.Zero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.Msg, "prerequisite flag new_payments_backend not found or deleted")
+	})
+
+	t.Run("prerequisite flag not enabled", func(t *testing.T) {
+		pf := genPrerequisiteFlag()
+		pf.Enabled = false
+		result := evalFlag([]entity.Flag{genFlag(), pf}, "CA")
+		assert.Zero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.Msg, "prerequisite flag new_payments_backend is not enabled")
+	})
+
+	t.Run("prerequisites of the prerequisite flag", func(t *testing.T) {
+		pf := genPrerequisiteFlag()
+		pf.Prerequisites = []entity.FlagPrerequisite{
+			{FlagKey: "new_payments_backend", VariantKeys: []string{"control"}},
+		}
+		result := evalFlag([]entity.Flag{genFlag(), pf}, "CA")
+		assert.Zero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.Msg, "prerequisite flag new_payments_backend forms a cycle")
+
+		pf.Prerequisites = []entity.FlagPrerequisite{
+			{FlagKey: "flag_key_100", VariantKeys: []string{"control", "treatment"}},
+		}
+		result = evalFlag([]entity.Flag{genFlag(), pf}, "CA")
+		assert.Zero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.Msg, "prerequisite flag flag_key_100 forms a cycle")
+	})
+}
+
+func TestEvalFlagWithActivationWindow(t *testing.T) {
+	now := time.Date(2026, 1, 5, 12, 0, 0, 0, time.UTC)
+	defer gostub.StubFunc(&evalTimeNow, now).Reset()
+
+	active := &entity.ActivationWindow{StartAt: new(now.Add(-time.Hour))}
+	expired := &entity.ActivationWindow{EndAt: new(now.Add(-time.Hour))}
+	evalFlag := func(f entity.Flag) *models.EvalResult {
+		f.PrepareEvaluation()
+		return EvalFlagWithContext(&f, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]any{"dl_state": "CA"},
+			EntityID:      "entityID1",
+			FlagID:        int64(100),
+		})
+	}
+
+	t.Run("flag in its window", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.ActivationWindow = active
+		result := evalFlag(f)
+		assert.NotZero(t, result.VariantID)
+	})
+
+	t.Run("flag outside of its window", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.ActivationWindow = expired
+		result := evalFlag(f)
+		assert.Zero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.Msg, "flagID 100 is not active. activation window: until 2026-01-05T11:00:00Z")
+	})
+
+	t.Run("segment outside of its window is skipped", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.Segments[0].ActivationWindow = expired
+		result := evalFlag(f)
+		assert.Zero(t, result.VariantID)
+		assert.Len(t, result.EvalDebugLog.SegmentDebugLogs, 1)
+		assert.Contains(t, result.EvalDebugLog.SegmentDebugLogs[0].Msg, "segment_id 200 is not active")
+
+		f.Segments[0].ActivationWindow = active
+		result = evalFlag(f)
+		assert.NotZero(t, result.VariantID)
+	})
+}
+
+func TestEvalFlagWithBucketBy(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	f.BucketBy = "company_id"
+	f.PrepareEvaluation()
+	evalFlag := func(entityID string, entityContext map[string]any) *models.EvalResult {
+		return EvalFlagWithContext(&f, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: entityContext,
+			EntityID:      entityID,
+			FlagID:        int64(100),
+		})
+	}
+
+	t.Run("entities of the same company get the same variant", func(t *testing.T) {
+		variantIDs := map[int64]bool{}
+		for i := range 20 {
+			result := evalFlag(fmt.Sprintf("user%d", i), map[string]any{"dl_state": "CA", "company_id": "acme"})
+			assert.Equal(t, "acme", result.BucketKey)
+			assert.Equal(t, fmt.Sprintf("user%d", i), util.SafeString(result.EvalContext.EntityID))
+			assert.Contains(t, result.EvalDebugLog.Msg, `bucketing by company_id "acme"`)
+			variantIDs[result.VariantID] = true
+		}
+		assert.Len(t, variantIDs, 1)
+	})
+
+	t.Run("missing attribute falls back to the entityID", func(t *testing.T) {
+		result := evalFlag("user1", map[string]any{"dl_state": "CA"})
+		assert.Equal(t, "user1", result.BucketKey)
+		assert.NotZero(t, result.VariantID)
+		assert.Contains(t, result.EvalDebugLog.Msg, "bucketBy company_id not usable, bucketing by entityID")
+	})
+}
+
+func TestEvalFlagWithSalt(t *testing.T) {
+	evalVariants := func(salt string) []int64 {
+		f := entity.GenFixtureFlag()
+		f.Salt = salt
+		f.PrepareEvaluation()
+		variantIDs := make([]int64, 100)
+		for i := range variantIDs {
+			result := EvalFlagWithContext(&f, models.EvalContext{
+				EntityContext: map[string]any{"dl_state": "CA"},
+				EntityID:      fmt.Sprintf("user%d", i),
+				FlagID:        int64(100),
+			})
+			variantIDs[i] = result.VariantID
+		}
+		return variantIDs
+	}
+
+	assert.Equal(t, evalVariants(""), evalVariants("100"), "an empty salt is the flag ID")
+	assert.Equal(t, evalVariants("experiment-2"), evalVariants("experiment-2"))
+	assert.NotEqual(t, evalVariants(""), evalVariants("experiment-2"))
+}
+
 func TestEvalFlagDistribution(t *testing.T) {
 	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
 
@@ -809,10 +1290,10 @@ func TestPostEvaluationBatch(t *testing.T) {
 	})
 
 	t.Run("test mixed duplicates are deduplicated", func(t *testing.T) {
-		evalCount := 0
+		var evalCount atomic.Int32
 		originalEvalFlag := EvalFlag
 		EvalFlag = func(evalContext models.EvalContext) *models.EvalResult {
-			evalCount++
+			evalCount.Add(1)
 			return &models.EvalResult{}
 		}
 		defer func() { EvalFlag = originalEvalFlag }()
@@ -842,7 +1323,48 @@ func TestPostEvaluationBatch(t *testing.T) {
 		})
 		_, ok := resp.(*evaluation.PostEvaluationBatchOK)
 		assert.True(t, ok, "expected PostEvaluationBatchOK response")
-		assert.Equal(t, 2, evalCount, "expected 2 evaluations after deduplication")
+		assert.Equal(t, int32(2), evalCount.Load(), "expected 2 evaluations after deduplication")
+	})
+
+	t.Run("test results keep the order of the entities and flags", func(t *testing.T) {
+		defer gostub.Stub(&EvalFlag, func(evalContext models.EvalContext) *models.EvalResult {
+			return &models.EvalResult{EvalContext: &evalContext, FlagID: evalContext.FlagID, FlagKey: evalContext.FlagKey}
+		}).Reset()
+		defer gostub.Stub(&EvalFlagsByTags, func(evalContext models.EvalContext) []*models.EvalResult {
+			return []*models.EvalResult{
+				{EvalContext: &evalContext, FlagKey: "tag_flag_1"},
+				{EvalContext: &evalContext, FlagKey: "tag_flag_2"},
+			}
+		}).Reset()
+
+		entities := make([]*models.EvaluationEntity, 50)
+		for i := range entities {
+			entities[i] = &models.EvaluationEntity{EntityID: fmt.Sprintf("entity%d", i)}
+		}
+		body := &models.EvaluationBatchRequest{
+			Entities: entities,
+			FlagIDs:  []int64{100, 200},
+			FlagKeys: []string{"flag_key_1"},
+			FlagTags: []string{"tag1"},
+		}
+
+		for _, concurrency := range []int{0, 1, 8} {
+			defer gostub.Stub(&config.Config.EvalBatchConcurrency, concurrency).Reset()
+			resp := NewEval().PostEvaluationBatch(evaluation.PostEvaluationBatchParams{Body: body})
+			results := resp.(*evaluation.PostEvaluationBatchOK).Payload.EvaluationResults
+			assert.Len(t, results, 50*5)
+			for i, evalEntity := range entities {
+				rs := results[i*5 : i*5+5]
+				for _, r := range rs {
+					assert.Equal(t, evalEntity.EntityID, r.EvalContext.EntityID)
+				}
+				assert.Equal(t, "tag_flag_1", rs[0].FlagKey)
+				assert.Equal(t, "tag_flag_2", rs[1].FlagKey)
+				assert.Equal(t, int64(100), rs[2].FlagID)
+				assert.Equal(t, int64(200), rs[3].FlagID)
+				assert.Equal(t, "flag_key_1", rs[4].FlagKey)
+			}
+		}
 	})
 
 	t.Run("test batch size limit exceeded", func(t *testing.T) {
@@ -1075,6 +1597,40 @@ func BenchmarkPostEvaluationBatch(b *testing.B) {
 	}
 }
 
+// BenchmarkPostEvaluationBatch_Concurrency evaluates a batch of 500 entities
+// serially and on the worker pool.
+func BenchmarkPostEvaluationBatch_Concurrency(b *testing.B) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	evalCache, flagIDs, flagKeys := genBenchmarkEvalCache(10)
+	defer gostub.StubFunc(&GetEvalCache, evalCache).Reset()
+
+	entities := make([]*models.EvaluationEntity, 500)
+	for i := range entities {
+		entities[i] = &models.EvaluationEntity{
+			EntityContext: map[string]any{"dl_state": "CA", "state": "NY"},
+			EntityID:      fmt.Sprintf("entityID%d", i),
+			EntityType:    "entityType1",
+		}
+	}
+	params := evaluation.PostEvaluationBatchParams{
+		Body: &models.EvaluationBatchRequest{
+			Entities: entities,
+			FlagIDs:  flagIDs,
+			FlagKeys: flagKeys,
+		},
+	}
+
+	e := NewEval()
+	for _, concurrency := range []int{1, 4, 0} {
+		b.Run(fmt.Sprintf("concurrency=%d", concurrency), func(b *testing.B) {
+			defer gostub.Stub(&config.Config.EvalBatchConcurrency, concurrency).Reset()
+			for b.Loop() {
+				e.PostEvaluationBatch(params)
+			}
+		})
+	}
+}
+
 // genBenchmarkEvalCacheWithConstraints creates a flag with multiple constraints for targeted benchmarks.
 func genBenchmarkEvalCacheWithConstraints(constraints []entity.Constraint, numFlags int) *EvalCache {
 	idCache := make(map[string]*entity.Flag, numFlags)
@@ -1343,3 +1899,323 @@ func BenchmarkPostEvaluationBatchWithTags(b *testing.B) {
 		})
 	}
 }
+
+func TestEvalFlagWithLayer(t *testing.T) {
+	layer := &entity.Layer{Key: "checkout", Slots: 100}
+	layer.ID = 1
+	evalOwners := func(entityID string) []string {
+		owners := []string{}
+		for i, start := range []uint{0, 30, 60} {
+			f := entity.GenFixtureFlag()
+			f.Key = fmt.Sprintf("flag_%d", i)
+			f.LayerID, f.Layer = &layer.ID, layer
+			f.LayerSlotStart, f.LayerSlotEnd = start, start+30
+			f.PrepareEvaluation()
+			result := EvalFlagWithContext(&f, models.EvalContext{
+				EntityContext: map[string]any{"dl_state": "CA"},
+				EntityID:      entityID,
+				FlagID:        int64(100),
+			})
+			if result.VariantID != 0 {
+				owners = append(owners, f.Key)
+			}
+		}
+		return owners
+	}
+
+	cnt := make(map[int]int)
+	for i := range 1000 {
+		cnt[len(evalOwners(fmt.Sprintf("user%d", i)))]++
+	}
+	assert.Len(t, cnt, 2, "an entity is evaluated by at most one flag of the layer")
+	assert.InDelta(t, 900, cnt[1], 50)
+	assert.InDelta(t, 100, cnt[0], 50, "slots [90, 100) are not owned by any flag")
+
+	t.Run("debug message", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.LayerID, f.Layer = &layer.ID, layer
+		f.LayerSlotStart, f.LayerSlotEnd = 0, 1
+		f.PrepareEvaluation()
+		entityID := "user1"
+		slot := layer.Slot(entityID)
+		if slot == 0 {
+			entityID = "user2"
+			slot = layer.Slot(entityID)
+		}
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityID: entityID,
+			FlagID:   int64(100),
+		})
+		assert.Zero(t, result.VariantID)
+		assert.Equal(t, fmt.Sprintf("flagID 100 doesn't own slot %d of layer checkout", slot), result.EvalDebugLog.Msg)
+	})
+}
+
+func TestEvalFlagWithHoldout(t *testing.T) {
+	holdout := &entity.Holdout{Key: "global", Percent: 10}
+	holdout.ID = 1
+	f := entity.GenFixtureFlag()
+	f.HoldoutID, f.Holdout = &holdout.ID, holdout
+	f.HoldoutVariantID = 300
+	f.PrepareEvaluation()
+
+	cnt := 0
+	for i := range 1000 {
+		entityID := fmt.Sprintf("user%d", i)
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "CA"},
+			EntityID:      entityID,
+			FlagID:        int64(100),
+		})
+		if !holdout.Contains(entityID) {
+			assert.Zero(t, result.HoldoutID)
+			assert.NotZero(t, result.SegmentID)
+			continue
+		}
+		cnt++
+		assert.Equal(t, int64(1), result.HoldoutID)
+		assert.Equal(t, "global", result.HoldoutKey)
+		assert.Zero(t, result.SegmentID)
+		assert.Equal(t, int64(300), result.VariantID)
+		assert.Equal(t, "control", result.VariantKey)
+	}
+	assert.InDelta(t, 100, cnt, 30)
+
+	t.Run("entity in holdout but not matching any segment", func(t *testing.T) {
+		entityID := "user0"
+		for i := 0; !holdout.Contains(entityID); i++ {
+			entityID = fmt.Sprintf("user%d", i)
+		}
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "NY"},
+			EntityID:      entityID,
+			EnableDebug:   true,
+			FlagID:        int64(100),
+		})
+		assert.Equal(t, int64(300), result.VariantID)
+		assert.Equal(t, "entity is in holdout global", result.EvalDebugLog.Msg)
+	})
+}
+
+func TestEvalFlagWithDefaultVariant(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	f := entity.GenFixtureFlag()
+	f.DefaultVariantID = 301
+	f.PrepareEvaluation()
+
+	t.Run("no segment matched", func(t *testing.T) {
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "NY"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.True(t, result.DefaultVariant)
+		assert.Equal(t, int64(301), result.VariantID)
+		assert.Equal(t, "treatment", result.VariantKey)
+		assert.Equal(t, entity.Attachment{"value": "321"}, result.VariantAttachment)
+	})
+
+	t.Run("no segment matched with a stale default variant", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.DefaultVariantID = 999
+		f.PrepareEvaluation()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "NY"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.False(t, result.DefaultVariant)
+		assert.Zero(t, result.VariantID)
+		assert.Empty(t, result.VariantKey)
+	})
+
+	t.Run("segment matched", func(t *testing.T) {
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "CA"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.False(t, result.DefaultVariant)
+		assert.NotZero(t, result.VariantID)
+	})
+
+	t.Run("segment matched but out of the rollout", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.DefaultVariantID = 301
+		f.Segments[0].RolloutPercent = 0
+		f.PrepareEvaluation()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "CA"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.False(t, result.DefaultVariant)
+		assert.Zero(t, result.VariantID)
+	})
+
+	t.Run("flag disabled", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.DefaultVariantID = 300
+		f.Enabled = false
+		f.PrepareEvaluation()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "CA"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.True(t, result.DefaultVariant)
+		assert.Equal(t, int64(300), result.VariantID)
+		assert.Equal(t, "control", result.VariantKey)
+	})
+
+	t.Run("layer slot not owned", func(t *testing.T) {
+		layer := &entity.Layer{Key: "checkout", Slots: 100}
+		layer.ID = 1
+		f := entity.GenFixtureFlag()
+		f.DefaultVariantID = 300
+		f.LayerID, f.Layer = &layer.ID, layer
+		f.PrepareEvaluation()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "CA"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.True(t, result.DefaultVariant)
+		assert.Equal(t, "control", result.VariantKey)
+	})
+
+	t.Run("prerequisite not met", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		f.DefaultVariantID = 300
+		f.Prerequisites = []entity.FlagPrerequisite{
+			{FlagKey: "missing_flag", VariantKeys: []string{"on"}},
+		}
+		f.PrepareEvaluation()
+		defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCacheWithFlags([]entity.Flag{f})).Reset()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "CA"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.True(t, result.DefaultVariant)
+		assert.Equal(t, "control", result.VariantKey)
+	})
+
+	t.Run("no default variant", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "NY"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.False(t, result.DefaultVariant)
+		assert.Zero(t, result.VariantID)
+	})
+}
+
+func TestEvalFlagWithTargets(t *testing.T) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	genFlag := func() entity.Flag {
+		f := entity.GenFixtureFlag()
+		f.Targets = []entity.FlagTarget{
+			{EntityID: "qa_account", VariantID: 301, VariantKey: "treatment"},
+		}
+		f.PrepareEvaluation()
+		return f
+	}
+
+	t.Run("targeted entity", func(t *testing.T) {
+		f := genFlag()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EnableDebug:   true,
+			EntityContext: map[string]any{"dl_state": "NY"},
+			EntityID:      "qa_account",
+			FlagID:        int64(100),
+		})
+		assert.True(t, result.IndividualTarget)
+		assert.Equal(t, int64(301), result.VariantID)
+		assert.Equal(t, "treatment", result.VariantKey)
+		assert.Equal(t, entity.Attachment{"value": "321"}, result.VariantAttachment)
+		assert.Zero(t, result.SegmentID)
+		assert.Equal(t, "entity qa_account is individually targeted to variant treatment", result.EvalDebugLog.Msg)
+	})
+
+	t.Run("entity not targeted", func(t *testing.T) {
+		f := genFlag()
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "NY"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.False(t, result.IndividualTarget)
+		assert.Zero(t, result.VariantID)
+	})
+
+	t.Run("flag without segments", func(t *testing.T) {
+		f := genFlag()
+		f.Segments = nil
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityID: "qa_account",
+			FlagID:   int64(100),
+		})
+		assert.True(t, result.IndividualTarget)
+		assert.Equal(t, int64(301), result.VariantID)
+	})
+
+	t.Run("flag disabled", func(t *testing.T) {
+		f := genFlag()
+		f.Enabled = false
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityID: "qa_account",
+			FlagID:   int64(100),
+		})
+		assert.False(t, result.IndividualTarget)
+		assert.Zero(t, result.VariantID)
+	})
+
+	t.Run("targeted in the prerequisite flag", func(t *testing.T) {
+		pf := genFlag()
+		pf.ID = 1
+		pf.Key = "new_payments_backend"
+		f := entity.GenFixtureFlag()
+		f.Prerequisites = []entity.FlagPrerequisite{
+			{FlagKey: "new_payments_backend", VariantKeys: []string{"treatment"}},
+		}
+		f.Segments[0].Constraints = []entity.Constraint{}
+		f.PrepareEvaluation()
+		defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCacheWithFlags([]entity.Flag{f, pf})).Reset()
+
+		result := EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "NY"},
+			EntityID:      "qa_account",
+			FlagID:        int64(100),
+		})
+		assert.NotZero(t, result.VariantID)
+
+		result = EvalFlagWithContext(&f, models.EvalContext{
+			EntityContext: map[string]any{"dl_state": "NY"},
+			EntityID:      "user1",
+			FlagID:        int64(100),
+		})
+		assert.Zero(t, result.VariantID)
+	})
+}
+
+func BenchmarkEvalFlagWithTargets(b *testing.B) {
+	defer gostub.StubFunc(&logEvalResult).Reset()
+	f := entity.GenFixtureFlag()
+	for i := range 100000 {
+		f.Targets = append(f.Targets, entity.FlagTarget{EntityID: fmt.Sprintf("vip_%d", i), VariantID: 301, VariantKey: "treatment"})
+	}
+	f.PrepareEvaluation()
+	evalContext := models.EvalContext{
+		EntityContext: map[string]any{"dl_state": "CA"},
+		EntityID:      "user1",
+		FlagID:        int64(100),
+	}
+	for b.Loop() {
+		EvalFlagWithContext(&f, evalContext)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/foxdalas/flagr/pkg/notification"
 	"github.com/foxdalas/flagr/swagger_gen/models"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/audience"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/constraint"
 	datarapi "github.com/foxdalas/flagr/swagger_gen/restapi/operations/datar"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/distribution"
@@ -18,8 +19,12 @@ import (
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/export"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/health"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/holdout"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/layer"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/list"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/tag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/target"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/variant"
 )
 
@@ -40,6 +45,7 @@ func Setup(api *operations.FlagrAPI) {
 	setupEvaluation(api)
 	setupCRUD(api)
 	setupExport(api)
+	startRolloutScheduler()
 }
 
 func setupCRUD(api *operations.FlagrAPI) {
@@ -51,8 +57,18 @@ func setupCRUD(api *operations.FlagrAPI) {
 	api.FlagDeleteFlagHandler = flag.DeleteFlagHandlerFunc(c.DeleteFlag)
 	api.FlagRestoreFlagHandler = flag.RestoreFlagHandlerFunc(c.RestoreFlag)
 	api.FlagSetFlagEnabledHandler = flag.SetFlagEnabledHandlerFunc(c.SetFlagEnabledState)
+	api.FlagPutFlagPrerequisitesHandler = flag.PutFlagPrerequisitesHandlerFunc(c.PutFlagPrerequisites)
+	api.FlagPutFlagActivationWindowHandler = flag.PutFlagActivationWindowHandlerFunc(c.PutFlagActivationWindow)
+	api.FlagDeleteFlagActivationWindowHandler = flag.DeleteFlagActivationWindowHandlerFunc(c.DeleteFlagActivationWindow)
+	api.FlagPutFlagLayerHandler = flag.PutFlagLayerHandlerFunc(c.PutFlagLayer)
+	api.FlagDeleteFlagLayerHandler = flag.DeleteFlagLayerHandlerFunc(c.DeleteFlagLayer)
+	api.FlagPutFlagHoldoutHandler = flag.PutFlagHoldoutHandlerFunc(c.PutFlagHoldout)
+	api.FlagDeleteFlagHoldoutHandler = flag.DeleteFlagHoldoutHandlerFunc(c.DeleteFlagHoldout)
 	api.FlagGetFlagSnapshotsHandler = flag.GetFlagSnapshotsHandlerFunc(c.GetFlagSnapshots)
+	api.FlagPostFlagSnapshotEvaluationHandler = flag.PostFlagSnapshotEvaluationHandlerFunc(c.PostFlagSnapshotEvaluation)
 	api.FlagGetFlagEntityTypesHandler = flag.GetFlagEntityTypesHandlerFunc(c.GetFlagEntityTypes)
+	api.FlagGetEntityTypeSchemaHandler = flag.GetEntityTypeSchemaHandlerFunc(c.GetEntityTypeSchema)
+	api.FlagPutEntityTypeSchemaHandler = flag.PutEntityTypeSchemaHandlerFunc(c.PutEntityTypeSchema)
 	api.FlagGetFlagSnapshotMaxIDHandler = flag.GetFlagSnapshotMaxIDHandlerFunc(c.GetFlagSnapshotMaxID)
 
 	api.TagCreateTagHandler = tag.CreateTagHandlerFunc(c.CreateTag)
@@ -60,11 +76,42 @@ func setupCRUD(api *operations.FlagrAPI) {
 	api.TagFindTagsHandler = tag.FindTagsHandlerFunc(c.FindTags)
 	api.TagFindAllTagsHandler = tag.FindAllTagsHandlerFunc(c.FindAllTags)
 
+	api.AudienceFindAudiencesHandler = audience.FindAudiencesHandlerFunc(c.FindAudiences)
+	api.AudienceCreateAudienceHandler = audience.CreateAudienceHandlerFunc(c.CreateAudience)
+	api.AudienceGetAudienceHandler = audience.GetAudienceHandlerFunc(c.GetAudience)
+	api.AudiencePutAudienceHandler = audience.PutAudienceHandlerFunc(c.PutAudience)
+	api.AudienceDeleteAudienceHandler = audience.DeleteAudienceHandlerFunc(c.DeleteAudience)
+
+	api.LayerFindLayersHandler = layer.FindLayersHandlerFunc(c.FindLayers)
+	api.LayerCreateLayerHandler = layer.CreateLayerHandlerFunc(c.CreateLayer)
+	api.LayerGetLayerHandler = layer.GetLayerHandlerFunc(c.GetLayer)
+	api.LayerPutLayerHandler = layer.PutLayerHandlerFunc(c.PutLayer)
+	api.LayerDeleteLayerHandler = layer.DeleteLayerHandlerFunc(c.DeleteLayer)
+
+	api.HoldoutFindHoldoutsHandler = holdout.FindHoldoutsHandlerFunc(c.FindHoldouts)
+	api.HoldoutCreateHoldoutHandler = holdout.CreateHoldoutHandlerFunc(c.CreateHoldout)
+	api.HoldoutGetHoldoutHandler = holdout.GetHoldoutHandlerFunc(c.GetHoldout)
+	api.HoldoutPutHoldoutHandler = holdout.PutHoldoutHandlerFunc(c.PutHoldout)
+	api.HoldoutDeleteHoldoutHandler = holdout.DeleteHoldoutHandlerFunc(c.DeleteHoldout)
+
+	api.ListFindListsHandler = list.FindListsHandlerFunc(c.FindLists)
+	api.ListCreateListHandler = list.CreateListHandlerFunc(c.CreateList)
+	api.ListGetListHandler = list.GetListHandlerFunc(c.GetList)
+	api.ListPutListHandler = list.PutListHandlerFunc(c.PutList)
+	api.ListDeleteListHandler = list.DeleteListHandlerFunc(c.DeleteList)
+	api.ListPutListValuesHandler = list.PutListValuesHandlerFunc(c.PutListValues)
+
 	api.SegmentCreateSegmentHandler = segment.CreateSegmentHandlerFunc(c.CreateSegment)
 	api.SegmentFindSegmentsHandler = segment.FindSegmentsHandlerFunc(c.FindSegments)
 	api.SegmentPutSegmentHandler = segment.PutSegmentHandlerFunc(c.PutSegment)
 	api.SegmentDeleteSegmentHandler = segment.DeleteSegmentHandlerFunc(c.DeleteSegment)
 	api.SegmentPutSegmentsReorderHandler = segment.PutSegmentsReorderHandlerFunc(c.PutSegmentsReorder)
+	api.SegmentGetRolloutScheduleHandler = segment.GetRolloutScheduleHandlerFunc(c.GetRolloutSchedule)
+	api.SegmentCreateRolloutScheduleHandler = segment.CreateRolloutScheduleHandlerFunc(c.CreateRolloutSchedule)
+	api.SegmentSetRolloutSchedulePausedHandler = segment.SetRolloutSchedulePausedHandlerFunc(c.SetRolloutSchedulePaused)
+	api.SegmentCancelRolloutScheduleHandler = segment.CancelRolloutScheduleHandlerFunc(c.CancelRolloutSchedule)
+	api.SegmentPutSegmentActivationWindowHandler = segment.PutSegmentActivationWindowHandlerFunc(c.PutSegmentActivationWindow)
+	api.SegmentDeleteSegmentActivationWindowHandler = segment.DeleteSegmentActivationWindowHandlerFunc(c.DeleteSegmentActivationWindow)
 
 	api.ConstraintCreateConstraintHandler = constraint.CreateConstraintHandlerFunc(c.CreateConstraint)
 	api.ConstraintFindConstraintsHandler = constraint.FindConstraintsHandlerFunc(c.FindConstraints)
@@ -78,6 +125,11 @@ func setupCRUD(api *operations.FlagrAPI) {
 	api.VariantFindVariantsHandler = variant.FindVariantsHandlerFunc(c.FindVariants)
 	api.VariantPutVariantHandler = variant.PutVariantHandlerFunc(c.PutVariant)
 	api.VariantDeleteVariantHandler = variant.DeleteVariantHandlerFunc(c.DeleteVariant)
+
+	api.TargetFindTargetsHandler = target.FindTargetsHandlerFunc(c.FindTargets)
+	api.TargetCreateTargetHandler = target.CreateTargetHandlerFunc(c.CreateTarget)
+	api.TargetPutTargetsHandler = target.PutTargetsHandlerFunc(c.PutTargets)
+	api.TargetDeleteTargetHandler = target.DeleteTargetHandlerFunc(c.DeleteTarget)
 }
 
 func setupEvaluation(api *operations.FlagrAPI) {
@@ -88,6 +140,8 @@ func setupEvaluation(api *operations.FlagrAPI) {
 	api.EvaluationGetEvaluationBatchHandler = evaluation.GetEvaluationBatchHandlerFunc(e.GetEvaluationBatch)
 	api.EvaluationPostEvaluationHandler = evaluation.PostEvaluationHandlerFunc(e.PostEvaluation)
 	api.EvaluationPostEvaluationBatchHandler = evaluation.PostEvaluationBatchHandlerFunc(e.PostEvaluationBatch)
+	api.EvaluationPostEvaluationExplainHandler = evaluation.PostEvaluationExplainHandlerFunc(e.PostEvaluationExplain)
+	api.EvaluationPostEvaluationSimulateHandler = evaluation.PostEvaluationSimulateHandlerFunc(e.PostEvaluationSimulate)
 
 	// Force-init the data recorder (may be noop, external, Datar, or fan-out).
 	GetDataRecorder()
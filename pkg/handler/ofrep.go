@@ -113,6 +113,14 @@ func (h *ofrepHandler) handleEvaluateFlag(w http.ResponseWriter, r *http.Request
 	}
 
 	evalContext := buildEvalContext(flag.Key, targetingKey, req.Context)
+	if err := checkEntityContext(evalContext); err != nil {
+		writeJSON(w, http.StatusBadRequest, ofrepEvalFailure{
+			Key:          key,
+			ErrorCode:    "INVALID_CONTEXT",
+			ErrorDetails: err.Error(),
+		})
+		return
+	}
 	evalResult := EvalFlagWithContext(flag, evalContext)
 
 	resp := buildEvalResponse(flag, evalResult)
@@ -148,15 +156,25 @@ func (h *ofrepHandler) handleBulkEvaluate(w http.ResponseWriter, r *http.Request
 
 	flags := cache.GetAllEnabledFlags()
 	entityContext := buildSharedEntityContext(req.Context)
-	results := make([]any, 0, len(flags))
-
-	for _, flag := range flags {
-		evalContext := models.EvalContext{
+	evalContexts := make([]models.EvalContext, len(flags))
+	for i, flag := range flags {
+		evalContexts[i] = models.EvalContext{
 			EntityID:      targetingKey,
 			EntityContext: entityContext,
 			FlagKey:       flag.Key,
 		}
-		evalResult := EvalFlagWithContext(flag, evalContext)
+		if err := checkEntityContext(evalContexts[i]); err != nil {
+			writeJSON(w, http.StatusBadRequest, ofrepBulkFailure{
+				ErrorCode:    "INVALID_CONTEXT",
+				ErrorDetails: err.Error(),
+			})
+			return
+		}
+	}
+
+	results := make([]any, 0, len(flags))
+	for i, flag := range flags {
+		evalResult := EvalFlagWithContext(flag, evalContexts[i])
 		results = append(results, buildEvalResponse(flag, evalResult))
 	}
 
@@ -219,6 +237,10 @@ func buildEvalResponse(flag *entity.Flag, evalResult *models.EvalResult) map[str
 	reason := determineReason(flag, evalResult)
 	value, hasValue := extractValue(evalResult.VariantKey, evalResult.VariantAttachment)
 	metadata := buildFlagMetadata(flag)
+	if metadata != nil && evalResult.HoldoutID != 0 {
+		metadata["holdoutId"] = float64(evalResult.HoldoutID)
+		metadata["holdoutKey"] = evalResult.HoldoutKey
+	}
 
 	return buildSuccessResponse(flag.Key, reason, evalResult.VariantKey, value, hasValue, metadata)
 }
@@ -248,6 +270,20 @@ func determineReason(flag *entity.Flag, evalResult *models.EvalResult) string {
 		return "DISABLED"
 	}
 
+	// HOLDOUT isn't an OFREP reason: the entity is in the holdout by its
+	// bucket, and the holdout is in the metadata, see buildEvalResponse
+	if evalResult.HoldoutID != 0 {
+		return "SPLIT"
+	}
+
+	if evalResult.IndividualTarget {
+		return "TARGETING_MATCH"
+	}
+
+	if evalResult.DefaultVariant {
+		return "DEFAULT"
+	}
+
 	seg := findMatchedSegment(flag, evalResult.SegmentID)
 	if seg == nil {
 		return "UNKNOWN"
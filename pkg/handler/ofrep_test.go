@@ -152,6 +152,39 @@ func TestOFREPEvaluateFlag(t *testing.T) {
 	})
 }
 
+func TestOFREPEvaluateFlag_NestedContext(t *testing.T) {
+	f := entity.GenFixtureFlag()
+	f.Segments[0].Constraints = []entity.Constraint{
+		{Property: "device.os", Operator: models.ConstraintOperatorEQ, Value: `"ios"`},
+		{Property: "roles[0]", Operator: models.ConstraintOperatorEQ, Value: `"admin"`},
+	}
+	assert.NoError(t, f.PrepareEvaluation())
+	defer gostub.StubFunc(&GetEvalCache, &EvalCache{
+		cache: &cacheContainer{keyCache: map[string]*entity.Flag{f.Key: &f}},
+	}).Reset()
+	defer gostub.StubFunc(&logEvalResult).Reset()
+
+	h := newOFREPHandler()
+	evaluate := func(body string) map[string]any {
+		req := httptest.NewRequest(http.MethodPost, "/ofrep/v1/evaluate/flags/"+f.Key, bytes.NewBufferString(body))
+		req.Header.Set("Content-Type", "application/json")
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		var resp map[string]any
+		assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &resp))
+		return resp
+	}
+
+	resp := evaluate(`{"context":{"targetingKey":"user-1","device":{"os":"ios"},"roles":["admin"]}}`)
+	assert.Equal(t, "TARGETING_MATCH", resp["reason"])
+	assert.NotEmpty(t, resp["variant"])
+
+	resp = evaluate(`{"context":{"targetingKey":"user-1","device":{"os":"ios"},"roles":["viewer","admin"]}}`)
+	assert.Empty(t, resp["variant"])
+}
+
 func TestOFREPBulkEvaluate(t *testing.T) {
 	defer gostub.StubFunc(&GetEvalCache, GenFixtureEvalCache()).Reset()
 	defer gostub.StubFunc(&logEvalResult).Reset()
@@ -306,6 +339,24 @@ func TestOFREPDetermineReason(t *testing.T) {
 		assert.Equal(t, "DISABLED", determineReason(&f, result))
 	})
 
+	t.Run("entity in holdout", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		result := &models.EvalResult{VariantID: 300, HoldoutID: 1}
+		assert.Equal(t, "SPLIT", determineReason(&f, result))
+	})
+
+	t.Run("individual target", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		result := &models.EvalResult{VariantID: 301, IndividualTarget: true}
+		assert.Equal(t, "TARGETING_MATCH", determineReason(&f, result))
+	})
+
+	t.Run("default variant", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		result := &models.EvalResult{SegmentID: 200, VariantID: 301, DefaultVariant: true}
+		assert.Equal(t, "DEFAULT", determineReason(&f, result))
+	})
+
 	t.Run("no segment matched", func(t *testing.T) {
 		f := entity.GenFixtureFlag()
 		result := &models.EvalResult{SegmentID: 0}
@@ -445,6 +496,27 @@ func TestOFREPExtractValue(t *testing.T) {
 	})
 }
 
+func TestOFREPBuildEvalResponse(t *testing.T) {
+	t.Run("entity in holdout", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		result := &models.EvalResult{VariantID: 300, VariantKey: "control", HoldoutID: 7, HoldoutKey: "global-holdout"}
+		resp := buildEvalResponse(&f, result)
+		assert.Equal(t, "SPLIT", resp["reason"])
+		assert.Equal(t, "control", resp["variant"])
+		meta := resp["metadata"].(map[string]any)
+		assert.Equal(t, float64(7), meta["holdoutId"])
+		assert.Equal(t, "global-holdout", meta["holdoutKey"])
+	})
+
+	t.Run("entity not in holdout", func(t *testing.T) {
+		f := entity.GenFixtureFlag()
+		result := &models.EvalResult{VariantID: 300, VariantKey: "control"}
+		meta := buildEvalResponse(&f, result)["metadata"].(map[string]any)
+		assert.NotContains(t, meta, "holdoutId")
+		assert.NotContains(t, meta, "holdoutKey")
+	})
+}
+
 func TestOFREPBuildFlagMetadata(t *testing.T) {
 	t.Run("flag with tags", func(t *testing.T) {
 		f := entity.GenFixtureFlag()
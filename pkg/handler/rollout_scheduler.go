@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"errors"
+	"time"
+
+	"github.com/foxdalas/flagr/pkg/config"
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/pkg/notification"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+
+	"github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+)
+
+// rolloutSchedulerSubject is the UpdatedBy of the changes made by the
+// rollout scheduler
+const rolloutSchedulerSubject = "rollout_scheduler"
+
+// startRolloutScheduler periodically applies the active rollout schedules
+func startRolloutScheduler() {
+	if !config.Config.RolloutSchedulerEnabled {
+		return
+	}
+	go func() {
+		for range time.Tick(config.Config.RolloutSchedulerInterval) {
+			if err := applyRolloutSchedules(time.Now()); err != nil {
+				logrus.WithField("err", err).Error("apply rollout schedules error")
+			}
+		}
+	}()
+}
+
+// applyRolloutSchedules writes the RolloutPercent prescribed by every active
+// rollout schedule at the given time to its segment
+func applyRolloutSchedules(now time.Time) error {
+	rss := []entity.RolloutSchedule{}
+	if err := getDB().Where("status = ?", models.RolloutScheduleStatusActive).Order("id").Find(&rss).Error; err != nil {
+		return err
+	}
+
+	for i := range rss {
+		if err := applyRolloutSchedule(&rss[i], now); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"err":               err,
+				"rolloutScheduleID": rss[i].ID,
+				"segmentID":         rss[i].SegmentID,
+			}).Error("failed to apply rollout schedule")
+		}
+	}
+	return nil
+}
+
+// applyRolloutSchedule updates the segment through the normal snapshot path
+// when the prescribed percent changes. The schedule row is updated
+// conditionally on the previously applied percent, so that only one of
+// several flagr instances applies each change.
+func applyRolloutSchedule(rs *entity.RolloutSchedule, now time.Time) error {
+	percent, ok, done := rs.PercentAt(now)
+	if !ok {
+		return nil
+	}
+	changed := rs.AppliedPercent == nil || *rs.AppliedPercent != percent
+	if !changed && !done {
+		return nil
+	}
+
+	s := &entity.Segment{}
+	err := getDB().First(s, rs.SegmentID).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// the segment is deleted, so there's nothing left to roll out
+		return getDB().Model(rs).Updates(map[string]any{
+			"status":     models.RolloutScheduleStatusCanceled,
+			"updated_by": rolloutSchedulerSubject,
+		}).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	updates := map[string]any{
+		"applied_percent": percent,
+		"updated_by":      rolloutSchedulerSubject,
+	}
+	if done {
+		updates["status"] = models.RolloutScheduleStatusCompleted
+	}
+
+	applied := false
+	err = getDB().Transaction(func(tx *gorm.DB) error {
+		q := tx.Model(&entity.RolloutSchedule{}).
+			Where("id = ? AND status = ?", rs.ID, models.RolloutScheduleStatusActive)
+		if rs.AppliedPercent == nil {
+			q = q.Where("applied_percent IS NULL")
+		} else {
+			q = q.Where("applied_percent = ?", *rs.AppliedPercent)
+		}
+		res := q.Updates(updates)
+		if res.Error != nil || res.RowsAffected == 0 || !changed {
+			return res.Error
+		}
+
+		applied = true
+		return tx.Model(s).Update("rollout_percent", percent).Error
+	})
+	if err != nil || !applied {
+		return err
+	}
+
+	entity.SaveFlagSnapshot(getDB(), s.FlagID, rolloutSchedulerSubject, notification.OperationUpdate, notification.ComponentSegment, s.ID, "")
+	return nil
+}
@@ -0,0 +1,126 @@
+package handler
+
+import (
+	"testing"
+	"time"
+
+	"github.com/foxdalas/flagr/pkg/entity"
+	"github.com/foxdalas/flagr/swagger_gen/models"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
+
+	"github.com/prashantv/gostub"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyRolloutSchedules(t *testing.T) {
+	db := entity.NewTestDB()
+	c := &crud{}
+
+	tmpDB, dbErr := db.DB()
+	if dbErr != nil {
+		t.Errorf("Failed to get database")
+	}
+
+	defer tmpDB.Close()
+	defer gostub.StubFunc(&getDB, db).Reset()
+
+	c.CreateFlag(flag.CreateFlagParams{
+		Body: &models.CreateFlagRequest{
+			Description: new("funny flag"),
+			Template:    "simple_boolean_flag",
+		},
+	})
+	start := time.Now().Add(time.Hour)
+	day := 24 * time.Hour
+	c.CreateRolloutSchedule(segment.CreateRolloutScheduleParams{
+		FlagID:    int64(1),
+		SegmentID: int64(1),
+		Body: &models.CreateRolloutScheduleRequest{
+			Steps: genRolloutScheduleSteps(start, 0.5, 10, 100),
+		},
+	})
+
+	rolloutPercent := func() float64 {
+		s := &entity.Segment{}
+		db.First(s, 1)
+		return s.RolloutPercent
+	}
+	snapshots := func() int64 {
+		var count int64
+		db.Model(&entity.FlagSnapshot{}).Where("flag_id = ?", 1).Count(&count)
+		return count
+	}
+	schedule := func() *entity.RolloutSchedule {
+		rs := &entity.RolloutSchedule{}
+		db.First(rs, 1)
+		return rs
+	}
+	initialSnapshots := snapshots()
+
+	t.Run("it should wait for the first step", func(t *testing.T) {
+		assert.NoError(t, applyRolloutSchedules(start.Add(-time.Minute)))
+		assert.Equal(t, float64(100), rolloutPercent())
+		assert.Equal(t, initialSnapshots, snapshots())
+	})
+
+	t.Run("it should apply the step once", func(t *testing.T) {
+		stale := schedule()
+		assert.NoError(t, applyRolloutSchedules(start))
+		assert.Equal(t, 0.5, rolloutPercent())
+		assert.Equal(t, 0.5, *schedule().AppliedPercent)
+		assert.Equal(t, initialSnapshots+1, snapshots())
+
+		assert.NoError(t, applyRolloutSchedules(start.Add(time.Hour)))
+		assert.Equal(t, initialSnapshots+1, snapshots())
+
+		// another instance that loaded the schedule before the update
+		assert.NoError(t, applyRolloutSchedule(stale, start))
+		assert.Equal(t, initialSnapshots+1, snapshots())
+
+		fs := &entity.FlagSnapshot{}
+		db.Order("id desc").First(fs)
+		assert.Equal(t, rolloutSchedulerSubject, fs.UpdatedBy)
+	})
+
+	t.Run("it should skip the paused schedule", func(t *testing.T) {
+		c.SetRolloutSchedulePaused(segment.SetRolloutSchedulePausedParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body:      &models.SetRolloutSchedulePausedRequest{Paused: new(true)},
+		})
+		assert.NoError(t, applyRolloutSchedules(start.Add(day)))
+		assert.Equal(t, 0.5, rolloutPercent())
+
+		c.SetRolloutSchedulePaused(segment.SetRolloutSchedulePausedParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body:      &models.SetRolloutSchedulePausedRequest{Paused: new(false)},
+		})
+		assert.NoError(t, applyRolloutSchedules(start.Add(day)))
+		assert.Equal(t, float64(10), rolloutPercent())
+	})
+
+	t.Run("it should complete the schedule with the last step", func(t *testing.T) {
+		assert.NoError(t, applyRolloutSchedules(start.Add(3*day)))
+		assert.Equal(t, float64(100), rolloutPercent())
+		assert.Equal(t, models.RolloutScheduleStatusCompleted, schedule().Status)
+		assert.Equal(t, float64(100), *schedule().AppliedPercent)
+	})
+
+	t.Run("it should cancel the schedule of a deleted segment", func(t *testing.T) {
+		c.CreateRolloutSchedule(segment.CreateRolloutScheduleParams{
+			FlagID:    int64(1),
+			SegmentID: int64(1),
+			Body: &models.CreateRolloutScheduleRequest{
+				Steps: genRolloutScheduleSteps(start, 50),
+			},
+		})
+		c.DeleteSegment(segment.DeleteSegmentParams{FlagID: int64(1), SegmentID: int64(1)})
+
+		assert.NoError(t, applyRolloutSchedules(start))
+		rs := &entity.RolloutSchedule{}
+		db.First(rs, 2)
+		assert.Equal(t, models.RolloutScheduleStatusCanceled, rs.Status)
+	})
+}
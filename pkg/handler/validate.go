@@ -1,22 +1,31 @@
 package handler
 
 import (
+	"errors"
+	"slices"
+	"strings"
+
 	"github.com/foxdalas/flagr/pkg/entity"
 	"github.com/foxdalas/flagr/pkg/util"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/distribution"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/variant"
+	"gorm.io/gorm"
 )
 
 var validatePutDistributions = func(params distribution.PutDistributionsParams) *Error {
-	sum := int64(0)
+	// the sum is checked in buckets, so that 33.3+33.3+33.4 adds up to 100
+	sum := uint(0)
 	for _, d := range params.Body.Distributions {
 		if d.Percent == nil {
 			return NewError(400, "the percent of distribution %v is empty", d.ID)
 		}
-		sum += *d.Percent
+		if err := entity.ValidatePercent(*d.Percent); err != nil {
+			return NewError(400, "invalid percent of distribution %v. %s", d.ID, err)
+		}
+		sum += entity.PercentBuckets(*d.Percent)
 	}
-	if sum != 100 {
-		return NewError(400, "the sum of distributions' percent %v is not 100", sum)
+	if sum != entity.TotalBucketNum {
+		return NewError(400, "the sum of distributions' percent %v is not 100", float64(sum)/float64(entity.PercentMultiplier))
 	}
 
 	f := &entity.Flag{}
@@ -53,10 +62,22 @@ var validateDeleteVariant = func(params variant.DeleteVariantParams) *Error {
 	}
 	f.Preload(getDB())
 
+	if f.HoldoutID != nil && f.HoldoutVariantID == util.SafeUint(params.VariantID) {
+		return NewError(400, "error deleting variant %v. it's the holdout variant of the flag", params.VariantID)
+	}
+	if f.DefaultVariantID != 0 && f.DefaultVariantID == util.SafeUint(params.VariantID) {
+		return NewError(400, "error deleting variant %v. it's the default variant of the flag", params.VariantID)
+	}
+	for _, t := range f.Targets {
+		if t.VariantID == util.SafeUint(params.VariantID) {
+			return NewError(400, "error deleting variant %v. entity %s is targeted to it", params.VariantID, t.EntityID)
+		}
+	}
+
 	for _, s := range f.Segments {
 		for _, d := range s.Distributions {
 			if d.VariantID == util.SafeUint(params.VariantID) {
-				if d.Percent != uint(0) {
+				if entity.PercentBuckets(d.Percent) != 0 {
 					return NewError(400, "error deleting variant %v. distribution %v still has non-zero distribution %v", params.VariantID, d.ID, d.Percent)
 				}
 				if err := getDB().Delete(&entity.Distribution{}, d.ID).Error; err != nil {
@@ -69,6 +90,41 @@ var validateDeleteVariant = func(params variant.DeleteVariantParams) *Error {
 	return nil
 }
 
+// validateVariantAttachment validates that the attachment of the variant
+// conforms to the attachment schema of its flag
+var validateVariantAttachment = func(v *entity.Variant) *Error {
+	f := &entity.Flag{}
+	if err := getDB().Select("id", "attachment_schema").First(f, v.FlagID).Error; err != nil {
+		return NewError(404, "error finding flagID %v. reason %s", v.FlagID, err)
+	}
+	if err := f.AttachmentSchema.ValidateAttachment(v.Attachment); err != nil {
+		return NewError(400, "invalid attachment of variant %s. %s", v.Key, err)
+	}
+	return nil
+}
+
+// validatePutFlagAttachmentSchema validates the attachment schema, and that
+// the attachments of the existing variants of the flag conform to it
+var validatePutFlagAttachmentSchema = func(flagID uint, schema entity.AttachmentSchema) *Error {
+	if err := schema.Validate(); err != nil {
+		return NewError(400, "%s", err)
+	}
+	if len(schema) == 0 {
+		return nil
+	}
+
+	vs := []entity.Variant{}
+	if err := getDB().Order("id").Where(entity.Variant{FlagID: flagID}).Find(&vs).Error; err != nil {
+		return NewError(500, "error finding the variants of flagID %v. reason %s", flagID, err)
+	}
+	for _, v := range vs {
+		if err := schema.ValidateAttachment(v.Attachment); err != nil {
+			return NewError(400, "variant %s does not conform to the attachment schema. %s", v.Key, err)
+		}
+	}
+	return nil
+}
+
 var validatePutVariantForDistributions = func(v *entity.Variant) *Error {
 	err := getDB().
 		Model(entity.Distribution{}).
@@ -80,3 +136,292 @@ var validatePutVariantForDistributions = func(v *entity.Variant) *Error {
 	}
 	return nil
 }
+
+var validatePutVariantForTargets = func(v *entity.Variant) *Error {
+	err := getDB().
+		Model(entity.FlagTarget{}).
+		Where(entity.FlagTarget{VariantID: v.ID}).
+		Updates(entity.FlagTarget{VariantKey: v.Key}).
+		Error
+	if err != nil {
+		return NewError(500, "error updating targets to sync with variantID %v with variantKey %v. reason: %s", v.ID, v.Key, err)
+	}
+	return nil
+}
+
+// validatePutTargets validates the targets of the flag, whose variants have to
+// be preloaded, and resolves their variant IDs from the variant keys
+var validatePutTargets = func(f *entity.Flag, ts []entity.FlagTarget) *Error {
+	entityIDs := make(map[string]bool, len(ts))
+	for i := range ts {
+		t := &ts[i]
+		if err := t.Validate(); err != nil {
+			return NewError(400, "%s", err)
+		}
+		if entityIDs[t.EntityID] {
+			return NewError(400, "entity %s is targeted more than once", t.EntityID)
+		}
+		entityIDs[t.EntityID] = true
+
+		j := slices.IndexFunc(f.Variants, func(v entity.Variant) bool { return v.Key == t.VariantKey })
+		if j < 0 {
+			return NewError(400, "error finding variantKey %s under flag %s", t.VariantKey, f.Key)
+		}
+		t.VariantID = f.Variants[j].ID
+	}
+	return nil
+}
+
+var validatePutFlagPrerequisites = func(f *entity.Flag, ps []entity.FlagPrerequisite) *Error {
+	for _, p := range ps {
+		if err := p.Validate(); err != nil {
+			return NewError(400, "%s", err)
+		}
+		if p.FlagKey == f.Key {
+			return NewError(400, "flag %s cannot be a prerequisite of itself", f.Key)
+		}
+
+		pf := &entity.Flag{}
+		err := getDB().Preload("Variants").Where(entity.Flag{Key: p.FlagKey}).First(pf).Error
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return NewError(400, "error finding prerequisite flag %s", p.FlagKey)
+		}
+		if err != nil {
+			return NewError(500, "error finding prerequisite flag %s. reason: %s", p.FlagKey, err)
+		}
+		for _, k := range p.VariantKeys {
+			if !slices.ContainsFunc(pf.Variants, func(v entity.Variant) bool { return v.Key == k }) {
+				return NewError(400, "error finding variantKey %s under prerequisite flag %s", k, p.FlagKey)
+			}
+		}
+	}
+
+	// build the prerequisites graph of all the flags as if ps were saved
+	fs := []entity.Flag{}
+	if err := getDB().Preload("Prerequisites").Find(&fs).Error; err != nil {
+		return NewError(500, "error finding flags. reason: %s", err)
+	}
+	deps := make(map[string][]string, len(fs))
+	for _, other := range fs {
+		if other.ID == f.ID {
+			continue
+		}
+		for _, p := range other.Prerequisites {
+			deps[other.Key] = append(deps[other.Key], p.FlagKey)
+		}
+	}
+	for _, p := range ps {
+		deps[f.Key] = append(deps[f.Key], p.FlagKey)
+	}
+	if cycle := entity.PrerequisiteCycle(deps); cycle != nil {
+		return NewError(400, "error saving prerequisites of flag %s. they form a cycle: %s", f.Key, strings.Join(cycle, " -> "))
+	}
+	return nil
+}
+
+// validatePutFlagKey validates the key change of the flag from oldKey, which
+// the flags that have it as a prerequisite follow. They must not form a
+// prerequisite cycle with the new key. It returns the IDs of those flags.
+var validatePutFlagKey = func(f *entity.Flag, oldKey string) ([]uint, *Error) {
+	fs := []entity.Flag{}
+	if err := getDB().Preload("Prerequisites").Find(&fs).Error; err != nil {
+		return nil, NewError(500, "error finding flags. reason: %s", err)
+	}
+
+	var dependentIDs []uint
+	deps := make(map[string][]string, len(fs))
+	for _, other := range fs {
+		key := other.Key
+		if other.ID == f.ID {
+			key = f.Key
+		}
+		for _, p := range other.Prerequisites {
+			depKey := p.FlagKey
+			if depKey == oldKey {
+				depKey = f.Key
+				if n := len(dependentIDs); n == 0 || dependentIDs[n-1] != other.ID {
+					dependentIDs = append(dependentIDs, other.ID)
+				}
+			}
+			deps[key] = append(deps[key], depKey)
+		}
+	}
+	if cycle := entity.PrerequisiteCycle(deps); cycle != nil {
+		return nil, NewError(400, "error changing the key of flag %s to %s. the prerequisites would form a cycle: %s", oldKey, f.Key, strings.Join(cycle, " -> "))
+	}
+	return dependentIDs, nil
+}
+
+// validateDeleteFlag checks that no other flag has the flag as a prerequisite
+var validateDeleteFlag = func(f *entity.Flag) *Error {
+	dependents := []entity.Flag{}
+	err := getDB().
+		Where("id IN (?)", getDB().Model(&entity.FlagPrerequisite{}).Select("flag_id").Where("flag_key = ?", f.Key)).
+		Order("id").
+		Find(&dependents).Error
+	if err != nil {
+		return NewError(500, "error finding the flags that have flag %s as a prerequisite. reason: %s", f.Key, err)
+	}
+	if len(dependents) != 0 {
+		keys := make([]string, len(dependents))
+		for i, d := range dependents {
+			keys[i] = d.Key
+		}
+		return NewError(400, "cannot delete flag %s. it is a prerequisite of flags: %s", f.Key, strings.Join(keys, ", "))
+	}
+	return nil
+}
+
+// validateAudienceKey checks that no other audience than audienceID has the
+// key, so that a duplicate is a bad request rather than a unique index error
+var validateAudienceKey = func(key string, audienceID uint) *Error {
+	var cnt int64
+	err := getDB().Model(&entity.Audience{}).
+		Where(&entity.Audience{Key: key}).
+		Where("id <> ?", audienceID).
+		Count(&cnt).
+		Error
+	if err != nil {
+		return NewError(500, "error finding audience %s. reason %s", key, err)
+	}
+	if cnt != 0 {
+		return NewError(400, "audience %s already exists", key)
+	}
+	return nil
+}
+
+// validateConstraintLists checks that the lists referenced by the IN_LIST and
+// NOT_IN_LIST constraints exist
+var validateConstraintLists = func(cs ...entity.Constraint) *Error {
+	for _, c := range cs {
+		key, ok := c.ListKey()
+		if !ok {
+			continue
+		}
+		if err := getDB().Where(&entity.List{Key: key}).First(&entity.List{}).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return NewError(400, "list %s referenced by constraint %s %s not found", key, c.Property, c.Operator)
+			}
+			return NewError(500, "error finding list %s. reason %s", key, err)
+		}
+	}
+	return nil
+}
+
+// validateConstraintEntityType validates the constraint against the attribute
+// schema of the entity type of the flag, if the flag has an entity type
+var validateConstraintEntityType = func(flagID int64, c entity.Constraint) *Error {
+	f := &entity.Flag{}
+	if err := getDB().Select("id", "entity_type").First(f, flagID).Error; err != nil {
+		return NewError(404, "unable to find flag %v in the database. reason %s", flagID, err)
+	}
+	return validateEntityTypeConstraints(f.EntityType, c)
+}
+
+// validateSegmentAudiencesEntityType validates the constraints of the
+// audiences referenced by a segment of the flag against the attribute schema
+// of the entity type of the flag
+var validateSegmentAudiencesEntityType = func(flagID int64, as []entity.Audience) *Error {
+	f := &entity.Flag{}
+	if err := getDB().Select("id", "entity_type").First(f, flagID).Error; err != nil {
+		return NewError(404, "unable to find flag %v in the database. reason %s", flagID, err)
+	}
+	attributes, verr := findEntityAttributes(f.EntityType)
+	if verr != nil {
+		return verr
+	}
+	for _, a := range as {
+		for _, c := range a.Constraints {
+			if err := attributes.ValidateConstraint(c); err != nil {
+				return NewError(400, "constraint of audience %s doesn't conform to entity type %s. %s", a.Key, f.EntityType, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateAudienceEntityTypes validates the constraints of the audience
+// against the attribute schemas of the entity types of the flags referencing
+// it
+var validateAudienceEntityTypes = func(a *entity.Audience, cs ...entity.Constraint) *Error {
+	flagIDs, err := a.FlagIDs(getDB())
+	if err != nil {
+		return NewError(500, "error finding flags of audience %s. reason %s", a.Key, err)
+	}
+	if len(flagIDs) == 0 {
+		return nil
+	}
+
+	entityTypes := []string{}
+	err = getDB().Model(&entity.Flag{}).
+		Where("id IN ?", flagIDs).
+		Distinct().
+		Order("entity_type").
+		Pluck("entity_type", &entityTypes).
+		Error
+	if err != nil {
+		return NewError(500, "error finding entity types of flags %v. reason %s", flagIDs, err)
+	}
+	for _, et := range entityTypes {
+		if err := validateEntityTypeConstraints(et, cs...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validatePutFlagEntityType validates the constraints of the flag, including
+// the ones of its audiences, against the attribute schema of the entity type
+// the flag is moved to
+var validatePutFlagEntityType = func(f *entity.Flag, entityType string) *Error {
+	attributes, verr := findEntityAttributes(entityType)
+	if verr != nil || len(attributes) == 0 {
+		return verr
+	}
+
+	fs := []entity.Flag{}
+	err := getDB().
+		Preload("Segments.Constraints").
+		Preload("Segments.Audiences.Constraints").
+		Find(&fs, f.ID).
+		Error
+	if err != nil {
+		return NewError(500, "error finding flag %s. reason %s", f.Key, err)
+	}
+	if err := validateFlagsEntityAttributes(fs, attributes); err != nil {
+		return NewError(400, "constraints don't conform to entity type %s. %s", entityType, err)
+	}
+	return nil
+}
+
+// validateEntityTypeConstraints validates the constraints against the
+// attribute schema of the entity type, if the entity type has one
+func validateEntityTypeConstraints(entityType string, cs ...entity.Constraint) *Error {
+	attributes, err := findEntityAttributes(entityType)
+	if err != nil {
+		return err
+	}
+	for _, c := range cs {
+		if err := attributes.ValidateConstraint(c); err != nil {
+			return NewError(400, "constraint doesn't conform to entity type %s. %s", entityType, err)
+		}
+	}
+	return nil
+}
+
+// findEntityAttributes finds the attribute schema of the entity type, nil if
+// the entity type is empty or doesn't exist
+func findEntityAttributes(entityType string) (entity.EntityAttributes, *Error) {
+	if entityType == "" {
+		return nil, nil
+	}
+
+	et := &entity.FlagEntityType{}
+	if err := getDB().Where(&entity.FlagEntityType{Key: entityType}).First(et).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, NewError(500, "error finding entity type %s. reason %s", entityType, err)
+	}
+	return et.Attributes, nil
+}
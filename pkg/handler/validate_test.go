@@ -37,7 +37,7 @@ func TestValidatePutDistributions(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -46,6 +46,12 @@ func TestValidatePutDistributions(t *testing.T) {
 			Key: new("control"),
 		},
 	})
+	c.CreateVariant(variant.CreateVariantParams{
+		FlagID: int64(1),
+		Body: &models.CreateVariantRequest{
+			Key: new("treatment"),
+		},
+	})
 
 	t.Run("happy code path", func(t *testing.T) {
 		param := distribution.PutDistributionsParams{
@@ -54,7 +60,7 @@ func TestValidatePutDistributions(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    new(int64(100)),
+						Percent:    new(float64(100)),
 						VariantID:  new(int64(1)),
 						VariantKey: new("control"),
 					},
@@ -65,6 +71,35 @@ func TestValidatePutDistributions(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("fractional percents", func(t *testing.T) {
+		genParam := func(percents ...float64) distribution.PutDistributionsParams {
+			ds := []*models.Distribution{}
+			for i, p := range percents {
+				ds = append(ds, &models.Distribution{
+					Percent:    new(p),
+					VariantID:  new(int64(i + 1)),
+					VariantKey: new([]string{"control", "treatment"}[i]),
+				})
+			}
+			return distribution.PutDistributionsParams{
+				FlagID:    int64(1),
+				SegmentID: int64(1),
+				Body:      &models.PutDistributionsRequest{Distributions: ds},
+			}
+		}
+
+		assert.Nil(t, validatePutDistributions(genParam(99.5, 0.5)))
+		assert.Nil(t, validatePutDistributions(genParam(66.7, 33.3)))
+
+		err := validatePutDistributions(genParam(99.45, 0.55))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "is not a multiple of 0.1")
+
+		err = validatePutDistributions(genParam(66.6, 33.3))
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "the sum of distributions' percent 99.9 is not 100")
+	})
+
 	t.Run("percent is nil", func(t *testing.T) {
 		param := distribution.PutDistributionsParams{
 			FlagID:    int64(1),
@@ -90,7 +125,7 @@ func TestValidatePutDistributions(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    new(int64(100)),
+						Percent:    new(float64(100)),
 						VariantID:  new(int64(1)),
 						VariantKey: new("control"),
 					},
@@ -108,7 +143,7 @@ func TestValidatePutDistributions(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    new(int64(100)),
+						Percent:    new(float64(100)),
 						VariantID:  new(int64(999999)),
 						VariantKey: new("control"),
 					},
@@ -126,7 +161,7 @@ func TestValidatePutDistributions(t *testing.T) {
 			Body: &models.PutDistributionsRequest{
 				Distributions: []*models.Distribution{
 					{
-						Percent:    new(int64(100)),
+						Percent:    new(float64(100)),
 						VariantID:  new(int64(1)),
 						VariantKey: new("treatment"),
 					},
@@ -159,7 +194,7 @@ func TestValidateDeleteVariant(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -180,12 +215,12 @@ func TestValidateDeleteVariant(t *testing.T) {
 		Body: &models.PutDistributionsRequest{
 			Distributions: []*models.Distribution{
 				{
-					Percent:    new(int64(100)),
+					Percent:    new(float64(100)),
 					VariantID:  new(int64(1)),
 					VariantKey: new("control"),
 				},
 				{
-					Percent:    new(int64(0)),
+					Percent:    new(float64(0)),
 					VariantID:  new(int64(2)),
 					VariantKey: new("treatment"),
 				},
@@ -233,7 +268,7 @@ func TestValidatePutVariantForDistributions(t *testing.T) {
 		FlagID: int64(1),
 		Body: &models.CreateSegmentRequest{
 			Description:    new("segment1"),
-			RolloutPercent: new(int64(100)),
+			RolloutPercent: new(float64(100)),
 		},
 	})
 	c.CreateVariant(variant.CreateVariantParams{
@@ -254,12 +289,12 @@ func TestValidatePutVariantForDistributions(t *testing.T) {
 		Body: &models.PutDistributionsRequest{
 			Distributions: []*models.Distribution{
 				{
-					Percent:    new(int64(100)),
+					Percent:    new(float64(100)),
 					VariantID:  new(int64(1)),
 					VariantKey: new("control"),
 				},
 				{
-					Percent:    new(int64(0)),
+					Percent:    new(float64(0)),
 					VariantID:  new(int64(2)),
 					VariantKey: new("treatment"),
 				},
@@ -19,6 +19,8 @@ func MapFlag(e *entity.Flag) (*models.Flag, error) {
 	r.CreatedBy = e.CreatedBy
 	r.DataRecordsEnabled = new(e.DataRecordsEnabled)
 	r.EntityType = e.EntityType
+	r.BucketBy = e.BucketBy
+	r.Salt = e.Salt
 	r.Description = new(e.Description)
 	r.Notes = e.Notes
 	r.Enabled = new(e.Enabled)
@@ -27,6 +29,20 @@ func MapFlag(e *entity.Flag) (*models.Flag, error) {
 	r.Segments = MapSegments(e.Segments)
 	r.Variants = MapVariants(e.Variants)
 	r.Tags = MapTags(e.Tags)
+	r.Prerequisites = MapFlagPrerequisites(e.Prerequisites)
+	r.Targets = MapFlagTargets(e.Targets)
+	r.ActivationWindow = MapActivationWindow(e.ActivationWindow)
+	r.Layer = MapFlagLayer(e)
+	r.Holdout = MapFlagHoldout(e)
+	r.DefaultVariantID = int64(e.DefaultVariantID)
+	for _, v := range e.Variants {
+		if v.ID == e.DefaultVariantID {
+			r.DefaultVariantKey = v.Key
+		}
+	}
+	if len(e.AttachmentSchema) != 0 {
+		r.AttachmentSchema = map[string]any(e.AttachmentSchema)
+	}
 
 	return r, nil
 }
@@ -82,9 +98,11 @@ func MapSegment(e *entity.Segment) *models.Segment {
 	r.ID = int64(e.ID)
 	r.Description = new(e.Description)
 	r.Rank = new(int64(e.Rank))
-	r.RolloutPercent = new(int64(e.RolloutPercent))
+	r.RolloutPercent = new(e.RolloutPercent)
 	r.Constraints = MapConstraints(e.Constraints)
 	r.Distributions = MapDistributions(e.Distributions)
+	r.Audiences = MapAudiences(e.Audiences)
+	r.ActivationWindow = MapActivationWindow(e.ActivationWindow)
 	return r
 }
 
@@ -114,6 +132,199 @@ func MapTags(e []entity.Tag) []*models.Tag {
 	return ret
 }
 
+// MapFlagPrerequisite maps flag prerequisite
+func MapFlagPrerequisite(e *entity.FlagPrerequisite) *models.FlagPrerequisite {
+	r := &models.FlagPrerequisite{}
+	r.FlagKey = new(e.FlagKey)
+	r.VariantKeys = e.VariantKeys
+	return r
+}
+
+// MapFlagPrerequisites maps flag prerequisites
+func MapFlagPrerequisites(e []entity.FlagPrerequisite) []*models.FlagPrerequisite {
+	ret := make([]*models.FlagPrerequisite, len(e))
+	for i, p := range e {
+		ret[i] = MapFlagPrerequisite(&p)
+	}
+	return ret
+}
+
+// MapFlagTarget maps flag target
+func MapFlagTarget(e *entity.FlagTarget) *models.Target {
+	r := &models.Target{}
+	r.ID = int64(e.ID)
+	r.EntityID = new(e.EntityID)
+	r.VariantID = int64(e.VariantID)
+	r.VariantKey = new(e.VariantKey)
+	return r
+}
+
+// MapFlagTargets maps flag targets
+func MapFlagTargets(e []entity.FlagTarget) []*models.Target {
+	ret := make([]*models.Target, len(e))
+	for i, t := range e {
+		ret[i] = MapFlagTarget(&t)
+	}
+	return ret
+}
+
+// MapActivationWindow maps activation window
+func MapActivationWindow(e *entity.ActivationWindow) *models.ActivationWindow {
+	if e == nil {
+		return nil
+	}
+	r := &models.ActivationWindow{}
+	if e.StartAt != nil {
+		r.StartAt = new(strfmt.DateTime(*e.StartAt))
+	}
+	if e.EndAt != nil {
+		r.EndAt = new(strfmt.DateTime(*e.EndAt))
+	}
+	r.Weekdays = e.Weekdays
+	r.StartTime = e.StartTime
+	r.EndTime = e.EndTime
+	r.Timezone = e.Timezone
+	return r
+}
+
+// MapFlagLayer maps the slot range of the layer owned by the flag
+func MapFlagLayer(e *entity.Flag) *models.FlagLayer {
+	if e.LayerID == nil {
+		return nil
+	}
+	r := &models.FlagLayer{}
+	r.LayerID = new(int64(*e.LayerID))
+	if e.Layer != nil {
+		r.LayerKey = e.Layer.Key
+	}
+	r.SlotStart = new(int64(e.LayerSlotStart))
+	r.SlotEnd = new(int64(e.LayerSlotEnd))
+	return r
+}
+
+// MapLayer maps layer
+func MapLayer(e *entity.Layer, allocations []entity.LayerAllocation) *models.Layer {
+	r := &models.Layer{}
+	r.ID = int64(e.ID)
+	r.Key = new(e.Key)
+	r.Description = e.Description
+	r.Slots = new(int64(e.Slots))
+	r.Allocations = MapLayerAllocations(allocations)
+	return r
+}
+
+// MapLayerAllocations maps layer allocations
+func MapLayerAllocations(e []entity.LayerAllocation) []*models.LayerAllocation {
+	ret := make([]*models.LayerAllocation, len(e))
+	for i, a := range e {
+		ret[i] = &models.LayerAllocation{
+			FlagID:    int64(a.FlagID),
+			FlagKey:   a.FlagKey,
+			SlotStart: int64(a.SlotStart),
+			SlotEnd:   int64(a.SlotEnd),
+		}
+	}
+	return ret
+}
+
+// MapFlagHoldout maps the holdout the flag is in
+func MapFlagHoldout(e *entity.Flag) *models.FlagHoldout {
+	if e.HoldoutID == nil {
+		return nil
+	}
+	r := &models.FlagHoldout{}
+	r.HoldoutID = new(int64(*e.HoldoutID))
+	if e.Holdout != nil {
+		r.HoldoutKey = e.Holdout.Key
+	}
+	r.VariantID = new(int64(e.HoldoutVariantID))
+	for _, v := range e.Variants {
+		if v.ID == e.HoldoutVariantID {
+			r.VariantKey = v.Key
+		}
+	}
+	return r
+}
+
+// MapHoldout maps holdout
+func MapHoldout(e *entity.Holdout) *models.Holdout {
+	r := &models.Holdout{}
+	r.ID = int64(e.ID)
+	r.Key = new(e.Key)
+	r.Description = e.Description
+	r.Percent = new(e.Percent)
+	return r
+}
+
+// MapHoldouts maps holdouts
+func MapHoldouts(e []entity.Holdout) []*models.Holdout {
+	ret := make([]*models.Holdout, len(e))
+	for i, h := range e {
+		ret[i] = MapHoldout(&h)
+	}
+	return ret
+}
+
+// MapList maps list
+func MapList(e *entity.List) *models.List {
+	r := &models.List{}
+	r.ID = int64(e.ID)
+	r.Key = new(e.Key)
+	r.Description = e.Description
+	r.Version = int64(e.Version)
+	r.Size = int64(e.Size)
+	r.UpdatedAt = strfmt.DateTime(e.UpdatedAt)
+	return r
+}
+
+// MapLists maps lists
+func MapLists(e []entity.List) []*models.List {
+	ret := make([]*models.List, len(e))
+	for i, l := range e {
+		ret[i] = MapList(&l)
+	}
+	return ret
+}
+
+// MapEntityTypeSchema maps the attribute schema of an entity type
+func MapEntityTypeSchema(e *entity.FlagEntityType) *models.EntityTypeSchema {
+	r := &models.EntityTypeSchema{}
+	r.EntityType = new(e.Key)
+	r.Attributes = make([]*models.EntityAttribute, len(e.Attributes))
+	for i, a := range e.Attributes {
+		r.Attributes[i] = &models.EntityAttribute{
+			Name:          new(a.Name),
+			Type:          a.Type,
+			Required:      a.Required,
+			AllowedValues: a.AllowedValues,
+		}
+	}
+	return r
+}
+
+// MapRolloutSchedule maps rollout schedule
+func MapRolloutSchedule(e *entity.RolloutSchedule) *models.RolloutSchedule {
+	r := &models.RolloutSchedule{}
+	r.ID = int64(e.ID)
+	r.SegmentID = int64(e.SegmentID)
+	r.Linear = new(e.Linear)
+	r.Status = new(e.Status)
+	if e.AppliedPercent != nil {
+		r.AppliedPercent = new(*e.AppliedPercent)
+	}
+	r.CreatedBy = e.CreatedBy
+	r.UpdatedBy = e.UpdatedBy
+	r.UpdatedAt = strfmt.DateTime(e.UpdatedAt)
+	r.Steps = make([]*models.RolloutScheduleStep, len(e.Steps))
+	for i, step := range e.Steps {
+		r.Steps[i] = &models.RolloutScheduleStep{
+			At:      new(strfmt.DateTime(step.At)),
+			Percent: new(step.Percent),
+		}
+	}
+	return r
+}
+
 // MapConstraint maps constraint
 func MapConstraint(e *entity.Constraint) *models.Constraint {
 	r := &models.Constraint{}
@@ -121,6 +332,7 @@ func MapConstraint(e *entity.Constraint) *models.Constraint {
 	r.Property = new(e.Property)
 	r.Operator = new(e.Operator)
 	r.Value = new(e.Value)
+	r.Group = int64(e.Group)
 	return r
 }
 
@@ -133,11 +345,30 @@ func MapConstraints(e []entity.Constraint) []*models.Constraint {
 	return ret
 }
 
+// MapAudience maps audience
+func MapAudience(e *entity.Audience) *models.Audience {
+	r := &models.Audience{}
+	r.ID = int64(e.ID)
+	r.Key = new(e.Key)
+	r.Description = e.Description
+	r.Constraints = MapConstraints(e.Constraints)
+	return r
+}
+
+// MapAudiences maps audiences
+func MapAudiences(e []entity.Audience) []*models.Audience {
+	ret := make([]*models.Audience, len(e))
+	for i, a := range e {
+		ret[i] = MapAudience(&a)
+	}
+	return ret
+}
+
 // MapDistribution maps to a distribution
 func MapDistribution(e *entity.Distribution) *models.Distribution {
 	r := &models.Distribution{
 		ID:         int64(e.ID),
-		Percent:    new(int64(e.Percent)),
+		Percent:    new(e.Percent),
 		VariantID:  new(int64(e.VariantID)),
 		VariantKey: new(e.VariantKey),
 	}
@@ -2,6 +2,7 @@ package r2e
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/davecgh/go-spew/spew"
 	"github.com/foxdalas/flagr/pkg/entity"
@@ -24,7 +25,94 @@ func MapDistribution(r *models.Distribution, segmentID uint) entity.Distribution
 		SegmentID:  segmentID,
 		VariantID:  uint(*r.VariantID),
 		VariantKey: util.SafeString(r.VariantKey),
-		Percent:    uint(*r.Percent),
+		Percent:    *r.Percent,
+	}
+	return e
+}
+
+// MapAudienceConstraints maps the constraints of an audience
+func MapAudienceConstraints(r []*models.CreateConstraintRequest, audienceID uint) entity.ConstraintArray {
+	e := make(entity.ConstraintArray, len(r))
+	for i, c := range r {
+		e[i] = entity.Constraint{
+			AudienceID: audienceID,
+			Property:   util.SafeString(c.Property),
+			Operator:   util.SafeString(c.Operator),
+			Value:      util.SafeString(c.Value),
+			Group:      util.SafeUint(c.Group),
+		}
+	}
+	return e
+}
+
+// MapFlagPrerequisites maps the prerequisites of a flag
+func MapFlagPrerequisites(r []*models.FlagPrerequisite, flagID uint) []entity.FlagPrerequisite {
+	e := make([]entity.FlagPrerequisite, len(r))
+	for i, p := range r {
+		e[i] = entity.FlagPrerequisite{
+			FlagID:      flagID,
+			FlagKey:     util.SafeString(p.FlagKey),
+			VariantKeys: p.VariantKeys,
+		}
+	}
+	return e
+}
+
+// MapFlagTargets maps the targets of a flag. The variant IDs are resolved from
+// the variant keys by the validation.
+func MapFlagTargets(r []*models.Target, flagID uint) []entity.FlagTarget {
+	e := make([]entity.FlagTarget, len(r))
+	for i, t := range r {
+		e[i] = entity.FlagTarget{
+			FlagID:     flagID,
+			EntityID:   util.SafeString(t.EntityID),
+			VariantKey: util.SafeString(t.VariantKey),
+		}
+	}
+	return e
+}
+
+// MapRolloutSteps maps the steps of a rollout schedule
+func MapRolloutSteps(r []*models.RolloutScheduleStep) entity.RolloutSteps {
+	e := make(entity.RolloutSteps, len(r))
+	for i, step := range r {
+		if step.At != nil {
+			e[i].At = time.Time(*step.At)
+		}
+		if step.Percent != nil {
+			e[i].Percent = *step.Percent
+		}
+	}
+	return e
+}
+
+// MapEntityAttributes maps the attributes of an entity type
+func MapEntityAttributes(r []*models.EntityAttribute) entity.EntityAttributes {
+	e := make(entity.EntityAttributes, len(r))
+	for i, a := range r {
+		e[i] = entity.EntityAttribute{
+			Name:          util.SafeString(a.Name),
+			Type:          a.Type,
+			Required:      a.Required,
+			AllowedValues: a.AllowedValues,
+		}
+	}
+	return e
+}
+
+// MapActivationWindow maps activation window
+func MapActivationWindow(r *models.ActivationWindow) *entity.ActivationWindow {
+	e := &entity.ActivationWindow{
+		Weekdays:  r.Weekdays,
+		StartTime: r.StartTime,
+		EndTime:   r.EndTime,
+		Timezone:  r.Timezone,
+	}
+	if r.StartAt != nil {
+		e.StartAt = new(time.Time(*r.StartAt))
+	}
+	if r.EndAt != nil {
+		e.EndAt = new(time.Time(*r.EndAt))
 	}
 	return e
 }
@@ -42,3 +130,18 @@ func MapAttachment(a any) (entity.Attachment, error) {
 	}
 	return e, nil
 }
+
+// MapAttachmentSchema maps attachment schema
+func MapAttachmentSchema(a any) (entity.AttachmentSchema, error) {
+	if a == nil {
+		return nil, nil
+	}
+	m, ok := a.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("make sure JSON is properly formatted into key/value pairs. Invalid attachment schema format %s", spew.Sdump(a))
+	}
+	if len(m) == 0 {
+		return nil, nil
+	}
+	return m, nil
+}
@@ -32,6 +32,12 @@ const (
 	ComponentConstraint   ComponentType = "constraint"
 	ComponentDistribution ComponentType = "distribution"
 	ComponentTag          ComponentType = "tag"
+	ComponentAudience     ComponentType = "audience"
+	ComponentLayer        ComponentType = "layer"
+	ComponentHoldout      ComponentType = "holdout"
+	ComponentTarget       ComponentType = "target"
+	ComponentList         ComponentType = "list"
+	ComponentEntityType   ComponentType = "entity_type"
 )
 
 type Notification struct {
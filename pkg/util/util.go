@@ -88,6 +88,11 @@ func SafeUint(s any) (ret uint) {
 	return cast.ToUint(s)
 }
 
+// SafeFloat64 returns the float64 of the value
+func SafeFloat64(s any) (ret float64) {
+	return cast.ToFloat64(s)
+}
+
 // Round makes the float to int conversion with rounding
 func Round(f float64) int {
 	return int(f + math.Copysign(0.5, f))
@@ -29,6 +29,14 @@ func TestSafeStringWithDefault(t *testing.T) {
 	assert.Equal(t, SafeStringWithDefault(nil, "<nil>"), "<nil>")
 }
 
+func TestSafeFloat64(t *testing.T) {
+	assert.Equal(t, SafeFloat64(nil), float64(0))
+	assert.Equal(t, SafeFloat64((*float64)(nil)), float64(0))
+	assert.Equal(t, SafeFloat64("0.5"), 0.5)
+	assert.Equal(t, SafeFloat64(new(33.3)), 33.3)
+	assert.Equal(t, SafeFloat64(new(int64(100))), float64(100))
+}
+
 func TestSafeUint(t *testing.T) {
 	assert.Equal(t, SafeUint(nil), uint(0))
 	assert.Equal(t, SafeUint("123"), uint(123))
@@ -18,6 +18,11 @@ import (
 // swagger:model constraint
 type Constraint struct {
 
+	// The constraint group within the segment. Constraints of the same group are joined by AND, and the groups are joined by OR. Defaults to 0.
+	//
+	// Minimum: 0
+	Group int64 `json:"group,omitempty"`
+
 	// id
 	// Read Only: true
 	// Minimum: 1
@@ -26,7 +31,7 @@ type Constraint struct {
 	// operator
 	// Required: true
 	// Min Length: 1
-	// Enum: ["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS"]
+	// Enum: ["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS","SEMVER_EQ","SEMVER_LT","SEMVER_LTE","SEMVER_GT","SEMVER_GTE","BEFORE","AFTER","IN_LIST","NOT_IN_LIST","IN_CIDR","NOT_IN_CIDR","STARTS_WITH","ENDS_WITH","EQ_IGNORE_CASE","IN_IGNORE_CASE","CONTAINS_IGNORE_CASE"]
 	Operator *string `json:"operator"`
 
 	// The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., `user.name`) for nested objects and brackets (e.g., `users[0]`) for array indices.
@@ -45,6 +50,10 @@ type Constraint struct {
 func (m *Constraint) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateGroup(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -67,6 +76,18 @@ func (m *Constraint) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Constraint) validateGroup(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Group) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("group", "body", m.Group, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Constraint) validateID(formats strfmt.Registry) error {
 	if typeutils.IsZero(m.ID) { // not required
 		return nil
@@ -83,7 +104,7 @@ var constraintTypeOperatorPropEnum []any
 
 func init() {
 	var res []string
-	if err := json.Unmarshal([]byte(`["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS"]`), &res); err != nil {
+	if err := json.Unmarshal([]byte(`["EQ","NEQ","LT","LTE","GT","GTE","EREG","NEREG","IN","NOTIN","CONTAINS","NOTCONTAINS","SEMVER_EQ","SEMVER_LT","SEMVER_LTE","SEMVER_GT","SEMVER_GTE","BEFORE","AFTER","IN_LIST","NOT_IN_LIST","IN_CIDR","NOT_IN_CIDR","STARTS_WITH","ENDS_WITH","EQ_IGNORE_CASE","IN_IGNORE_CASE","CONTAINS_IGNORE_CASE"]`), &res); err != nil {
 		panic(err)
 	}
 	for _, v := range res {
@@ -128,6 +149,54 @@ const (
 
 	// ConstraintOperatorNOTCONTAINS captures enum value "NOTCONTAINS"
 	ConstraintOperatorNOTCONTAINS string = "NOTCONTAINS"
+
+	// ConstraintOperatorSEMVEREQ captures enum value "SEMVER_EQ"
+	ConstraintOperatorSEMVEREQ string = "SEMVER_EQ"
+
+	// ConstraintOperatorSEMVERLT captures enum value "SEMVER_LT"
+	ConstraintOperatorSEMVERLT string = "SEMVER_LT"
+
+	// ConstraintOperatorSEMVERLTE captures enum value "SEMVER_LTE"
+	ConstraintOperatorSEMVERLTE string = "SEMVER_LTE"
+
+	// ConstraintOperatorSEMVERGT captures enum value "SEMVER_GT"
+	ConstraintOperatorSEMVERGT string = "SEMVER_GT"
+
+	// ConstraintOperatorSEMVERGTE captures enum value "SEMVER_GTE"
+	ConstraintOperatorSEMVERGTE string = "SEMVER_GTE"
+
+	// ConstraintOperatorBEFORE captures enum value "BEFORE"
+	ConstraintOperatorBEFORE string = "BEFORE"
+
+	// ConstraintOperatorAFTER captures enum value "AFTER"
+	ConstraintOperatorAFTER string = "AFTER"
+
+	// ConstraintOperatorINLIST captures enum value "IN_LIST"
+	ConstraintOperatorINLIST string = "IN_LIST"
+
+	// ConstraintOperatorNOTINLIST captures enum value "NOT_IN_LIST"
+	ConstraintOperatorNOTINLIST string = "NOT_IN_LIST"
+
+	// ConstraintOperatorINCIDR captures enum value "IN_CIDR"
+	ConstraintOperatorINCIDR string = "IN_CIDR"
+
+	// ConstraintOperatorNOTINCIDR captures enum value "NOT_IN_CIDR"
+	ConstraintOperatorNOTINCIDR string = "NOT_IN_CIDR"
+
+	// ConstraintOperatorSTARTSWITH captures enum value "STARTS_WITH"
+	ConstraintOperatorSTARTSWITH string = "STARTS_WITH"
+
+	// ConstraintOperatorENDSWITH captures enum value "ENDS_WITH"
+	ConstraintOperatorENDSWITH string = "ENDS_WITH"
+
+	// ConstraintOperatorEQIGNORECASE captures enum value "EQ_IGNORE_CASE"
+	ConstraintOperatorEQIGNORECASE string = "EQ_IGNORE_CASE"
+
+	// ConstraintOperatorINIGNORECASE captures enum value "IN_IGNORE_CASE"
+	ConstraintOperatorINIGNORECASE string = "IN_IGNORE_CASE"
+
+	// ConstraintOperatorCONTAINSIGNORECASE captures enum value "CONTAINS_IGNORE_CASE"
+	ConstraintOperatorCONTAINSIGNORECASE string = "CONTAINS_IGNORE_CASE"
 )
 
 // prop value enum
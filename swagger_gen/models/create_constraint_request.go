@@ -8,6 +8,7 @@ import (
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag/jsonutils"
+	"github.com/go-openapi/swag/typeutils"
 	"github.com/go-openapi/validate"
 )
 
@@ -16,6 +17,11 @@ import (
 // swagger:model createConstraintRequest
 type CreateConstraintRequest struct {
 
+	// The constraint group within the segment. Constraints of the same group are joined by AND, and the groups are joined by OR. Defaults to 0.
+	//
+	// Minimum: 0
+	Group int64 `json:"group,omitempty"`
+
 	// operator
 	// Required: true
 	// Min Length: 1
@@ -37,6 +43,10 @@ type CreateConstraintRequest struct {
 func (m *CreateConstraintRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateGroup(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateOperator(formats); err != nil {
 		res = append(res, err)
 	}
@@ -55,6 +65,18 @@ func (m *CreateConstraintRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *CreateConstraintRequest) validateGroup(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Group) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("group", "body", m.Group, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *CreateConstraintRequest) validateOperator(formats strfmt.Registry) error {
 
 	if err := validate.Required("operator", "body", m.Operator); err != nil {
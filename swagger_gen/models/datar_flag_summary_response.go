@@ -24,6 +24,9 @@ type DatarFlagSummaryResponse struct {
 	// traffic by day
 	TrafficByDay []*DatarDayEntry `json:"trafficByDay"`
 
+	// traffic by holdout
+	TrafficByHoldout []*DatarHoldoutEntry `json:"trafficByHoldout"`
+
 	// traffic by segment
 	TrafficBySegment []*DatarSegmentEntry `json:"trafficBySegment"`
 
@@ -39,6 +42,10 @@ func (m *DatarFlagSummaryResponse) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTrafficByHoldout(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateTrafficBySegment(formats); err != nil {
 		res = append(res, err)
 	}
@@ -83,6 +90,36 @@ func (m *DatarFlagSummaryResponse) validateTrafficByDay(formats strfmt.Registry)
 	return nil
 }
 
+func (m *DatarFlagSummaryResponse) validateTrafficByHoldout(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.TrafficByHoldout) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.TrafficByHoldout); i++ {
+		if typeutils.IsZero(m.TrafficByHoldout[i]) { // not required
+			continue
+		}
+
+		if m.TrafficByHoldout[i] != nil {
+			if err := m.TrafficByHoldout[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("trafficByHoldout" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("trafficByHoldout" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *DatarFlagSummaryResponse) validateTrafficBySegment(formats strfmt.Registry) error {
 	if typeutils.IsZero(m.TrafficBySegment) { // not required
 		return nil
@@ -151,6 +188,10 @@ func (m *DatarFlagSummaryResponse) ContextValidate(ctx context.Context, formats
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTrafficByHoldout(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateTrafficBySegment(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -194,6 +235,35 @@ func (m *DatarFlagSummaryResponse) contextValidateTrafficByDay(ctx context.Conte
 	return nil
 }
 
+func (m *DatarFlagSummaryResponse) contextValidateTrafficByHoldout(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.TrafficByHoldout); i++ {
+
+		if m.TrafficByHoldout[i] != nil {
+
+			if typeutils.IsZero(m.TrafficByHoldout[i]) { // not required
+				return nil
+			}
+
+			if err := m.TrafficByHoldout[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("trafficByHoldout" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("trafficByHoldout" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *DatarFlagSummaryResponse) contextValidateTrafficBySegment(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.TrafficBySegment); i++ {
@@ -22,11 +22,11 @@ type Distribution struct {
 	// Minimum: 1
 	ID int64 `json:"id,omitempty"`
 
-	// percent
+	// percent of the rolled out entities given the variant, in steps of 0.1
 	// Required: true
 	// Maximum: 100
 	// Minimum: 0
-	Percent *int64 `json:"percent"`
+	Percent *float64 `json:"percent"`
 
 	// variant ID
 	// Required: true
@@ -83,11 +83,11 @@ func (m *Distribution) validatePercent(formats strfmt.Registry) error {
 		return err
 	}
 
-	if err := validate.MinimumInt("percent", "body", *m.Percent, 0, false); err != nil {
+	if err := validate.Minimum("percent", "body", *m.Percent, 0, false); err != nil {
 		return err
 	}
 
-	if err := validate.MaximumInt("percent", "body", *m.Percent, 100, false); err != nil {
+	if err := validate.Maximum("percent", "body", *m.Percent, 100, false); err != nil {
 		return err
 	}
 
@@ -17,9 +17,15 @@ import (
 // swagger:model evalResult
 type EvalResult struct {
 
+	// the value the entity is bucketed by. It's the value of the flag's bucketBy attribute, or the entityID if the flag has no bucketBy or the attribute is missing from the entityContext.
+	BucketKey string `json:"bucketKey,omitempty"`
+
 	// flag's data records status.
 	DataRecordsEnabled bool `json:"dataRecordsEnabled"`
 
+	// the variant is the default variant of the flag, because the flag is disabled or not active, or no segment matched.
+	DefaultVariant bool `json:"defaultVariant,omitempty"`
+
 	// eval context
 	EvalContext *EvalContext `json:"evalContext,omitempty"`
 
@@ -38,6 +44,15 @@ type EvalResult struct {
 	// flagTags. flagTags looks up flags by tag. Either works.
 	FlagTags []string `json:"flagTags,omitempty"`
 
+	// the holdout the entity is in. The entity got the holdout variant of the flag instead of being evaluated by its segments.
+	HoldoutID int64 `json:"holdoutID,omitempty"`
+
+	// holdout key
+	HoldoutKey string `json:"holdoutKey,omitempty"`
+
+	// the entity is individually targeted to the variant, the segments of the flag were not evaluated.
+	IndividualTarget bool `json:"individualTarget,omitempty"`
+
 	// segment ID
 	SegmentID int64 `json:"segmentID,omitempty"`
 
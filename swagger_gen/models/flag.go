@@ -19,6 +19,15 @@ import (
 // swagger:model flag
 type Flag struct {
 
+	// activation window
+	ActivationWindow *ActivationWindow `json:"activationWindow,omitempty"`
+
+	// JSON Schema (draft 4) that the attachments of the variants must conform to. Empty if the attachments are not validated.
+	AttachmentSchema any `json:"attachmentSchema,omitempty"`
+
+	// entityContext attribute, e.g. company_id, that is hashed instead of the entityID to bucket the entity. The entityID is used if it's empty or the attribute is missing.
+	BucketBy string `json:"bucketBy,omitempty"`
+
 	// created by
 	CreatedBy string `json:"createdBy,omitempty"`
 
@@ -26,6 +35,14 @@ type Flag struct {
 	// Required: true
 	DataRecordsEnabled *bool `json:"dataRecordsEnabled"`
 
+	// variant returned when the flag is disabled or not active, or no segment matched. 0 if the flag has no default variant.
+	// Minimum: 0
+	DefaultVariantID int64 `json:"defaultVariantID,omitempty"`
+
+	// default variant key
+	// Read Only: true
+	DefaultVariantKey string `json:"defaultVariantKey,omitempty"`
+
 	// description
 	// Required: true
 	// Min Length: 1
@@ -38,6 +55,9 @@ type Flag struct {
 	// it will override the entityType in the evaluation logs if it's not empty
 	EntityType string `json:"entityType,omitempty"`
 
+	// holdout
+	Holdout *FlagHoldout `json:"holdout,omitempty"`
+
 	// id
 	// Read Only: true
 	// Minimum: 1
@@ -47,15 +67,28 @@ type Flag struct {
 	// Min Length: 1
 	Key string `json:"key,omitempty"`
 
+	// layer
+	Layer *FlagLayer `json:"layer,omitempty"`
+
 	// flag usage details in markdown format
 	Notes string `json:"notes,omitempty"`
 
+	// prerequisites
+	Prerequisites []*FlagPrerequisite `json:"prerequisites"`
+
+	// salt of the hash that buckets the entities. The flag ID is used if it's empty. Changing it re-randomizes which entities get which variant.
+	// Max Length: 64
+	Salt string `json:"salt,omitempty"`
+
 	// segments
 	Segments []*Segment `json:"segments"`
 
 	// tags
 	Tags []*Tag `json:"tags"`
 
+	// targets
+	Targets []*Target `json:"targets"`
+
 	// updated at
 	// Format: date-time
 	UpdatedAt strfmt.DateTime `json:"updatedAt,omitempty"`
@@ -71,10 +104,18 @@ type Flag struct {
 func (m *Flag) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateActivationWindow(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDataRecordsEnabled(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateDefaultVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
@@ -83,6 +124,10 @@ func (m *Flag) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateHoldout(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateID(formats); err != nil {
 		res = append(res, err)
 	}
@@ -91,6 +136,18 @@ func (m *Flag) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateLayer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePrerequisites(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSalt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateSegments(formats); err != nil {
 		res = append(res, err)
 	}
@@ -99,6 +156,10 @@ func (m *Flag) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateTargets(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateUpdatedAt(formats); err != nil {
 		res = append(res, err)
 	}
@@ -113,6 +174,29 @@ func (m *Flag) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Flag) validateActivationWindow(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.ActivationWindow) { // not required
+		return nil
+	}
+
+	if m.ActivationWindow != nil {
+		if err := m.ActivationWindow.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("activationWindow")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("activationWindow")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Flag) validateDataRecordsEnabled(formats strfmt.Registry) error {
 
 	if err := validate.Required("dataRecordsEnabled", "body", m.DataRecordsEnabled); err != nil {
@@ -122,6 +206,18 @@ func (m *Flag) validateDataRecordsEnabled(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Flag) validateDefaultVariantID(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.DefaultVariantID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("defaultVariantID", "body", m.DefaultVariantID, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Flag) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -144,6 +240,29 @@ func (m *Flag) validateEnabled(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Flag) validateHoldout(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Holdout) { // not required
+		return nil
+	}
+
+	if m.Holdout != nil {
+		if err := m.Holdout.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("holdout")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("holdout")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Flag) validateID(formats strfmt.Registry) error {
 	if typeutils.IsZero(m.ID) { // not required
 		return nil
@@ -168,6 +287,71 @@ func (m *Flag) validateKey(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Flag) validateLayer(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Layer) { // not required
+		return nil
+	}
+
+	if m.Layer != nil {
+		if err := m.Layer.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("layer")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("layer")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Flag) validatePrerequisites(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Prerequisites) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Prerequisites); i++ {
+		if typeutils.IsZero(m.Prerequisites[i]) { // not required
+			continue
+		}
+
+		if m.Prerequisites[i] != nil {
+			if err := m.Prerequisites[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *Flag) validateSalt(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Salt) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("salt", "body", m.Salt, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *Flag) validateSegments(formats strfmt.Registry) error {
 	if typeutils.IsZero(m.Segments) { // not required
 		return nil
@@ -228,6 +412,36 @@ func (m *Flag) validateTags(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Flag) validateTargets(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Targets) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Targets); i++ {
+		if typeutils.IsZero(m.Targets[i]) { // not required
+			continue
+		}
+
+		if m.Targets[i] != nil {
+			if err := m.Targets[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("targets" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("targets" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Flag) validateUpdatedAt(formats strfmt.Registry) error {
 	if typeutils.IsZero(m.UpdatedAt) { // not required
 		return nil
@@ -274,10 +488,30 @@ func (m *Flag) validateVariants(formats strfmt.Registry) error {
 func (m *Flag) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateActivationWindow(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateDefaultVariantKey(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateHoldout(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateID(ctx, formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateLayer(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidatePrerequisites(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateSegments(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -286,6 +520,10 @@ func (m *Flag) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateTargets(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateVariants(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -296,6 +534,65 @@ func (m *Flag) ContextValidate(ctx context.Context, formats strfmt.Registry) err
 	return nil
 }
 
+func (m *Flag) contextValidateActivationWindow(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ActivationWindow != nil {
+
+		if typeutils.IsZero(m.ActivationWindow) { // not required
+			return nil
+		}
+
+		if err := m.ActivationWindow.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("activationWindow")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("activationWindow")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Flag) contextValidateDefaultVariantKey(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "defaultVariantKey", "body", m.DefaultVariantKey); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *Flag) contextValidateHoldout(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Holdout != nil {
+
+		if typeutils.IsZero(m.Holdout) { // not required
+			return nil
+		}
+
+		if err := m.Holdout.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("holdout")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("holdout")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *Flag) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "id", "body", m.ID); err != nil {
@@ -305,6 +602,60 @@ func (m *Flag) contextValidateID(ctx context.Context, formats strfmt.Registry) e
 	return nil
 }
 
+func (m *Flag) contextValidateLayer(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Layer != nil {
+
+		if typeutils.IsZero(m.Layer) { // not required
+			return nil
+		}
+
+		if err := m.Layer.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("layer")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("layer")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Flag) contextValidatePrerequisites(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Prerequisites); i++ {
+
+		if m.Prerequisites[i] != nil {
+
+			if typeutils.IsZero(m.Prerequisites[i]) { // not required
+				return nil
+			}
+
+			if err := m.Prerequisites[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("prerequisites" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Flag) contextValidateSegments(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Segments); i++ {
@@ -363,6 +714,35 @@ func (m *Flag) contextValidateTags(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Flag) contextValidateTargets(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Targets); i++ {
+
+		if m.Targets[i] != nil {
+
+			if typeutils.IsZero(m.Targets[i]) { // not required
+				return nil
+			}
+
+			if err := m.Targets[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("targets" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("targets" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Flag) contextValidateVariants(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Variants); i++ {
@@ -17,9 +17,19 @@ import (
 // swagger:model putFlagRequest
 type PutFlagRequest struct {
 
+	// JSON Schema (draft 4) that the attachments of the variants must conform to. The existing variants must conform to it as well. An empty object removes the schema.
+	AttachmentSchema any `json:"attachmentSchema,omitempty"`
+
+	// entityContext attribute that is hashed instead of the entityID, empty for the entityID
+	BucketBy *string `json:"bucketBy,omitempty"`
+
 	// enabled data records will get data logging in the metrics pipeline, for example, kafka.
 	DataRecordsEnabled *bool `json:"dataRecordsEnabled,omitempty"`
 
+	// variant returned when the flag is disabled or not active, or no segment matched. 0 removes the default variant.
+	// Minimum: 0
+	DefaultVariantID *int64 `json:"defaultVariantID,omitempty"`
+
 	// description
 	// Min Length: 1
 	Description *string `json:"description,omitempty"`
@@ -35,22 +45,46 @@ type PutFlagRequest struct {
 
 	// notes
 	Notes *string `json:"notes,omitempty"`
+
+	// salt of the hash that buckets the entities, empty for the flag ID. Set a new value to re-randomize the buckets, e.g. before re-running an experiment.
+	// Max Length: 64
+	Salt *string `json:"salt,omitempty"`
 }
 
 // Validate validates this put flag request
 func (m *PutFlagRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateDefaultVariantID(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateSalt(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *PutFlagRequest) validateDefaultVariantID(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.DefaultVariantID) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("defaultVariantID", "body", *m.DefaultVariantID, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *PutFlagRequest) validateDescription(formats strfmt.Registry) error {
 	if typeutils.IsZero(m.Description) { // not required
 		return nil
@@ -63,6 +97,18 @@ func (m *PutFlagRequest) validateDescription(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PutFlagRequest) validateSalt(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Salt) { // not required
+		return nil
+	}
+
+	if err := validate.MaxLength("salt", "body", *m.Salt, 64); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // ContextValidate validates this put flag request based on context it is used
 func (m *PutFlagRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
@@ -4,10 +4,12 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag/jsonutils"
+	"github.com/go-openapi/swag/typeutils"
 	"github.com/go-openapi/validate"
 )
 
@@ -16,22 +18,29 @@ import (
 // swagger:model putSegmentRequest
 type PutSegmentRequest struct {
 
+	// IDs of the audiences the entity has to match in addition to the constraints of the segment. The audiences are kept as is when it's not set, and removed when it's an empty array.
+	AudienceIDs []int64 `json:"audienceIDs"`
+
 	// description
 	// Required: true
 	// Min Length: 1
 	Description *string `json:"description"`
 
-	// rollout percent
+	// percent of the matching entities in the rollout, in steps of 0.1
 	// Required: true
 	// Maximum: 100
 	// Minimum: 0
-	RolloutPercent *int64 `json:"rolloutPercent"`
+	RolloutPercent *float64 `json:"rolloutPercent"`
 }
 
 // Validate validates this put segment request
 func (m *PutSegmentRequest) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateAudienceIDs(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateDescription(formats); err != nil {
 		res = append(res, err)
 	}
@@ -46,6 +55,22 @@ func (m *PutSegmentRequest) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *PutSegmentRequest) validateAudienceIDs(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.AudienceIDs) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.AudienceIDs); i++ {
+
+		if err := validate.MinimumInt("audienceIDs"+"."+strconv.Itoa(i), "body", m.AudienceIDs[i], 1, false); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
 func (m *PutSegmentRequest) validateDescription(formats strfmt.Registry) error {
 
 	if err := validate.Required("description", "body", m.Description); err != nil {
@@ -65,11 +90,11 @@ func (m *PutSegmentRequest) validateRolloutPercent(formats strfmt.Registry) erro
 		return err
 	}
 
-	if err := validate.MinimumInt("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
+	if err := validate.Minimum("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
 		return err
 	}
 
-	if err := validate.MaximumInt("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
+	if err := validate.Maximum("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
 		return err
 	}
 
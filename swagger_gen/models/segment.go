@@ -19,6 +19,12 @@ import (
 // swagger:model segment
 type Segment struct {
 
+	// activation window
+	ActivationWindow *ActivationWindow `json:"activationWindow,omitempty"`
+
+	// the audiences the entity has to match in addition to the constraints
+	Audiences []*Audience `json:"audiences"`
+
 	// constraints
 	Constraints []*Constraint `json:"constraints"`
 
@@ -40,17 +46,25 @@ type Segment struct {
 	// Minimum: 0
 	Rank *int64 `json:"rank"`
 
-	// rollout percent
+	// percent of the matching entities in the rollout, in steps of 0.1
 	// Required: true
 	// Maximum: 100
 	// Minimum: 0
-	RolloutPercent *int64 `json:"rolloutPercent"`
+	RolloutPercent *float64 `json:"rolloutPercent"`
 }
 
 // Validate validates this segment
 func (m *Segment) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateActivationWindow(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAudiences(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateConstraints(formats); err != nil {
 		res = append(res, err)
 	}
@@ -81,6 +95,59 @@ func (m *Segment) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Segment) validateActivationWindow(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.ActivationWindow) { // not required
+		return nil
+	}
+
+	if m.ActivationWindow != nil {
+		if err := m.ActivationWindow.Validate(formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("activationWindow")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("activationWindow")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Segment) validateAudiences(formats strfmt.Registry) error {
+	if typeutils.IsZero(m.Audiences) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Audiences); i++ {
+		if typeutils.IsZero(m.Audiences[i]) { // not required
+			continue
+		}
+
+		if m.Audiences[i] != nil {
+			if err := m.Audiences[i].Validate(formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("audiences" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("audiences" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Segment) validateConstraints(formats strfmt.Registry) error {
 	if typeutils.IsZero(m.Constraints) { // not required
 		return nil
@@ -185,11 +252,11 @@ func (m *Segment) validateRolloutPercent(formats strfmt.Registry) error {
 		return err
 	}
 
-	if err := validate.MinimumInt("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
+	if err := validate.Minimum("rolloutPercent", "body", *m.RolloutPercent, 0, false); err != nil {
 		return err
 	}
 
-	if err := validate.MaximumInt("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
+	if err := validate.Maximum("rolloutPercent", "body", *m.RolloutPercent, 100, false); err != nil {
 		return err
 	}
 
@@ -200,6 +267,14 @@ func (m *Segment) validateRolloutPercent(formats strfmt.Registry) error {
 func (m *Segment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateActivationWindow(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateAudiences(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateConstraints(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -218,6 +293,60 @@ func (m *Segment) ContextValidate(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
+func (m *Segment) contextValidateActivationWindow(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.ActivationWindow != nil {
+
+		if typeutils.IsZero(m.ActivationWindow) { // not required
+			return nil
+		}
+
+		if err := m.ActivationWindow.ContextValidate(ctx, formats); err != nil {
+			ve := new(errors.Validation)
+			if stderrors.As(err, &ve) {
+				return ve.ValidateName("activationWindow")
+			}
+			ce := new(errors.CompositeError)
+			if stderrors.As(err, &ce) {
+				return ce.ValidateName("activationWindow")
+			}
+
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *Segment) contextValidateAudiences(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Audiences); i++ {
+
+		if m.Audiences[i] != nil {
+
+			if typeutils.IsZero(m.Audiences[i]) { // not required
+				return nil
+			}
+
+			if err := m.Audiences[i].ContextValidate(ctx, formats); err != nil {
+				ve := new(errors.Validation)
+				if stderrors.As(err, &ve) {
+					return ve.ValidateName("audiences" + "." + strconv.Itoa(i))
+				}
+				ce := new(errors.CompositeError)
+				if stderrors.As(err, &ce) {
+					return ce.ValidateName("audiences" + "." + strconv.Itoa(i))
+				}
+
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Segment) contextValidateConstraints(ctx context.Context, formats strfmt.Registry) error {
 
 	for i := 0; i < len(m.Constraints); i++ {
@@ -65,6 +65,8 @@ func configureAPI(api *operations.FlagrAPI) http.Handler {
 		dec.UseNumber()
 		return dec.Decode(data)
 	})
+	api.CsvConsumer = runtime.CSVConsumer()
+	api.TxtConsumer = runtime.TextConsumer()
 
 	api.JSONProducer = runtime.ProducerFunc(func(writer io.Writer, data interface{}) error {
 		enc := json.NewEncoder(writer)
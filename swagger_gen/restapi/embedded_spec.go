@@ -32,6 +32,187 @@ func init() {
   },
   "basePath": "/api/v1",
   "paths": {
+    "/audiences": {
+      "get": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "findAudiences",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of audiences to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return audiences given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return audiences partially matching given key",
+            "name": "key_like",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list audiences ordered by audienceID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/audience"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "createAudience",
+        "parameters": [
+          {
+            "description": "create an audience",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createAudienceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "audience created",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/audiences/{audienceID}": {
+      "get": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "getAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the audience",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Updates the audience. Every flag with a segment referencing the audience gets a new snapshot, so the evaluation cache picks up the change.\n",
+        "tags": [
+          "audience"
+        ],
+        "operationId": "putAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update an audience",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putAudienceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "audience updated",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the audience. It fails if a segment still references it.",
+        "tags": [
+          "audience"
+        ],
+        "operationId": "deleteAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/datar/flags/{flagID}/summary": {
       "get": {
         "description": "All-in-one analytics summary for a single flag",
@@ -132,6 +313,79 @@ func init() {
         }
       }
     },
+    "/entity_types/{entityType}/schema": {
+      "get": {
+        "description": "Gets the attribute schema of the entity context of an entity type",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getEntityTypeSchema",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "key of the entity type",
+            "name": "entityType",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the attribute schema of the entity type",
+            "schema": {
+              "$ref": "#/definitions/entityTypeSchema"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Declares the attributes of the entity context of an entity type. The constraints of the flags of the entity type are validated against them, and so are the entity contexts of evaluations if FLAGR_EVAL_ENTITY_CONTEXT_VALIDATION is set. An empty list of attributes removes the schema.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putEntityTypeSchema",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "key of the entity type",
+            "name": "entityType",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the attributes of the entity type",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putEntityTypeSchemaRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the attribute schema of the entity type",
+            "schema": {
+              "$ref": "#/definitions/entityTypeSchema"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/evaluation": {
       "post": {
         "tags": [
@@ -295,6 +549,74 @@ func init() {
         }
       }
     },
+    "/evaluation/explain": {
+      "post": {
+        "description": "Explains the evaluation of a flag for an entity as structured JSON: every segment with its constraints, their resolved attribute values and whether they passed, the bucket number of the entity and the rollout decision. The evaluation is not recorded. It requires eval debugging to be enabled.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationExplain",
+        "parameters": [
+          {
+            "description": "evaluation context, with the entityID and the flagID or flagKey",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evalContext"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation explanation",
+            "schema": {
+              "$ref": "#/definitions/evalExplanation"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/simulate": {
+      "post": {
+        "description": "Simulates a flag, or a proposed draft of it, on a sample of entities, and compares the result with the live version of the flag in the evaluation cache: how many entities match every segment, how many get every variant, and how many would move to another variant. The evaluations are not recorded.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationSimulate",
+        "parameters": [
+          {
+            "description": "the flag to simulate and the sample of entities",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/simulationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "simulation result",
+            "schema": {
+              "$ref": "#/definitions/simulationResult"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
     "/export/eval_cache/json": {
       "get": {
         "description": "Export JSON format of the eval cache dump",
@@ -669,29 +991,30 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/enabled": {
+    "/flags/{flagID}/activation_window": {
       "put": {
+        "description": "Set the activation window of the flag. Outside of the window the flag evaluates to no variant, like a disabled flag.",
         "tags": [
           "flag"
         ],
-        "operationId": "setFlagEnabled",
+        "operationId": "putFlagActivationWindow",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "set flag enabled state",
+            "description": "activation window of the flag",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/setFlagEnabledRequest"
+              "$ref": "#/definitions/activationWindow"
             }
           }
         ],
@@ -709,20 +1032,19 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/restore": {
-      "put": {
+      },
+      "delete": {
+        "description": "remove the activation window of the flag, so that it's always active",
         "tags": [
           "flag"
         ],
-        "operationId": "restoreFlag",
+        "operationId": "deleteFlagActivationWindow",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
@@ -744,12 +1066,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments": {
-      "get": {
+    "/flags/{flagID}/enabled": {
+      "put": {
         "tags": [
-          "segment"
+          "flag"
         ],
-        "operationId": "findSegments",
+        "operationId": "setFlagEnabled",
         "parameters": [
           {
             "minimum": 1,
@@ -759,16 +1081,22 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "set flag enabled state",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setFlagEnabledRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "segments ordered by rank of the flag",
+            "description": "returns the flag",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/segment"
-              }
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -778,37 +1106,40 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/flags/{flagID}/holdout": {
+      "put": {
+        "description": "Put the flag into a holdout. The entities hashed into the holdout get the given variant of the flag instead of being evaluated by its segments.",
         "tags": [
-          "segment"
+          "flag"
         ],
-        "operationId": "createSegment",
+        "operationId": "putFlagHoldout",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "description": "create a segment under a flag",
+            "description": "the holdout and the variant for the entities in it",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createSegmentRequest"
+              "$ref": "#/definitions/putFlagHoldoutRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "segment created",
+            "description": "returns the flag",
             "schema": {
-              "$ref": "#/definitions/segment"
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -818,14 +1149,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/segments/reorder": {
-      "put": {
+      },
+      "delete": {
+        "description": "remove the flag from its holdout",
         "tags": [
-          "segment"
+          "flag"
         ],
-        "operationId": "putSegmentsReorder",
+        "operationId": "deleteFlagHoldout",
         "parameters": [
           {
             "minimum": 1,
@@ -835,20 +1165,14 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
-          },
-          {
-            "description": "reorder segments",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putSegmentReorderRequest"
-            }
           }
         ],
         "responses": {
           "200": {
-            "description": "segments reordered"
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -859,12 +1183,13 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}": {
+    "/flags/{flagID}/layer": {
       "put": {
+        "description": "Allocate a slot range of a layer to the flag. The flag is only evaluated for the entities hashed into its slots, so flags in the same layer are mutually exclusive. Ranges overlapping the ones of other flags in the layer are rejected.",
         "tags": [
-          "segment"
+          "flag"
         ],
-        "operationId": "putSegment",
+        "operationId": "putFlagLayer",
         "parameters": [
           {
             "minimum": 1,
@@ -876,29 +1201,20 @@ func init() {
             "required": true
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "update a segment",
+            "description": "the layer and the slot range of the flag",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putSegmentRequest"
+              "$ref": "#/definitions/putFlagLayerRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "segment updated",
+            "description": "returns the flag",
             "schema": {
-              "$ref": "#/definitions/segment"
+              "$ref": "#/definitions/flag"
             }
           },
           "default": {
@@ -910,10 +1226,11 @@ func init() {
         }
       },
       "delete": {
+        "description": "remove the flag from its layer and release its slots",
         "tags": [
-          "segment"
+          "flag"
         ],
-        "operationId": "deleteSegment",
+        "operationId": "deleteFlagLayer",
         "parameters": [
           {
             "minimum": 1,
@@ -923,20 +1240,57 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/prerequisites": {
+      "put": {
+        "description": "Replace the prerequisites of the flag. The flag is only evaluated for entities that got one of the listed variants of every prerequisite flag. Prerequisites referencing unknown flags or variants, and the ones that would form a cycle, are rejected.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlagPrerequisites",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "prerequisites of the flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putFlagPrerequisitesRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -947,39 +1301,63 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints": {
-      "get": {
+    "/flags/{flagID}/restore": {
+      "put": {
         "tags": [
-          "constraint"
+          "flag"
         ],
-        "operationId": "findConstraints",
+        "operationId": "restoreFlag",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
+            "description": "numeric ID of the flag to get",
             "name": "flagID",
             "in": "path",
             "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments": {
+      "get": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "findSegments",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "constraints under the segment",
+            "description": "segments ordered by rank of the flag",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/constraint"
+                "$ref": "#/definitions/segment"
               }
             }
           },
@@ -993,43 +1371,34 @@ func init() {
       },
       "post": {
         "tags": [
-          "constraint"
+          "segment"
         ],
-        "operationId": "createConstraint",
+        "operationId": "createSegment",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
+            "description": "numeric ID of the flag to get",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a constraint",
+            "description": "create a segment under a flag",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
+              "$ref": "#/definitions/createSegmentRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "the constraint created",
+            "description": "segment created",
             "schema": {
-              "$ref": "#/definitions/constraint"
+              "$ref": "#/definitions/segment"
             }
           },
           "default": {
@@ -1041,12 +1410,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
+    "/flags/{flagID}/segments/reorder": {
       "put": {
         "tags": [
-          "constraint"
+          "segment"
         ],
-        "operationId": "putConstraint",
+        "operationId": "putSegmentsReorder",
         "parameters": [
           {
             "minimum": 1,
@@ -1058,39 +1427,18 @@ func init() {
             "required": true
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a constraint",
+            "description": "reorder segments",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
+              "$ref": "#/definitions/putSegmentReorderRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "constraint just updated",
-            "schema": {
-              "$ref": "#/definitions/constraint"
-            }
+            "description": "segments reordered"
           },
           "default": {
             "description": "generic error response",
@@ -1099,12 +1447,14 @@ func init() {
             }
           }
         }
-      },
-      "delete": {
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}": {
+      "put": {
         "tags": [
-          "constraint"
+          "segment"
         ],
-        "operationId": "deleteConstraint",
+        "operationId": "putSegment",
         "parameters": [
           {
             "minimum": 1,
@@ -1125,18 +1475,21 @@ func init() {
             "required": true
           },
           {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
+            "description": "update a segment",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
           "200": {
-            "description": "deleted"
+            "description": "segment updated",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -1145,14 +1498,12 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/segments/{segmentID}/distributions": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "distribution"
+          "segment"
         ],
-        "operationId": "findDistributions",
+        "operationId": "deleteSegment",
         "parameters": [
           {
             "minimum": 1,
@@ -1175,13 +1526,7 @@ func init() {
         ],
         "responses": {
           "200": {
-            "description": "distribution under the segment",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
-            }
+            "description": "deleted"
           },
           "default": {
             "description": "generic error response",
@@ -1190,13 +1535,15 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/activation_window": {
       "put": {
-        "description": "replace the distribution with the new setting",
+        "description": "Set the activation window of the segment. Outside of the window the segment is skipped during the evaluation.",
         "tags": [
-          "distribution"
+          "segment"
         ],
-        "operationId": "putDistributions",
+        "operationId": "putSegmentActivationWindow",
         "parameters": [
           {
             "minimum": 1,
@@ -1217,23 +1564,20 @@ func init() {
             "required": true
           },
           {
-            "description": "array of distributions",
+            "description": "activation window of the segment",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putDistributionsRequest"
+              "$ref": "#/definitions/activationWindow"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "distribution under the segment",
+            "description": "returns the segment",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
+              "$ref": "#/definitions/segment"
             }
           },
           "default": {
@@ -1243,57 +1587,38 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/snapshots": {
-      "get": {
+      },
+      "delete": {
+        "description": "remove the activation window of the segment, so that it's always active",
         "tags": [
-          "flag"
+          "segment"
         ],
-        "operationId": "getFlagSnapshots",
+        "operationId": "deleteSegmentActivationWindow",
         "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
+            "description": "numeric ID of the flag",
             "name": "flagID",
             "in": "path",
             "required": true
           },
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "the number of snapshots to return",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "return snapshots given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "ASC",
-              "DESC"
-            ],
-            "type": "string",
-            "description": "sort order",
-            "name": "sort",
-            "in": "query"
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "returns the flag snapshots",
+            "description": "returns the segment",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/flagSnapshot"
-              }
+              "$ref": "#/definitions/segment"
             }
           },
           "default": {
@@ -1305,12 +1630,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/tags": {
+    "/flags/{flagID}/segments/{segmentID}/constraints": {
       "get": {
         "tags": [
-          "tag"
+          "constraint"
         ],
-        "operationId": "findTags",
+        "operationId": "findConstraints",
         "parameters": [
           {
             "minimum": 1,
@@ -1320,15 +1645,24 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "tag ordered by tagID",
+            "description": "constraints under the segment",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/tag"
+                "$ref": "#/definitions/constraint"
               }
             }
           },
@@ -1342,9 +1676,9 @@ func init() {
       },
       "post": {
         "tags": [
-          "tag"
+          "constraint"
         ],
-        "operationId": "createTag",
+        "operationId": "createConstraint",
         "parameters": [
           {
             "minimum": 1,
@@ -1356,20 +1690,29 @@ func init() {
             "required": true
           },
           {
-            "description": "create a tag",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/createTagRequest"
+              "$ref": "#/definitions/createConstraintRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "tag just created",
+            "description": "the constraint created",
             "schema": {
-              "$ref": "#/definitions/tag"
+              "$ref": "#/definitions/constraint"
             }
           },
           "default": {
@@ -1381,12 +1724,12 @@ func init() {
         }
       }
     },
-    "/flags/{flagID}/tags/{tagID}": {
-      "delete": {
+    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
+      "put": {
         "tags": [
-          "tag"
+          "constraint"
         ],
-        "operationId": "deleteTag",
+        "operationId": "putConstraint",
         "parameters": [
           {
             "minimum": 1,
@@ -1401,15 +1744,36 @@ func init() {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the tag",
-            "name": "tagID",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
             "in": "path",
             "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "constraint just updated",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -1418,14 +1782,12 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/variants": {
-      "get": {
+      },
+      "delete": {
         "tags": [
-          "variant"
+          "constraint"
         ],
-        "operationId": "findVariants",
+        "operationId": "deleteConstraint",
         "parameters": [
           {
             "minimum": 1,
@@ -1435,17 +1797,29 @@ func init() {
             "name": "flagID",
             "in": "path",
             "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "variant ordered by variantID",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/variant"
-              }
-            }
+            "description": "deleted"
           },
           "default": {
             "description": "generic error response",
@@ -1454,12 +1828,14 @@ func init() {
             }
           }
         }
-      },
-      "post": {
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/distributions": {
+      "get": {
         "tags": [
-          "variant"
+          "distribution"
         ],
-        "operationId": "createVariant",
+        "operationId": "findDistributions",
         "parameters": [
           {
             "minimum": 1,
@@ -1471,20 +1847,23 @@ func init() {
             "required": true
           },
           {
-            "description": "create a variant",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createVariantRequest"
-            }
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "variant just created",
+            "description": "distribution under the segment",
             "schema": {
-              "$ref": "#/definitions/variant"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
             }
           },
           "default": {
@@ -1494,14 +1873,13 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/flags/{flagID}/variants/{variantID}": {
+      },
       "put": {
+        "description": "replace the distribution with the new setting",
         "tags": [
-          "variant"
+          "distribution"
         ],
-        "operationId": "putVariant",
+        "operationId": "putDistributions",
         "parameters": [
           {
             "minimum": 1,
@@ -1516,26 +1894,122 @@ func init() {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
             "in": "path",
             "required": true
           },
           {
-            "description": "update a variant",
+            "description": "array of distributions",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/putVariantRequest"
+              "$ref": "#/definitions/putDistributionsRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "variant just updated",
+            "description": "distribution under the segment",
             "schema": {
-              "$ref": "#/definitions/variant"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_schedule": {
+      "get": {
+        "description": "get the latest rollout schedule of the segment",
+        "tags": [
+          "segment"
+        ],
+        "operationId": "getRolloutSchedule",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the rollout schedule",
+            "schema": {
+              "$ref": "#/definitions/rolloutSchedule"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a rollout schedule that ramps the rollout percent of the segment over time. It fails if the segment already has an active or paused schedule.",
+        "tags": [
+          "segment"
+        ],
+        "operationId": "createRolloutSchedule",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a rollout schedule",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRolloutScheduleRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the rollout schedule",
+            "schema": {
+              "$ref": "#/definitions/rolloutSchedule"
             }
           },
           "default": {
@@ -1547,10 +2021,11 @@ func init() {
         }
       },
       "delete": {
+        "description": "Cancel the active or paused rollout schedule of the segment. The rollout percent of the segment is kept as is.",
         "tags": [
-          "variant"
+          "segment"
         ],
-        "operationId": "deleteVariant",
+        "operationId": "cancelRolloutSchedule",
         "parameters": [
           {
             "minimum": 1,
@@ -1565,15 +2040,18 @@ func init() {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
             "in": "path",
             "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "deleted"
+            "description": "returns the rollout schedule",
+            "schema": {
+              "$ref": "#/definitions/rolloutSchedule"
+            }
           },
           "default": {
             "description": "generic error response",
@@ -1584,18 +2062,47 @@ func init() {
         }
       }
     },
-    "/health": {
-      "get": {
-        "description": "Check if Flagr is healthy",
+    "/flags/{flagID}/segments/{segmentID}/rollout_schedule/paused": {
+      "put": {
+        "description": "Pause or resume the rollout schedule of the segment. A resumed schedule jumps to the rollout percent of the current time.",
         "tags": [
-          "health"
+          "segment"
+        ],
+        "operationId": "setRolloutSchedulePaused",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "pause or resume the rollout schedule",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setRolloutSchedulePausedRequest"
+            }
+          }
         ],
-        "operationId": "getHealth",
         "responses": {
           "200": {
-            "description": "status of health check",
+            "description": "returns the rollout schedule",
             "schema": {
-              "$ref": "#/definitions/health"
+              "$ref": "#/definitions/rolloutSchedule"
             }
           },
           "default": {
@@ -1607,41 +2114,54 @@ func init() {
         }
       }
     },
-    "/tags": {
+    "/flags/{flagID}/snapshots": {
       "get": {
         "tags": [
-          "tag"
+          "flag"
         ],
-        "operationId": "findAllTags",
+        "operationId": "getFlagSnapshots",
         "parameters": [
           {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "the numbers of tags to return",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the number of snapshots to return",
             "name": "limit",
             "in": "query"
           },
           {
             "type": "integer",
             "format": "int64",
-            "description": "return tags given the offset, it should usually set together with limit",
+            "description": "return snapshots given the offset, it should usually set together with limit",
             "name": "offset",
             "in": "query"
           },
           {
+            "enum": [
+              "ASC",
+              "DESC"
+            ],
             "type": "string",
-            "description": "return tags partially matching given value",
-            "name": "value_like",
+            "description": "sort order",
+            "name": "sort",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "list all the tags",
+            "description": "returns the flag snapshots",
             "schema": {
               "type": "array",
               "items": {
-                "$ref": "#/definitions/tag"
+                "$ref": "#/definitions/flagSnapshot"
               }
             }
           },
@@ -1653,917 +2173,923 @@ func init() {
           }
         }
       }
-    }
-  },
-  "definitions": {
-    "constraint": {
-      "type": "object",
-      "required": [
-        "property",
-        "operator",
-        "value"
-      ],
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "operator": {
-          "type": "string",
-          "minLength": 1,
-          "enum": [
-            "EQ",
-            "NEQ",
-            "LT",
-            "LTE",
-            "GT",
-            "GTE",
-            "EREG",
-            "NEREG",
-            "IN",
-            "NOTIN",
-            "CONTAINS",
-            "NOTCONTAINS"
-          ]
-        },
-        "property": {
-          "description": "The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., ` + "`" + `user.name` + "`" + `) for nested objects and brackets (e.g., ` + "`" + `users[0]` + "`" + `) for array indices.\n",
-          "type": "string",
-          "minLength": 1
-        },
-        "value": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
     },
-    "createConstraintRequest": {
-      "type": "object",
-      "required": [
-        "property",
-        "operator",
-        "value"
-      ],
-      "properties": {
-        "operator": {
-          "type": "string",
-          "minLength": 1
-        },
-        "property": {
-          "description": "The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., ` + "`" + `user.name` + "`" + `) for nested objects and brackets (e.g., ` + "`" + `users[0]` + "`" + `) for array indices.\n",
-          "type": "string",
-          "minLength": 1
-        },
-        "value": {
-          "type": "string",
-          "minLength": 1
+    "/flags/{flagID}/snapshots/evaluation": {
+      "post": {
+        "description": "Evaluates an entity against a historical snapshot of the flag, selected by flagSnapshotID or by the snapshot that was current at a timestamp, and returns the result alongside the evaluation of the current flag. The evaluations are not recorded.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "postFlagSnapshotEvaluation",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the snapshot and the entity to evaluate",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/snapshotEvaluationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation results of the snapshot and of the current flag",
+            "schema": {
+              "$ref": "#/definitions/snapshotEvaluationResult"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "createFlagRequest": {
-      "type": "object",
-      "required": [
-        "description"
-      ],
-      "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string"
-        },
-        "template": {
-          "description": "template for flag creation",
-          "type": "string"
-        }
-      }
-    },
-    "createSegmentRequest": {
-      "type": "object",
-      "required": [
-        "description",
-        "rolloutPercent"
-      ],
-      "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100
-        }
-      }
-    },
-    "createTagRequest": {
-      "type": "object",
-      "required": [
-        "value"
-      ],
-      "properties": {
-        "value": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
-    },
-    "createVariantRequest": {
-      "type": "object",
-      "required": [
-        "key"
-      ],
-      "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
-    },
-    "datarDayEntry": {
-      "type": "object",
-      "properties": {
-        "count": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "date": {
-          "type": "string",
-          "format": "date"
-        }
-      }
-    },
-    "datarFlagSummaryResponse": {
-      "type": "object",
-      "properties": {
-        "flagID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "trafficByDay": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/datarDayEntry"
-          }
-        },
-        "trafficBySegment": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/datarSegmentEntry"
+    "/flags/{flagID}/tags": {
+      "get": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "findTags",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           }
-        },
-        "trafficByVariant": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/datarVariantEntry"
+        ],
+        "responses": {
+          "200": {
+            "description": "tag ordered by tagID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "datarSegmentEntry": {
-      "type": "object",
-      "properties": {
-        "count": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "segmentID": {
-          "type": "integer",
-          "format": "int64"
-        }
-      }
-    },
-    "datarSummaryFlag": {
-      "type": "object",
-      "properties": {
-        "description": {
-          "type": "string"
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "flagID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "flagKey": {
-          "type": "string"
-        },
-        "lastEvaluatedAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "totalEvalCount": {
-          "type": "integer",
-          "format": "int64"
+      },
+      "post": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "createTag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a tag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createTagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tag just created",
+            "schema": {
+              "$ref": "#/definitions/tag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "datarSummaryResponse": {
-      "type": "object",
-      "properties": {
-        "flags": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/datarSummaryFlag"
+    "/flags/{flagID}/tags/{tagID}": {
+      "delete": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "deleteTag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the tag",
+            "name": "tagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "datarVariantEntry": {
-      "type": "object",
-      "properties": {
-        "count": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "variantID": {
-          "type": "integer",
-          "format": "int64"
+    "/flags/{flagID}/targets": {
+      "get": {
+        "tags": [
+          "target"
+        ],
+        "operationId": "findTargets",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "targets of the flag ordered by targetID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/target"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "distribution": {
-      "type": "object",
-      "required": [
-        "percent",
-        "variantID",
-        "variantKey"
-      ],
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "percent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100
-        },
-        "variantID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "variantKey": {
-          "type": "string",
-          "minLength": 1
+      },
+      "put": {
+        "description": "Replace the targets of the flag. Targets referencing unknown variants, and an entityID targeted more than once, are rejected.",
+        "tags": [
+          "target"
+        ],
+        "operationId": "putTargets",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "targets of the flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putTargetsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "targets of the flag ordered by targetID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/target"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "message": {
-          "type": "string",
-          "minLength": 1
+      },
+      "post": {
+        "description": "Pin an entity to a variant of the flag. The entity gets the variant without evaluating the segments of the flag.",
+        "tags": [
+          "target"
+        ],
+        "operationId": "createTarget",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a target",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createTargetRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "target just created",
+            "schema": {
+              "$ref": "#/definitions/target"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "evalContext": {
-      "type": "object",
-      "properties": {
-        "enableDebug": {
-          "type": "boolean"
-        },
-        "entityContext": {
-          "type": "object"
-        },
-        "entityID": {
-          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
-          "type": "string"
-        },
-        "entityType": {
-          "type": "string"
-        },
-        "flagID": {
-          "description": "flagID",
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        },
-        "flagKey": {
-          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
-          "type": "string"
-        },
-        "flagTags": {
-          "description": "flagTags. flagTags looks up flags by tag. Either works.",
-          "type": "array",
-          "items": {
-            "type": "string"
+    "/flags/{flagID}/targets/{targetID}": {
+      "delete": {
+        "tags": [
+          "target"
+        ],
+        "operationId": "deleteTarget",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           },
-          "x-omitempty": true
-        },
-        "flagTagsOperator": {
-          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
-          "type": "string",
-          "default": "ANY",
-          "enum": [
-            "ANY",
-            "ALL"
-          ]
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the target",
+            "name": "targetID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "evalDebugLog": {
-      "type": "object",
-      "properties": {
-        "msg": {
-          "type": "string"
-        },
-        "segmentDebugLogs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segmentDebugLog"
+    "/flags/{flagID}/variants": {
+      "get": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "findVariants",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant ordered by variantID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/variant"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "evalResult": {
-      "type": "object",
-      "properties": {
-        "dataRecordsEnabled": {
-          "description": "flag's data records status.",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "evalContext": {
-          "$ref": "#/definitions/evalContext"
-        },
-        "evalDebugLog": {
-          "$ref": "#/definitions/evalDebugLog"
-        },
-        "flagID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "flagKey": {
-          "type": "string"
-        },
-        "flagSnapshotID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "flagTags": {
-          "description": "flagTags. flagTags looks up flags by tag. Either works.",
-          "type": "array",
-          "items": {
-            "type": "string"
+      },
+      "post": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "createVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
           },
-          "x-omitempty": true
-        },
-        "segmentID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "timestamp": {
-          "type": "string"
-        },
-        "variantAttachment": {
-          "type": "object"
-        },
-        "variantID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "variantKey": {
-          "type": "string"
+          {
+            "description": "create a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just created",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "evaluationBatchRequest": {
-      "type": "object",
-      "required": [
-        "entities"
-      ],
-      "properties": {
-        "enableDebug": {
-          "type": "boolean"
-        },
-        "entities": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "$ref": "#/definitions/evaluationEntity"
-          }
-        },
-        "flagIDs": {
-          "description": "flagIDs",
-          "type": "array",
-          "minItems": 1,
-          "items": {
+    "/flags/{flagID}/variants/{variantID}": {
+      "put": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "putVariant",
+        "parameters": [
+          {
+            "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "minimum": 1
-          }
-        },
-        "flagKeys": {
-          "description": "flagKeys. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "string",
-            "minLength": 1
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putVariantRequest"
+            }
           }
-        },
-        "flagTags": {
-          "description": "flagTags. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "string",
-            "minLength": 1
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just updated",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "flagTagsOperator": {
-          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
-          "type": "string",
-          "default": "ANY",
-          "enum": [
-            "ANY",
-            "ALL"
-          ]
         }
-      }
-    },
-    "evaluationBatchResponse": {
-      "type": "object",
-      "required": [
-        "evaluationResults"
-      ],
-      "properties": {
-        "evaluationResults": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/evalResult"
+      },
+      "delete": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "deleteVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "evaluationEntity": {
-      "type": "object",
-      "properties": {
-        "entityContext": {
-          "type": "object"
-        },
-        "entityID": {
-          "type": "string"
-        },
-        "entityType": {
-          "type": "string"
+    "/health": {
+      "get": {
+        "description": "Check if Flagr is healthy",
+        "tags": [
+          "health"
+        ],
+        "operationId": "getHealth",
+        "responses": {
+          "200": {
+            "description": "status of health check",
+            "schema": {
+              "$ref": "#/definitions/health"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "flag": {
-      "type": "object",
-      "required": [
-        "description",
-        "enabled",
-        "dataRecordsEnabled"
-      ],
-      "properties": {
-        "createdBy": {
-          "type": "string"
-        },
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean"
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "entityType": {
-          "description": "it will override the entityType in the evaluation logs if it's not empty",
-          "type": "string"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string",
-          "minLength": 1
-        },
-        "notes": {
-          "description": "flag usage details in markdown format",
-          "type": "string"
-        },
-        "segments": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segment"
-          }
-        },
-        "tags": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/tag"
+    "/holdouts": {
+      "get": {
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "findHoldouts",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of holdouts to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return holdouts given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
           }
-        },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "updatedBy": {
-          "type": "string"
-        },
-        "variants": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/variant"
+        ],
+        "responses": {
+          "200": {
+            "description": "list holdouts ordered by holdoutID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/holdout"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "flagSnapshot": {
-      "type": "object",
-      "required": [
-        "id",
-        "flag",
-        "updatedAt"
-      ],
-      "properties": {
-        "flag": {
-          "$ref": "#/definitions/flag"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "updatedAt": {
-          "type": "string",
-          "minLength": 1
-        },
-        "updatedBy": {
-          "type": "string"
+      },
+      "post": {
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "createHoldout",
+        "parameters": [
+          {
+            "description": "create a holdout",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createHoldoutRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "holdout created",
+            "schema": {
+              "$ref": "#/definitions/holdout"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "flagSnapshotMaxID": {
-      "type": "object",
-      "properties": {
-        "maxID": {
-          "type": "integer",
-          "format": "int64"
+    "/holdouts/{holdoutID}": {
+      "get": {
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "getHoldout",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the holdout",
+            "name": "holdoutID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the holdout",
+            "schema": {
+              "$ref": "#/definitions/holdout"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "health": {
-      "type": "object",
-      "properties": {
-        "status": {
-          "type": "string"
+      },
+      "put": {
+        "description": "Updates the holdout. Every flag in the holdout gets a new snapshot when it changes.",
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "putHoldout",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the holdout",
+            "name": "holdoutID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a holdout",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putHoldoutRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "holdout updated",
+            "schema": {
+              "$ref": "#/definitions/holdout"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "putDistributionsRequest": {
-      "type": "object",
-      "required": [
-        "distributions"
-      ],
-      "properties": {
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
+      },
+      "delete": {
+        "description": "Deletes the holdout. It fails if flags are still in it.",
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "deleteHoldout",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the holdout",
+            "name": "holdoutID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
     },
-    "putFlagRequest": {
-      "type": "object",
-      "properties": {
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": true
-        },
-        "enabled": {
-          "type": "boolean",
-          "x-nullable": true
-        },
-        "entityType": {
-          "description": "it will overwrite entityType into evaluation logs if it's not empty",
-          "type": "string",
-          "x-nullable": true
-        },
-        "key": {
-          "type": "string",
-          "x-nullable": true
-        },
-        "notes": {
-          "type": "string",
-          "x-nullable": true
-        }
-      }
-    },
-    "putSegmentReorderRequest": {
-      "type": "object",
-      "required": [
-        "segmentIDs"
-      ],
-      "properties": {
-        "segmentIDs": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
+    "/layers": {
+      "get": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "findLayers",
+        "parameters": [
+          {
             "type": "integer",
             "format": "int64",
-            "minimum": 1
+            "description": "the numbers of layers to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return layers given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list layers ordered by layerID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/layer"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
-      }
-    },
-    "putSegmentRequest": {
-      "type": "object",
-      "required": [
-        "description",
-        "rolloutPercent"
-      ],
-      "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100
-        }
-      }
-    },
-    "putVariantRequest": {
-      "type": "object",
-      "required": [
-        "key"
-      ],
-      "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
+      },
+      "post": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "createLayer",
+        "parameters": [
+          {
+            "description": "create a layer",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "layer created",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "segment": {
-      "type": "object",
-      "required": [
-        "description",
-        "rank",
-        "rolloutPercent"
-      ],
-      "properties": {
-        "constraints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/constraint"
+    "/layers/{layerID}": {
+      "get": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "getLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
           }
-        },
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the layer with the slot ranges allocated to its flags",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "rank": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100
         }
-      }
-    },
-    "segmentDebugLog": {
-      "type": "object",
-      "properties": {
-        "msg": {
-          "type": "string"
-        },
-        "segmentID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
+      },
+      "put": {
+        "description": "Updates the layer. The number of slots can only be changed as long as every allocated slot range still fits, and every flag in the layer gets a new snapshot when it changes.",
+        "tags": [
+          "layer"
+        ],
+        "operationId": "putLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a layer",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "layer updated",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "setFlagEnabledRequest": {
-      "type": "object",
-      "required": [
-        "enabled"
-      ],
-      "properties": {
-        "enabled": {
-          "type": "boolean"
+      },
+      "delete": {
+        "description": "Deletes the layer. It fails if flags still own slots of it.",
+        "tags": [
+          "layer"
+        ],
+        "operationId": "deleteLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "tag": {
-      "type": "object",
-      "required": [
-        "value"
-      ],
-      "properties": {
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "value": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
-    },
-    "variant": {
-      "type": "object",
-      "required": [
-        "key"
-      ],
-      "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "id": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
-        }
-      }
-    }
-  },
-  "tags": [
-    {
-      "description": "Everything about the flag",
-      "name": "flag"
-    },
-    {
-      "description": "Segment defines the audience of the flag, it's the user segmentation",
-      "name": "segment"
-    },
-    {
-      "description": "Constraint is the unit of defining a small subset of users",
-      "name": "constraint"
-    },
-    {
-      "description": "Distribution is the percent distribution of variants within that segment",
-      "name": "distribution"
-    },
-    {
-      "description": "Variants are the possible outcomes of flag evaluation",
-      "name": "variant"
-    },
-    {
-      "description": "Evaluation is the process of evaluating a flag given the entity context",
-      "name": "evaluation"
-    },
-    {
-      "description": "Check if Flagr is healthy",
-      "name": "health"
-    },
-    {
-      "description": "Datar aggregate analytics",
-      "name": "datar"
-    }
-  ],
-  "x-tagGroups": [
-    {
-      "name": "Flag Management",
-      "tags": [
-        "flag",
-        "segment",
-        "constraint",
-        "distribution",
-        "variant",
-        "tag"
-      ]
-    },
-    {
-      "name": "Flag Evaluation",
-      "tags": [
-        "evaluation"
-      ]
-    },
-    {
-      "name": "Health Check",
-      "tags": [
-        "health"
-      ]
-    },
-    {
-      "name": "Export",
-      "tags": [
-        "export"
-      ]
-    },
-    {
-      "name": "Datar",
-      "tags": [
-        "datar"
-      ]
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "description": "Flagr is a feature flagging, A/B testing and dynamic configuration microservice. The base path for all the APIs is \"/api/v1\".\n",
-    "title": "Flagr",
-    "version": "dev"
-  },
-  "basePath": "/api/v1",
-  "paths": {
-    "/datar/flags/{flagID}/summary": {
+    "/lists": {
       "get": {
-        "description": "All-in-one analytics summary for a single flag",
         "tags": [
-          "datar"
+          "list"
         ],
-        "operationId": "getDatarFlagSummary",
+        "operationId": "findLists",
         "parameters": [
           {
             "type": "integer",
             "format": "int64",
-            "description": "Flag ID",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+            "description": "the numbers of lists to return",
+            "name": "limit",
+            "in": "query"
           },
           {
-            "type": "string",
-            "format": "date-time",
-            "description": "Start time (RFC 3339, default 7 days ago)",
-            "name": "from",
+            "type": "integer",
+            "format": "int64",
+            "description": "return lists given the offset, it should usually set together with limit",
+            "name": "offset",
             "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list lists ordered by listID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/list"
+              }
+            }
           },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "list"
+        ],
+        "operationId": "createList",
+        "parameters": [
           {
-            "type": "string",
-            "format": "date-time",
-            "description": "End time (RFC 3339, default now)",
-            "name": "to",
-            "in": "query"
+            "description": "create a list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createListRequest"
+            }
           }
         ],
         "responses": {
           "200": {
-            "description": "flag analytics summary",
+            "description": "list created",
             "schema": {
-              "$ref": "#/definitions/datarFlagSummaryResponse"
+              "$ref": "#/definitions/list"
             }
           },
           "default": {
@@ -2575,48 +3101,28 @@ func init() {
         }
       }
     },
-    "/datar/summary": {
+    "/lists/{listID}": {
       "get": {
-        "description": "Aggregate traffic summary for all flags",
         "tags": [
-          "datar"
+          "list"
         ],
-        "operationId": "getDatarSummary",
+        "operationId": "getList",
         "parameters": [
           {
-            "type": "string",
-            "format": "date-time",
-            "description": "Start time (RFC 3339, default 7 days ago)",
-            "name": "from",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "format": "date-time",
-            "description": "End time (RFC 3339, default now)",
-            "name": "to",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int32",
-            "description": "Max results (default 100)",
-            "name": "limit",
-            "in": "query"
-          },
-          {
+            "minimum": 1,
             "type": "integer",
-            "format": "int32",
-            "description": "Result offset (default 0)",
-            "name": "offset",
-            "in": "query"
+            "format": "int64",
+            "description": "numeric ID of the list",
+            "name": "listID",
+            "in": "path",
+            "required": true
           }
         ],
         "responses": {
           "200": {
-            "description": "summary of all flag traffic",
+            "description": "returns the list",
             "schema": {
-              "$ref": "#/definitions/datarSummaryResponse"
+              "$ref": "#/definitions/list"
             }
           },
           "default": {
@@ -2626,31 +3132,68 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/evaluation": {
-      "post": {
+      },
+      "put": {
+        "description": "Updates the description of the list.",
         "tags": [
-          "evaluation"
+          "list"
         ],
-        "operationId": "postEvaluation",
+        "operationId": "putList",
         "parameters": [
           {
-            "description": "evaluation context",
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the list",
+            "name": "listID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a list",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/evalContext"
+              "$ref": "#/definitions/putListRequest"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "evaluation result",
+            "description": "list updated",
             "schema": {
-              "$ref": "#/definitions/evalResult"
+              "$ref": "#/definitions/list"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
             }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the list and its values. It fails if constraints still reference it.",
+        "tags": [
+          "list"
+        ],
+        "operationId": "deleteList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the list",
+            "name": "listID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
           },
           "default": {
             "description": "generic error response",
@@ -2661,127 +3204,44 @@ func init() {
         }
       }
     },
-    "/evaluation/batch": {
-      "get": {
-        "description": "Salo flagr-response's lambda GET-handler drop-in replacement",
+    "/lists/{listID}/values": {
+      "put": {
+        "description": "Replaces the values of the list and bumps its version. The values are uploaded as CSV or newline separated text, every non-empty field is a value. Every flag referencing the list gets a new snapshot.",
+        "consumes": [
+          "text/plain",
+          "text/csv"
+        ],
         "tags": [
-          "evaluation"
+          "list"
         ],
-        "operationId": "getEvaluationBatch",
+        "operationId": "putListValues",
         "parameters": [
           {
-            "type": "string",
-            "description": "ETag value from previous response for cache validation",
-            "name": "If-None-Match",
-            "in": "header"
-          },
-          {
-            "type": "string",
-            "default": "",
-            "name": "entityId",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "integer",
-              "format": "int64"
-            },
-            "collectionFormat": "multi",
-            "name": "flagId",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "name": "flagKey",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "type": "string"
-            },
-            "collectionFormat": "multi",
-            "name": "flagTag",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "ANY",
-              "ALL"
-            ],
-            "type": "string",
-            "default": "ALL",
-            "name": "flagTagQuery",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "evaluation batch result",
-            "schema": {
-              "$ref": "#/definitions/evaluationBatchResponse"
-            },
-            "headers": {
-              "ETag": {
-                "type": "string",
-                "description": "Cache version identifier for the flag evaluation cache"
-              }
-            }
-          },
-          "304": {
-            "description": "Not Modified - flag configuration has not changed since the provided ETag"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "evaluation"
-        ],
-        "operationId": "postEvaluationBatch",
-        "parameters": [
-          {
-            "type": "string",
-            "description": "ETag value from previous response for cache validation",
-            "name": "If-None-Match",
-            "in": "header"
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the list",
+            "name": "listID",
+            "in": "path",
+            "required": true
           },
           {
-            "description": "evaluation batch request",
+            "description": "the values of the list",
             "name": "body",
             "in": "body",
             "required": true,
             "schema": {
-              "$ref": "#/definitions/evaluationBatchRequest"
+              "type": "string"
             }
           }
         ],
         "responses": {
           "200": {
-            "description": "evaluation batch result",
+            "description": "values uploaded",
             "schema": {
-              "$ref": "#/definitions/evaluationBatchResponse"
-            },
-            "headers": {
-              "ETag": {
-                "type": "string",
-                "description": "Cache version identifier for the flag evaluation cache"
-              }
+              "$ref": "#/definitions/list"
             }
           },
-          "304": {
-            "description": "Not Modified - flag configuration has not changed since the provided ETag"
-          },
           "default": {
             "description": "generic error response",
             "schema": {
@@ -2791,70 +3251,42 @@ func init() {
         }
       }
     },
-    "/export/eval_cache/json": {
+    "/tags": {
       "get": {
-        "description": "Export JSON format of the eval cache dump",
-        "produces": [
-          "application/json"
-        ],
         "tags": [
-          "export"
+          "tag"
         ],
-        "operationId": "getExportEvalCacheJSON",
+        "operationId": "findAllTags",
         "parameters": [
           {
-            "minItems": 1,
-            "type": "array",
-            "items": {
-              "minimum": 1,
-              "type": "integer",
-              "format": "int64"
-            },
-            "collectionFormat": "csv",
-            "description": "\"query optimized\" flagIDs parameter. Has precedence over ` + "`" + `enabled` + "`" + `, ` + "`" + `keys` + "`" + ` and ` + "`" + `tags` + "`" + ` parameters.",
-            "name": "ids",
-            "in": "query"
-          },
-          {
-            "type": "array",
-            "items": {
-              "minLength": 1,
-              "type": "string"
-            },
-            "collectionFormat": "csv",
-            "description": "\"query optimized\" flagKeys parameter. Has precedence over ` + "`" + `enabled` + "`" + ` and ` + "`" + `tags` + "`" + ` parameter.",
-            "name": "keys",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "return flags having given enabled status",
-            "name": "enabled",
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of tags to return",
+            "name": "limit",
             "in": "query"
           },
           {
-            "type": "array",
-            "items": {
-              "minLength": 1,
-              "type": "string"
-            },
-            "collectionFormat": "csv",
-            "description": "\"query optimized\" flagTags parameter",
-            "name": "tags",
+            "type": "integer",
+            "format": "int64",
+            "description": "return tags given the offset, it should usually set together with limit",
+            "name": "offset",
             "in": "query"
           },
           {
-            "type": "boolean",
-            "description": "whether to use ALL (tags) semantics (ANY by default): ` + "`" + `?tags=foo,bar\u0026all=true` + "`" + ` is equivalent to postEvaluation's ` + "`" + `flagTagsOperator: \"ALL\"` + "`" + `. Only meaningful when ` + "`" + `tags` + "`" + ` parameter is present. Ignored otherwise.",
-            "name": "all",
+            "type": "string",
+            "description": "return tags partially matching given value",
+            "name": "value_like",
             "in": "query"
           }
         ],
         "responses": {
           "200": {
-            "description": "OK",
+            "description": "list all the tags",
             "schema": {
-              "type": "object"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tag"
+              }
             }
           },
           "default": {
@@ -2865,1673 +3297,7223 @@ func init() {
           }
         }
       }
-    },
-    "/export/sqlite": {
-      "get": {
-        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
-        "produces": [
-          "application/octet-stream"
-        ],
-        "tags": [
-          "export"
-        ],
-        "operationId": "getExportSqlite",
-        "parameters": [
-          {
-            "type": "boolean",
-            "description": "export without snapshots data - useful for smaller db without snapshots\n",
-            "name": "exclude_snapshots",
-            "in": "query"
+    }
+  },
+  "definitions": {
+    "activationWindow": {
+      "description": "limits when a flag or a segment is active. startAt and endAt bound the window in absolute time. weekdays, startTime and endTime make it recurring in the timezone, e.g. mon-fri 09:00-17:00 in Europe/Berlin. A startTime after the endTime spans midnight. Empty fields don't limit the window.",
+      "type": "object",
+      "properties": {
+        "endAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "endTime": {
+          "description": "end time of the day in HH:MM",
+          "type": "string",
+          "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]$"
+        },
+        "startAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "startTime": {
+          "description": "start time of the day in HH:MM",
+          "type": "string",
+          "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]$"
+        },
+        "timezone": {
+          "description": "IANA time zone of weekdays, startTime and endTime, UTC if empty",
+          "type": "string"
+        },
+        "weekdays": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "sun",
+              "mon",
+              "tue",
+              "wed",
+              "thu",
+              "fri",
+              "sat"
+            ]
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "file"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        }
+      }
+    },
+    "audience": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
           }
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "/flags": {
-      "get": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "findFlags",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "the numbers of flags to return",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "return flags having given enabled status",
-            "name": "enabled",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags exactly matching given description",
-            "name": "description",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags with the given tags (comma separated)",
-            "name": "tags",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags partially matching given description",
-            "name": "description_like",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return flags matching given key",
-            "name": "key",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "return flags given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "return flags with preloaded segments and variants",
-            "name": "preload",
-            "in": "query"
-          },
-          {
-            "type": "boolean",
-            "description": "return all deleted flags",
-            "name": "deleted",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "list all the flags",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/flag"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "audienceExplanation": {
+      "type": "object",
+      "properties": {
+        "audienceID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintExplanation"
           }
+        },
+        "key": {
+          "type": "string"
+        },
+        "matched": {
+          "type": "boolean"
         }
-      },
-      "post": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "createFlag",
-        "parameters": [
-          {
-            "description": "create a flag",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createFlagRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "returns the created flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "constraint": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "group": {
+          "description": "The constraint group within the segment. Constraints of the same group are joined by AND, and the groups are joined by OR. Defaults to 0.\n",
+          "type": "integer",
+          "format": "int64"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "operator": {
+          "type": "string",
+          "minLength": 1,
+          "enum": [
+            "EQ",
+            "NEQ",
+            "LT",
+            "LTE",
+            "GT",
+            "GTE",
+            "EREG",
+            "NEREG",
+            "IN",
+            "NOTIN",
+            "CONTAINS",
+            "NOTCONTAINS",
+            "SEMVER_EQ",
+            "SEMVER_LT",
+            "SEMVER_LTE",
+            "SEMVER_GT",
+            "SEMVER_GTE",
+            "BEFORE",
+            "AFTER",
+            "IN_LIST",
+            "NOT_IN_LIST",
+            "IN_CIDR",
+            "NOT_IN_CIDR",
+            "STARTS_WITH",
+            "ENDS_WITH",
+            "EQ_IGNORE_CASE",
+            "IN_IGNORE_CASE",
+            "CONTAINS_IGNORE_CASE"
+          ]
+        },
+        "property": {
+          "description": "The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., ` + "`" + `user.name` + "`" + `) for nested objects and brackets (e.g., ` + "`" + `users[0]` + "`" + `) for array indices.\n",
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "/flags/entity_types": {
-      "get": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "getFlagEntityTypes",
-        "responses": {
-          "200": {
-            "description": "returns all the FlagEntityTypes",
-            "schema": {
-              "type": "array",
-              "items": {
-                "type": "string",
-                "minLength": 1
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "constraintExplanation": {
+      "type": "object",
+      "properties": {
+        "constraintID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "type": "string"
+        },
+        "group": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "matched": {
+          "type": "boolean"
+        },
+        "operator": {
+          "type": "string"
+        },
+        "property": {
+          "type": "string"
+        },
+        "resolveError": {
+          "description": "the reason the property could not be resolved",
+          "type": "string"
+        },
+        "resolvedValue": {
+          "description": "the value of the property in the entityContext"
+        },
+        "value": {
+          "type": "string"
         }
       }
     },
-    "/flags/snapshots/max_id": {
-      "get": {
-        "description": "Returns the highest (most recent) flag_snapshot id. flag_snapshot is an append-only changelog — every flag configuration mutation (create, update, delete, restore of flags, segments, variants, constraints, distributions, or tags) inserts a new row with a monotonically increasing id. This makes the max id a lightweight single source of truth for detecting any configuration change.\nConsumers can store this value and poll it periodically: if the value changes, at least one flag's configuration has been modified and caches should be invalidated. This is vastly more efficient than polling the flags list or individual flags.\n",
-        "tags": [
-          "flag"
-        ],
-        "summary": "Get the max flag snapshot ID (config change version)",
-        "operationId": "getFlagSnapshotMaxID",
-        "responses": {
-          "200": {
-            "description": "returns the current max flag_snapshot id; poll this value to detect flag configuration changes for cache invalidation",
-            "schema": {
-              "$ref": "#/definitions/flagSnapshotMaxID"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "createAudienceRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
           }
+        },
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "/flags/{flagID}": {
-      "get": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "getFlag",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "returns the flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "createConstraintRequest": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "group": {
+          "description": "The constraint group within the segment. Constraints of the same group are joined by AND, and the groups are joined by OR. Defaults to 0.\n",
+          "type": "integer",
+          "format": "int64"
+        },
+        "operator": {
+          "type": "string",
+          "minLength": 1
+        },
+        "property": {
+          "description": "The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., ` + "`" + `user.name` + "`" + `) for nested objects and brackets (e.g., ` + "`" + `users[0]` + "`" + `) for array indices.\n",
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
         }
-      },
-      "put": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "putFlag",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "update a flag",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putFlagRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "returns the flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "createFlagRequest": {
+      "type": "object",
+      "required": [
+        "description"
+      ],
+      "properties": {
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string"
+        },
+        "template": {
+          "description": "template for flag creation",
+          "type": "string"
         }
-      },
-      "delete": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "deleteFlag",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK deleted"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "createHoldoutRequest": {
+      "type": "object",
+      "required": [
+        "key",
+        "percent"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the holdout",
+          "type": "string",
+          "minLength": 1
+        },
+        "percent": {
+          "description": "percent of the entities in the holdout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100
         }
       }
     },
-    "/flags/{flagID}/enabled": {
-      "put": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "setFlagEnabled",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "set flag enabled state",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/setFlagEnabledRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "returns the flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "createLayerRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the layer",
+          "type": "string",
+          "minLength": 1
+        },
+        "slots": {
+          "description": "number of slots the entities are hashed into",
+          "type": "integer",
+          "format": "int64",
+          "default": 1000,
+          "minimum": 1
+        }
+      }
+    },
+    "createListRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the list",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createRolloutScheduleRequest": {
+      "type": "object",
+      "required": [
+        "steps"
+      ],
+      "properties": {
+        "linear": {
+          "description": "interpolate the rollout percent linearly between the steps",
+          "type": "boolean"
+        },
+        "steps": {
+          "description": "steps in chronological order. A linear ramp from start to end is two steps with linear set to true.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/rolloutScheduleStep"
           }
         }
       }
     },
-    "/flags/{flagID}/restore": {
-      "put": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "restoreFlag",
-        "parameters": [
-          {
-            "minimum": 1,
+    "createSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceIDs": {
+          "description": "IDs of the audiences the entity has to match in addition to the constraints of the segment.",
+          "type": "array",
+          "items": {
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "returns the flag",
-            "schema": {
-              "$ref": "#/definitions/flag"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+            "minimum": 1
           }
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rolloutPercent": {
+          "description": "percent of the matching entities in the rollout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100
         }
       }
     },
-    "/flags/{flagID}/segments": {
-      "get": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "findSegments",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
+    "createTagRequest": {
+      "type": "object",
+      "required": [
+        "value"
+      ],
+      "properties": {
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createTargetRequest": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "variantKey"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createVariantRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "datarDayEntry": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "date": {
+          "type": "string",
+          "format": "date"
+        }
+      }
+    },
+    "datarFlagSummaryResponse": {
+      "type": "object",
+      "properties": {
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "trafficByDay": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarDayEntry"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "segments ordered by rank of the flag",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/segment"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "trafficByHoldout": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarHoldoutEntry"
           }
-        }
-      },
-      "post": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "createSegment",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a segment under a flag",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createSegmentRequest"
-            }
+        },
+        "trafficBySegment": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarSegmentEntry"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "segment created",
-            "schema": {
-              "$ref": "#/definitions/segment"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "trafficByVariant": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarVariantEntry"
           }
         }
       }
     },
-    "/flags/{flagID}/segments/reorder": {
-      "put": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "putSegmentsReorder",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "reorder segments",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putSegmentReorderRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "segments reordered"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "datarHoldoutEntry": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "holdoutID": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}": {
-      "put": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "putSegment",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "update a segment",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putSegmentRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "segment updated",
-            "schema": {
-              "$ref": "#/definitions/segment"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "datarSegmentEntry": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
         }
-      },
-      "delete": {
-        "tags": [
-          "segment"
-        ],
-        "operationId": "deleteSegment",
-        "parameters": [
+      }
+    },
+    "datarSummaryFlag": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "lastEvaluatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "totalEvalCount": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "datarSummaryResponse": {
+      "type": "object",
+      "properties": {
+        "flags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarSummaryFlag"
+          }
+        }
+      }
+    },
+    "datarVariantEntry": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "distribution": {
+      "type": "object",
+      "required": [
+        "percent",
+        "variantID",
+        "variantKey"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "percent": {
+          "description": "percent of the rolled out entities given the variant, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "entityAttribute": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "allowedValues": {
+          "description": "the values the attribute can take, any value if empty",
+          "type": "array",
+          "items": {}
+        },
+        "name": {
+          "description": "property of the entity context, nested properties like device.os are supported",
+          "type": "string",
+          "minLength": 1
+        },
+        "required": {
+          "type": "boolean"
+        },
+        "type": {
+          "description": "the type of the attribute, any type if empty",
+          "type": "string",
+          "enum": [
+            "string",
+            "number",
+            "boolean",
+            "object",
+            "array"
+          ]
+        }
+      }
+    },
+    "entityTypeSchema": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "attributes"
+      ],
+      "properties": {
+        "attributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityAttribute"
+          }
+        },
+        "entityType": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "evalContext": {
+      "type": "object",
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "flagID": {
+          "description": "flagID",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
+          "type": "string"
+        },
+        "flagTags": {
+          "description": "flagTags. flagTags looks up flags by tag. Either works.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "flagTagsOperator": {
+          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
+        }
+      }
+    },
+    "evalDebugLog": {
+      "type": "object",
+      "properties": {
+        "msg": {
+          "type": "string"
+        },
+        "segmentDebugLogs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentDebugLog"
+          }
+        }
+      }
+    },
+    "evalExplanation": {
+      "type": "object",
+      "properties": {
+        "bucketKey": {
+          "description": "the value the entity is bucketed by, see evalResult",
+          "type": "string"
+        },
+        "defaultVariant": {
+          "description": "the variant is the default variant of the flag",
+          "type": "boolean"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "description": "the final reason of the evaluation. SEGMENT_MATCH if the entity is rolled out in the first segment it matched, NOT_ROLLED_OUT if it matched a segment but is outside of its rollout.",
+          "type": "string",
+          "enum": [
+            "FLAG_NOT_FOUND",
+            "FLAG_DISABLED",
+            "FLAG_NOT_ACTIVE",
+            "INDIVIDUAL_TARGET",
+            "NO_SEGMENTS",
+            "LAYER_SLOT_NOT_OWNED",
+            "PREREQUISITE_NOT_MET",
+            "HOLDOUT",
+            "SEGMENT_MATCH",
+            "NOT_ROLLED_OUT",
+            "NO_SEGMENT_MATCH"
+          ]
+        },
+        "segmentID": {
+          "description": "the segment the entity matched, 0 if none",
+          "type": "integer",
+          "format": "int64"
+        },
+        "segments": {
+          "description": "the segments of the flag in evaluation order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentExplanation"
+          }
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "evalResult": {
+      "type": "object",
+      "properties": {
+        "bucketKey": {
+          "description": "the value the entity is bucketed by. It's the value of the flag's bucketBy attribute, or the entityID if the flag has no bucketBy or the attribute is missing from the entityContext.",
+          "type": "string"
+        },
+        "dataRecordsEnabled": {
+          "description": "flag's data records status.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "defaultVariant": {
+          "description": "the variant is the default variant of the flag, because the flag is disabled or not active, or no segment matched.",
+          "type": "boolean"
+        },
+        "evalContext": {
+          "$ref": "#/definitions/evalContext"
+        },
+        "evalDebugLog": {
+          "$ref": "#/definitions/evalDebugLog"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "flagSnapshotID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagTags": {
+          "description": "flagTags. flagTags looks up flags by tag. Either works.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "x-omitempty": true
+        },
+        "holdoutID": {
+          "description": "the holdout the entity is in. The entity got the holdout variant of the flag instead of being evaluated by its segments.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "holdoutKey": {
+          "type": "string"
+        },
+        "individualTarget": {
+          "description": "the entity is individually targeted to the variant, the segments of the flag were not evaluated.",
+          "type": "boolean"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "variantAttachment": {
+          "type": "object"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "evaluationBatchRequest": {
+      "type": "object",
+      "required": [
+        "entities"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entities": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
+        },
+        "flagIDs": {
+          "description": "flagIDs",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "flagKeys": {
+          "description": "flagKeys. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "flagTags": {
+          "description": "flagTags. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "flagTagsOperator": {
+          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
+        }
+      }
+    },
+    "evaluationBatchResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
+          }
+        }
+      }
+    },
+    "evaluationEntity": {
+      "type": "object",
+      "properties": {
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        }
+      }
+    },
+    "flag": {
+      "type": "object",
+      "required": [
+        "description",
+        "enabled",
+        "dataRecordsEnabled"
+      ],
+      "properties": {
+        "activationWindow": {
+          "$ref": "#/definitions/activationWindow"
+        },
+        "attachmentSchema": {
+          "description": "JSON Schema (draft 4) that the attachments of the variants must conform to. Empty if the attachments are not validated.",
+          "type": "object"
+        },
+        "bucketBy": {
+          "description": "entityContext attribute, e.g. company_id, that is hashed instead of the entityID to bucket the entity. The entityID is used if it's empty or the attribute is missing.",
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean"
+        },
+        "defaultVariantID": {
+          "description": "variant returned when the flag is disabled or not active, or no segment matched. 0 if the flag has no default variant.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "defaultVariantKey": {
+          "type": "string",
+          "readOnly": true
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "entityType": {
+          "description": "it will override the entityType in the evaluation logs if it's not empty",
+          "type": "string"
+        },
+        "holdout": {
+          "$ref": "#/definitions/flagHoldout"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string",
+          "minLength": 1
+        },
+        "layer": {
+          "$ref": "#/definitions/flagLayer"
+        },
+        "notes": {
+          "description": "flag usage details in markdown format",
+          "type": "string"
+        },
+        "prerequisites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/flagPrerequisite"
+          }
+        },
+        "salt": {
+          "description": "salt of the hash that buckets the entities. The flag ID is used if it's empty. Changing it re-randomizes which entities get which variant.",
+          "type": "string",
+          "maxLength": 64
+        },
+        "segments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segment"
+          }
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tag"
+          }
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/target"
+          }
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedBy": {
+          "type": "string"
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variant"
+          }
+        }
+      }
+    },
+    "flagHoldout": {
+      "description": "the holdout the flag is in, and the variant for the entities in it",
+      "type": "object",
+      "required": [
+        "holdoutID",
+        "variantID"
+      ],
+      "properties": {
+        "holdoutID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "holdoutKey": {
+          "type": "string",
+          "readOnly": true
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "flagLayer": {
+      "description": "the slot range of a layer owned by the flag",
+      "type": "object",
+      "required": [
+        "layerID",
+        "slotStart",
+        "slotEnd"
+      ],
+      "properties": {
+        "layerID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "layerKey": {
+          "type": "string",
+          "readOnly": true
+        },
+        "slotEnd": {
+          "description": "the slot after the last one owned by the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "slotStart": {
+          "description": "first slot owned by the flag",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "flagPrerequisite": {
+      "type": "object",
+      "required": [
+        "flagKey",
+        "variantKeys"
+      ],
+      "properties": {
+        "flagKey": {
+          "description": "key of the prerequisite flag",
+          "type": "string",
+          "minLength": 1
+        },
+        "variantKeys": {
+          "description": "the prerequisite is met if the prerequisite flag evaluates to any of the variant keys",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        }
+      }
+    },
+    "flagSnapshot": {
+      "type": "object",
+      "required": [
+        "id",
+        "flag",
+        "updatedAt"
+      ],
+      "properties": {
+        "flag": {
+          "$ref": "#/definitions/flag"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "updatedAt": {
+          "type": "string",
+          "minLength": 1
+        },
+        "updatedBy": {
+          "type": "string"
+        }
+      }
+    },
+    "flagSnapshotMaxID": {
+      "type": "object",
+      "properties": {
+        "maxID": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "health": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "holdout": {
+      "type": "object",
+      "required": [
+        "key",
+        "percent"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the holdout",
+          "type": "string",
+          "minLength": 1
+        },
+        "percent": {
+          "description": "percent of the entities in the holdout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        }
+      }
+    },
+    "layer": {
+      "type": "object",
+      "required": [
+        "key",
+        "slots"
+      ],
+      "properties": {
+        "allocations": {
+          "description": "the slot ranges owned by the flags in the layer, ordered by slotStart",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/layerAllocation"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the layer",
+          "type": "string",
+          "minLength": 1
+        },
+        "slots": {
+          "description": "number of slots the entities are hashed into",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "layerAllocation": {
+      "type": "object",
+      "properties": {
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "slotEnd": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "slotStart": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "list": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the list",
+          "type": "string",
+          "minLength": 1
+        },
+        "size": {
+          "description": "number of the values in the list",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "version": {
+          "description": "bumped on every upload of the values",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "putAudienceRequest": {
+      "type": "object",
+      "properties": {
+        "constraints": {
+          "description": "replaces all the constraints of the audience. The constraints are kept as is when it's not set.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        }
+      }
+    },
+    "putDistributionsRequest": {
+      "type": "object",
+      "required": [
+        "distributions"
+      ],
+      "properties": {
+        "distributions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        }
+      }
+    },
+    "putEntityTypeSchemaRequest": {
+      "type": "object",
+      "required": [
+        "attributes"
+      ],
+      "properties": {
+        "attributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityAttribute"
+          }
+        }
+      }
+    },
+    "putFlagHoldoutRequest": {
+      "type": "object",
+      "required": [
+        "holdoutID",
+        "variantID"
+      ],
+      "properties": {
+        "holdoutID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantID": {
+          "description": "the variant of the flag for the entities in the holdout",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "putFlagLayerRequest": {
+      "type": "object",
+      "required": [
+        "layerID",
+        "slotStart",
+        "slotEnd"
+      ],
+      "properties": {
+        "layerID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "slotEnd": {
+          "description": "the slot after the last one owned by the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "slotStart": {
+          "description": "first slot owned by the flag",
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "putFlagPrerequisitesRequest": {
+      "type": "object",
+      "required": [
+        "prerequisites"
+      ],
+      "properties": {
+        "prerequisites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/flagPrerequisite"
+          }
+        }
+      }
+    },
+    "putFlagRequest": {
+      "type": "object",
+      "properties": {
+        "attachmentSchema": {
+          "description": "JSON Schema (draft 4) that the attachments of the variants must conform to. The existing variants must conform to it as well. An empty object removes the schema.",
+          "type": "object"
+        },
+        "bucketBy": {
+          "description": "entityContext attribute that is hashed instead of the entityID, empty for the entityID",
+          "type": "string",
+          "x-nullable": true
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "defaultVariantID": {
+          "description": "variant returned when the flag is disabled or not active, or no segment matched. 0 removes the default variant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "enabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "entityType": {
+          "description": "it will overwrite entityType into evaluation logs if it's not empty",
+          "type": "string",
+          "x-nullable": true
+        },
+        "key": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "notes": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "salt": {
+          "description": "salt of the hash that buckets the entities, empty for the flag ID. Set a new value to re-randomize the buckets, e.g. before re-running an experiment.",
+          "type": "string",
+          "maxLength": 64,
+          "x-nullable": true
+        }
+      }
+    },
+    "putHoldoutRequest": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "percent": {
+          "description": "percent of the entities in the holdout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0,
+          "x-nullable": true
+        }
+      }
+    },
+    "putLayerRequest": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "slots": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "x-nullable": true
+        }
+      }
+    },
+    "putListRequest": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "putSegmentReorderRequest": {
+      "type": "object",
+      "required": [
+        "segmentIDs"
+      ],
+      "properties": {
+        "segmentIDs": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        }
+      }
+    },
+    "putSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceIDs": {
+          "description": "IDs of the audiences the entity has to match in addition to the constraints of the segment. The audiences are kept as is when it's not set, and removed when it's an empty array.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rolloutPercent": {
+          "description": "percent of the matching entities in the rollout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        }
+      }
+    },
+    "putTargetsRequest": {
+      "type": "object",
+      "required": [
+        "targets"
+      ],
+      "properties": {
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/target"
+          }
+        }
+      }
+    },
+    "putVariantRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "rolloutSchedule": {
+      "type": "object",
+      "required": [
+        "steps",
+        "linear",
+        "status"
+      ],
+      "properties": {
+        "appliedPercent": {
+          "description": "the last rollout percent applied by the scheduler",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "linear": {
+          "description": "interpolate the rollout percent linearly between the steps",
+          "type": "boolean"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "active",
+            "paused",
+            "completed",
+            "canceled"
+          ]
+        },
+        "steps": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/rolloutScheduleStep"
+          }
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedBy": {
+          "type": "string"
+        }
+      }
+    },
+    "rolloutScheduleStep": {
+      "type": "object",
+      "required": [
+        "at",
+        "percent"
+      ],
+      "properties": {
+        "at": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "percent": {
+          "description": "rollout percent of the segment from the time of the step on, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        }
+      }
+    },
+    "segment": {
+      "type": "object",
+      "required": [
+        "description",
+        "rank",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "activationWindow": {
+          "$ref": "#/definitions/activationWindow"
+        },
+        "audiences": {
+          "description": "the audiences the entity has to match in addition to the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audience"
+          }
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "distributions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "rank": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rolloutPercent": {
+          "description": "percent of the matching entities in the rollout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100
+        }
+      }
+    },
+    "segmentDebugLog": {
+      "type": "object",
+      "properties": {
+        "msg": {
+          "type": "string"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "segmentExplanation": {
+      "type": "object",
+      "properties": {
+        "active": {
+          "description": "the segment is within its activation window",
+          "type": "boolean"
+        },
+        "audiences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audienceExplanation"
+          }
+        },
+        "bucketNum": {
+          "description": "the bucket of the entity computed from the bucket key and the salt, set if the segment matched",
+          "type": "integer",
+          "format": "int64",
+          "x-nullable": true
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintExplanation"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "evaluated": {
+          "description": "the segment was evaluated. The segments after the first one matched are not evaluated.",
+          "type": "boolean"
+        },
+        "matched": {
+          "description": "the entity matched the constraints and the audiences of the segment",
+          "type": "boolean"
+        },
+        "message": {
+          "type": "string"
+        },
+        "rank": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "rolledOut": {
+          "description": "the entity is within the rollout of the segment",
+          "type": "boolean"
+        },
+        "rolloutPercent": {
+          "type": "number",
+          "format": "double"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "setFlagEnabledRequest": {
+      "type": "object",
+      "required": [
+        "enabled"
+      ],
+      "properties": {
+        "enabled": {
+          "type": "boolean"
+        }
+      }
+    },
+    "setRolloutSchedulePausedRequest": {
+      "type": "object",
+      "required": [
+        "paused"
+      ],
+      "properties": {
+        "paused": {
+          "type": "boolean"
+        }
+      }
+    },
+    "simulationGenerate": {
+      "description": "entities with generated entityIDs, added to the sample",
+      "type": "object",
+      "required": [
+        "count"
+      ],
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100000,
+          "minimum": 1
+        },
+        "entityContext": {
+          "description": "entity context of all the generated entities",
+          "type": "object"
+        },
+        "entityIDPrefix": {
+          "description": "prefix of the generated entityIDs, which are the prefix followed by a sequence number",
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        }
+      }
+    },
+    "simulationRequest": {
+      "type": "object",
+      "properties": {
+        "draft": {
+          "description": "proposed version of the flag, in the JSON flag spec of the evaluation cache export. The live flag is simulated if it's empty.",
+          "type": "object"
+        },
+        "entities": {
+          "description": "the sample of entities to simulate",
+          "type": "array",
+          "maxItems": 10000,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
+        },
+        "flagID": {
+          "description": "the live flag to compare with",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
+          "type": "string"
+        },
+        "generate": {
+          "$ref": "#/definitions/simulationGenerate"
+        }
+      }
+    },
+    "simulationResult": {
+      "type": "object",
+      "properties": {
+        "changed": {
+          "description": "the number of entities whose variant differs from the live flag",
+          "type": "integer",
+          "format": "int64"
+        },
+        "draft": {
+          "description": "a draft was simulated instead of the live flag",
+          "type": "boolean"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "liveUnassigned": {
+          "description": "the number of entities without a variant in the live flag",
+          "type": "integer",
+          "format": "int64"
+        },
+        "segments": {
+          "description": "the segments of the simulated flag in evaluation order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationSegmentCount"
+          }
+        },
+        "total": {
+          "description": "the number of simulated entities",
+          "type": "integer",
+          "format": "int64"
+        },
+        "transitions": {
+          "description": "the number of entities moving from a variant of the live flag to another variant, an empty variantKey means no variant",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationTransition"
+          }
+        },
+        "unassigned": {
+          "description": "the number of entities without a variant",
+          "type": "integer",
+          "format": "int64"
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/simulationVariantCount"
+          }
+        }
+      }
+    },
+    "simulationSegmentCount": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "matched": {
+          "description": "the number of entities for which the segment is the first one that matched",
+          "type": "integer",
+          "format": "int64"
+        },
+        "rolledOut": {
+          "description": "the number of matched entities that are in the rollout of the segment",
+          "type": "integer",
+          "format": "int64"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "simulationTransition": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "fromVariantKey": {
+          "type": "string"
+        },
+        "toVariantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "simulationVariantCount": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "description": "the number of entities that get the variant",
+          "type": "integer",
+          "format": "int64"
+        },
+        "liveCount": {
+          "description": "the number of entities that get the variant in the live flag",
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "snapshotEvaluationRequest": {
+      "type": "object",
+      "required": [
+        "entityID"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "type": "string",
+          "minLength": 1
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "flagSnapshotID": {
+          "description": "the snapshot to evaluate. Either flagSnapshotID or timestamp works.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "timestamp": {
+          "description": "evaluates the latest snapshot of the flag taken at or before the timestamp",
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "snapshotEvaluationResult": {
+      "type": "object",
+      "properties": {
+        "currentResult": {
+          "description": "the evaluation against the current flag in the evaluation cache",
+          "$ref": "#/definitions/evalResult"
+        },
+        "flagSnapshotID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "snapshotResult": {
+          "description": "the evaluation against the flag of the snapshot",
+          "$ref": "#/definitions/evalResult"
+        },
+        "snapshotUpdatedAt": {
+          "type": "string"
+        },
+        "snapshotUpdatedBy": {
+          "type": "string"
+        },
+        "variantChanged": {
+          "description": "the entity gets a different variant from the current flag",
+          "type": "boolean"
+        }
+      }
+    },
+    "tag": {
+      "type": "object",
+      "required": [
+        "value"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "target": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "variantKey"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "variant": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    }
+  },
+  "tags": [
+    {
+      "description": "Everything about the flag",
+      "name": "flag"
+    },
+    {
+      "description": "Segment defines the audience of the flag, it's the user segmentation",
+      "name": "segment"
+    },
+    {
+      "description": "Constraint is the unit of defining a small subset of users",
+      "name": "constraint"
+    },
+    {
+      "description": "Audience is a named set of constraints shared by segments of different flags",
+      "name": "audience"
+    },
+    {
+      "description": "Layer is a bucket space shared by mutually exclusive flags",
+      "name": "layer"
+    },
+    {
+      "description": "Holdout is a share of the entities excluded from the experiments of all its flags",
+      "name": "holdout"
+    },
+    {
+      "description": "List is an uploaded set of values referenced by the IN_LIST and NOT_IN_LIST constraints",
+      "name": "list"
+    },
+    {
+      "description": "Distribution is the percent distribution of variants within that segment",
+      "name": "distribution"
+    },
+    {
+      "description": "Variants are the possible outcomes of flag evaluation",
+      "name": "variant"
+    },
+    {
+      "description": "Target pins an entity to a variant of the flag",
+      "name": "target"
+    },
+    {
+      "description": "Evaluation is the process of evaluating a flag given the entity context",
+      "name": "evaluation"
+    },
+    {
+      "description": "Check if Flagr is healthy",
+      "name": "health"
+    },
+    {
+      "description": "Datar aggregate analytics",
+      "name": "datar"
+    }
+  ],
+  "x-tagGroups": [
+    {
+      "name": "Flag Management",
+      "tags": [
+        "flag",
+        "segment",
+        "constraint",
+        "distribution",
+        "variant",
+        "target",
+        "tag",
+        "audience",
+        "layer",
+        "holdout",
+        "list"
+      ]
+    },
+    {
+      "name": "Flag Evaluation",
+      "tags": [
+        "evaluation"
+      ]
+    },
+    {
+      "name": "Health Check",
+      "tags": [
+        "health"
+      ]
+    },
+    {
+      "name": "Export",
+      "tags": [
+        "export"
+      ]
+    },
+    {
+      "name": "Datar",
+      "tags": [
+        "datar"
+      ]
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "description": "Flagr is a feature flagging, A/B testing and dynamic configuration microservice. The base path for all the APIs is \"/api/v1\".\n",
+    "title": "Flagr",
+    "version": "dev"
+  },
+  "basePath": "/api/v1",
+  "paths": {
+    "/audiences": {
+      "get": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "findAudiences",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of audiences to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return audiences given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return audiences partially matching given key",
+            "name": "key_like",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list audiences ordered by audienceID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/audience"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "createAudience",
+        "parameters": [
+          {
+            "description": "create an audience",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createAudienceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "audience created",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/audiences/{audienceID}": {
+      "get": {
+        "tags": [
+          "audience"
+        ],
+        "operationId": "getAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the audience",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Updates the audience. Every flag with a segment referencing the audience gets a new snapshot, so the evaluation cache picks up the change.\n",
+        "tags": [
+          "audience"
+        ],
+        "operationId": "putAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update an audience",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putAudienceRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "audience updated",
+            "schema": {
+              "$ref": "#/definitions/audience"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the audience. It fails if a segment still references it.",
+        "tags": [
+          "audience"
+        ],
+        "operationId": "deleteAudience",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the audience",
+            "name": "audienceID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/datar/flags/{flagID}/summary": {
+      "get": {
+        "description": "All-in-one analytics summary for a single flag",
+        "tags": [
+          "datar"
+        ],
+        "operationId": "getDatarFlagSummary",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "Flag ID",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Start time (RFC 3339, default 7 days ago)",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "End time (RFC 3339, default now)",
+            "name": "to",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "flag analytics summary",
+            "schema": {
+              "$ref": "#/definitions/datarFlagSummaryResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/datar/summary": {
+      "get": {
+        "description": "Aggregate traffic summary for all flags",
+        "tags": [
+          "datar"
+        ],
+        "operationId": "getDatarSummary",
+        "parameters": [
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "Start time (RFC 3339, default 7 days ago)",
+            "name": "from",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "description": "End time (RFC 3339, default now)",
+            "name": "to",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "Max results (default 100)",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int32",
+            "description": "Result offset (default 0)",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "summary of all flag traffic",
+            "schema": {
+              "$ref": "#/definitions/datarSummaryResponse"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/entity_types/{entityType}/schema": {
+      "get": {
+        "description": "Gets the attribute schema of the entity context of an entity type",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getEntityTypeSchema",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "key of the entity type",
+            "name": "entityType",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the attribute schema of the entity type",
+            "schema": {
+              "$ref": "#/definitions/entityTypeSchema"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Declares the attributes of the entity context of an entity type. The constraints of the flags of the entity type are validated against them, and so are the entity contexts of evaluations if FLAGR_EVAL_ENTITY_CONTEXT_VALIDATION is set. An empty list of attributes removes the schema.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putEntityTypeSchema",
+        "parameters": [
+          {
+            "minLength": 1,
+            "type": "string",
+            "description": "key of the entity type",
+            "name": "entityType",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the attributes of the entity type",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putEntityTypeSchemaRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the attribute schema of the entity type",
+            "schema": {
+              "$ref": "#/definitions/entityTypeSchema"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation": {
+      "post": {
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluation",
+        "parameters": [
+          {
+            "description": "evaluation context",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evalContext"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation result",
+            "schema": {
+              "$ref": "#/definitions/evalResult"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/batch": {
+      "get": {
+        "description": "Salo flagr-response's lambda GET-handler drop-in replacement",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "getEvaluationBatch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag value from previous response for cache validation",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "type": "string",
+            "default": "",
+            "name": "entityId",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "multi",
+            "name": "flagId",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "name": "flagKey",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "name": "flagTag",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ANY",
+              "ALL"
+            ],
+            "type": "string",
+            "default": "ALL",
+            "name": "flagTagQuery",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation batch result",
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Cache version identifier for the flag evaluation cache"
+              }
+            }
+          },
+          "304": {
+            "description": "Not Modified - flag configuration has not changed since the provided ETag"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationBatch",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "ETag value from previous response for cache validation",
+            "name": "If-None-Match",
+            "in": "header"
+          },
+          {
+            "description": "evaluation batch request",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation batch result",
+            "schema": {
+              "$ref": "#/definitions/evaluationBatchResponse"
+            },
+            "headers": {
+              "ETag": {
+                "type": "string",
+                "description": "Cache version identifier for the flag evaluation cache"
+              }
+            }
+          },
+          "304": {
+            "description": "Not Modified - flag configuration has not changed since the provided ETag"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/explain": {
+      "post": {
+        "description": "Explains the evaluation of a flag for an entity as structured JSON: every segment with its constraints, their resolved attribute values and whether they passed, the bucket number of the entity and the rollout decision. The evaluation is not recorded. It requires eval debugging to be enabled.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationExplain",
+        "parameters": [
+          {
+            "description": "evaluation context, with the entityID and the flagID or flagKey",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/evalContext"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation explanation",
+            "schema": {
+              "$ref": "#/definitions/evalExplanation"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/evaluation/simulate": {
+      "post": {
+        "description": "Simulates a flag, or a proposed draft of it, on a sample of entities, and compares the result with the live version of the flag in the evaluation cache: how many entities match every segment, how many get every variant, and how many would move to another variant. The evaluations are not recorded.",
+        "tags": [
+          "evaluation"
+        ],
+        "operationId": "postEvaluationSimulate",
+        "parameters": [
+          {
+            "description": "the flag to simulate and the sample of entities",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/simulationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "simulation result",
+            "schema": {
+              "$ref": "#/definitions/simulationResult"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/export/eval_cache/json": {
+      "get": {
+        "description": "Export JSON format of the eval cache dump",
+        "produces": [
+          "application/json"
+        ],
+        "tags": [
+          "export"
+        ],
+        "operationId": "getExportEvalCacheJSON",
+        "parameters": [
+          {
+            "minItems": 1,
+            "type": "array",
+            "items": {
+              "minimum": 1,
+              "type": "integer",
+              "format": "int64"
+            },
+            "collectionFormat": "csv",
+            "description": "\"query optimized\" flagIDs parameter. Has precedence over ` + "`" + `enabled` + "`" + `, ` + "`" + `keys` + "`" + ` and ` + "`" + `tags` + "`" + ` parameters.",
+            "name": "ids",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "minLength": 1,
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "\"query optimized\" flagKeys parameter. Has precedence over ` + "`" + `enabled` + "`" + ` and ` + "`" + `tags` + "`" + ` parameter.",
+            "name": "keys",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return flags having given enabled status",
+            "name": "enabled",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "minLength": 1,
+              "type": "string"
+            },
+            "collectionFormat": "csv",
+            "description": "\"query optimized\" flagTags parameter",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "whether to use ALL (tags) semantics (ANY by default): ` + "`" + `?tags=foo,bar\u0026all=true` + "`" + ` is equivalent to postEvaluation's ` + "`" + `flagTagsOperator: \"ALL\"` + "`" + `. Only meaningful when ` + "`" + `tags` + "`" + ` parameter is present. Ignored otherwise.",
+            "name": "all",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/export/sqlite": {
+      "get": {
+        "description": "Export sqlite3 format of the db dump, which is converted from the main database.",
+        "produces": [
+          "application/octet-stream"
+        ],
+        "tags": [
+          "export"
+        ],
+        "operationId": "getExportSqlite",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "export without snapshots data - useful for smaller db without snapshots\n",
+            "name": "exclude_snapshots",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "file"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "findFlags",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of flags to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return flags having given enabled status",
+            "name": "enabled",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags exactly matching given description",
+            "name": "description",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags with the given tags (comma separated)",
+            "name": "tags",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags partially matching given description",
+            "name": "description_like",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return flags matching given key",
+            "name": "key",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return flags given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return flags with preloaded segments and variants",
+            "name": "preload",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "return all deleted flags",
+            "name": "deleted",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list all the flags",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "createFlag",
+        "parameters": [
+          {
+            "description": "create a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createFlagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the created flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/entity_types": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagEntityTypes",
+        "responses": {
+          "200": {
+            "description": "returns all the FlagEntityTypes",
+            "schema": {
+              "type": "array",
+              "items": {
+                "type": "string",
+                "minLength": 1
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/snapshots/max_id": {
+      "get": {
+        "description": "Returns the highest (most recent) flag_snapshot id. flag_snapshot is an append-only changelog — every flag configuration mutation (create, update, delete, restore of flags, segments, variants, constraints, distributions, or tags) inserts a new row with a monotonically increasing id. This makes the max id a lightweight single source of truth for detecting any configuration change.\nConsumers can store this value and poll it periodically: if the value changes, at least one flag's configuration has been modified and caches should be invalidated. This is vastly more efficient than polling the flags list or individual flags.\n",
+        "tags": [
+          "flag"
+        ],
+        "summary": "Get the max flag snapshot ID (config change version)",
+        "operationId": "getFlagSnapshotMaxID",
+        "responses": {
+          "200": {
+            "description": "returns the current max flag_snapshot id; poll this value to detect flag configuration changes for cache invalidation",
+            "schema": {
+              "$ref": "#/definitions/flagSnapshotMaxID"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putFlagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "deleteFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/activation_window": {
+      "put": {
+        "description": "Set the activation window of the flag. Outside of the window the flag evaluates to no variant, like a disabled flag.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlagActivationWindow",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "activation window of the flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/activationWindow"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "remove the activation window of the flag, so that it's always active",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "deleteFlagActivationWindow",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/enabled": {
+      "put": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "setFlagEnabled",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "set flag enabled state",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setFlagEnabledRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/holdout": {
+      "put": {
+        "description": "Put the flag into a holdout. The entities hashed into the holdout get the given variant of the flag instead of being evaluated by its segments.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlagHoldout",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the holdout and the variant for the entities in it",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putFlagHoldoutRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "remove the flag from its holdout",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "deleteFlagHoldout",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/layer": {
+      "put": {
+        "description": "Allocate a slot range of a layer to the flag. The flag is only evaluated for the entities hashed into its slots, so flags in the same layer are mutually exclusive. Ranges overlapping the ones of other flags in the layer are rejected.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlagLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the layer and the slot range of the flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putFlagLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "remove the flag from its layer and release its slots",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "deleteFlagLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/prerequisites": {
+      "put": {
+        "description": "Replace the prerequisites of the flag. The flag is only evaluated for entities that got one of the listed variants of every prerequisite flag. Prerequisites referencing unknown flags or variants, and the ones that would form a cycle, are rejected.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "putFlagPrerequisites",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "prerequisites of the flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putFlagPrerequisitesRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/restore": {
+      "put": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "restoreFlag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag",
+            "schema": {
+              "$ref": "#/definitions/flag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments": {
+      "get": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "findSegments",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segments ordered by rank of the flag",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/segment"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "createSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a segment under a flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segment created",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/reorder": {
+      "put": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegmentsReorder",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "reorder segments",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentReorderRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segments reordered"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}": {
+      "put": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a segment",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putSegmentRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "segment updated",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "segment"
+        ],
+        "operationId": "deleteSegment",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/activation_window": {
+      "put": {
+        "description": "Set the activation window of the segment. Outside of the window the segment is skipped during the evaluation.",
+        "tags": [
+          "segment"
+        ],
+        "operationId": "putSegmentActivationWindow",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "activation window of the segment",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/activationWindow"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the segment",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "remove the activation window of the segment, so that it's always active",
+        "tags": [
+          "segment"
+        ],
+        "operationId": "deleteSegmentActivationWindow",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the segment",
+            "schema": {
+              "$ref": "#/definitions/segment"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/constraints": {
+      "get": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "findConstraints",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "constraints under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/constraint"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "createConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "the constraint created",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
+      "put": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "putConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a constraint",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createConstraintRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "constraint just updated",
+            "schema": {
+              "$ref": "#/definitions/constraint"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "constraint"
+        ],
+        "operationId": "deleteConstraint",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the constraint",
+            "name": "constraintID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/distributions": {
+      "get": {
+        "tags": [
+          "distribution"
+        ],
+        "operationId": "findDistributions",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "distribution under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "replace the distribution with the new setting",
+        "tags": [
+          "distribution"
+        ],
+        "operationId": "putDistributions",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "array of distributions",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putDistributionsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "distribution under the segment",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/distribution"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_schedule": {
+      "get": {
+        "description": "get the latest rollout schedule of the segment",
+        "tags": [
+          "segment"
+        ],
+        "operationId": "getRolloutSchedule",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the rollout schedule",
+            "schema": {
+              "$ref": "#/definitions/rolloutSchedule"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a rollout schedule that ramps the rollout percent of the segment over time. It fails if the segment already has an active or paused schedule.",
+        "tags": [
+          "segment"
+        ],
+        "operationId": "createRolloutSchedule",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a rollout schedule",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createRolloutScheduleRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the rollout schedule",
+            "schema": {
+              "$ref": "#/definitions/rolloutSchedule"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Cancel the active or paused rollout schedule of the segment. The rollout percent of the segment is kept as is.",
+        "tags": [
+          "segment"
+        ],
+        "operationId": "cancelRolloutSchedule",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the rollout schedule",
+            "schema": {
+              "$ref": "#/definitions/rolloutSchedule"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/segments/{segmentID}/rollout_schedule/paused": {
+      "put": {
+        "description": "Pause or resume the rollout schedule of the segment. A resumed schedule jumps to the rollout percent of the current time.",
+        "tags": [
+          "segment"
+        ],
+        "operationId": "setRolloutSchedulePaused",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the segment",
+            "name": "segmentID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "pause or resume the rollout schedule",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/setRolloutSchedulePausedRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the rollout schedule",
+            "schema": {
+              "$ref": "#/definitions/rolloutSchedule"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/snapshots": {
+      "get": {
+        "tags": [
+          "flag"
+        ],
+        "operationId": "getFlagSnapshots",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag to get",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the number of snapshots to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return snapshots given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "ASC",
+              "DESC"
+            ],
+            "type": "string",
+            "description": "sort order",
+            "name": "sort",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the flag snapshots",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/flagSnapshot"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/snapshots/evaluation": {
+      "post": {
+        "description": "Evaluates an entity against a historical snapshot of the flag, selected by flagSnapshotID or by the snapshot that was current at a timestamp, and returns the result alongside the evaluation of the current flag. The evaluations are not recorded.",
+        "tags": [
+          "flag"
+        ],
+        "operationId": "postFlagSnapshotEvaluation",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "the snapshot and the entity to evaluate",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/snapshotEvaluationRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "evaluation results of the snapshot and of the current flag",
+            "schema": {
+              "$ref": "#/definitions/snapshotEvaluationResult"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/tags": {
+      "get": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "findTags",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tag ordered by tagID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "createTag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a tag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createTagRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "tag just created",
+            "schema": {
+              "$ref": "#/definitions/tag"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/tags/{tagID}": {
+      "delete": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "deleteTag",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the tag",
+            "name": "tagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/targets": {
+      "get": {
+        "tags": [
+          "target"
+        ],
+        "operationId": "findTargets",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "targets of the flag ordered by targetID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/target"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replace the targets of the flag. Targets referencing unknown variants, and an entityID targeted more than once, are rejected.",
+        "tags": [
+          "target"
+        ],
+        "operationId": "putTargets",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "targets of the flag",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putTargetsRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "targets of the flag ordered by targetID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/target"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Pin an entity to a variant of the flag. The entity gets the variant without evaluating the segments of the flag.",
+        "tags": [
+          "target"
+        ],
+        "operationId": "createTarget",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a target",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createTargetRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "target just created",
+            "schema": {
+              "$ref": "#/definitions/target"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/targets/{targetID}": {
+      "delete": {
+        "tags": [
+          "target"
+        ],
+        "operationId": "deleteTarget",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the target",
+            "name": "targetID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/variants": {
+      "get": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "findVariants",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant ordered by variantID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/variant"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "createVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "create a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just created",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/flags/{flagID}/variants/{variantID}": {
+      "put": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "putVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a variant",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putVariantRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "variant just updated",
+            "schema": {
+              "$ref": "#/definitions/variant"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "tags": [
+          "variant"
+        ],
+        "operationId": "deleteVariant",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the flag",
+            "name": "flagID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the variant",
+            "name": "variantID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/health": {
+      "get": {
+        "description": "Check if Flagr is healthy",
+        "tags": [
+          "health"
+        ],
+        "operationId": "getHealth",
+        "responses": {
+          "200": {
+            "description": "status of health check",
+            "schema": {
+              "$ref": "#/definitions/health"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/holdouts": {
+      "get": {
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "findHoldouts",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of holdouts to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return holdouts given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list holdouts ordered by holdoutID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/holdout"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "createHoldout",
+        "parameters": [
+          {
+            "description": "create a holdout",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createHoldoutRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "holdout created",
+            "schema": {
+              "$ref": "#/definitions/holdout"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/holdouts/{holdoutID}": {
+      "get": {
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "getHoldout",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the holdout",
+            "name": "holdoutID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the holdout",
+            "schema": {
+              "$ref": "#/definitions/holdout"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Updates the holdout. Every flag in the holdout gets a new snapshot when it changes.",
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "putHoldout",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the holdout",
+            "name": "holdoutID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a holdout",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putHoldoutRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "holdout updated",
+            "schema": {
+              "$ref": "#/definitions/holdout"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the holdout. It fails if flags are still in it.",
+        "tags": [
+          "holdout"
+        ],
+        "operationId": "deleteHoldout",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the holdout",
+            "name": "holdoutID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/layers": {
+      "get": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "findLayers",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of layers to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return layers given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list layers ordered by layerID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/layer"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "createLayer",
+        "parameters": [
+          {
+            "description": "create a layer",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "layer created",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/layers/{layerID}": {
+      "get": {
+        "tags": [
+          "layer"
+        ],
+        "operationId": "getLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the layer with the slot ranges allocated to its flags",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Updates the layer. The number of slots can only be changed as long as every allocated slot range still fits, and every flag in the layer gets a new snapshot when it changes.",
+        "tags": [
+          "layer"
+        ],
+        "operationId": "putLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a layer",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putLayerRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "layer updated",
+            "schema": {
+              "$ref": "#/definitions/layer"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the layer. It fails if flags still own slots of it.",
+        "tags": [
+          "layer"
+        ],
+        "operationId": "deleteLayer",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the layer",
+            "name": "layerID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/lists": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "operationId": "findLists",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of lists to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return lists given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list lists ordered by listID",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/list"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "tags": [
+          "list"
+        ],
+        "operationId": "createList",
+        "parameters": [
+          {
+            "description": "create a list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/createListRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list created",
+            "schema": {
+              "$ref": "#/definitions/list"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/lists/{listID}": {
+      "get": {
+        "tags": [
+          "list"
+        ],
+        "operationId": "getList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the list",
+            "name": "listID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "returns the list",
+            "schema": {
+              "$ref": "#/definitions/list"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Updates the description of the list.",
+        "tags": [
+          "list"
+        ],
+        "operationId": "putList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the list",
+            "name": "listID",
+            "in": "path",
+            "required": true
+          },
+          {
+            "description": "update a list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/putListRequest"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list updated",
+            "schema": {
+              "$ref": "#/definitions/list"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Deletes the list and its values. It fails if constraints still reference it.",
+        "tags": [
+          "list"
+        ],
+        "operationId": "deleteList",
+        "parameters": [
+          {
+            "minimum": 1,
+            "type": "integer",
+            "format": "int64",
+            "description": "numeric ID of the list",
+            "name": "listID",
+            "in": "path",
+            "required": true
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "deleted"
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/lists/{listID}/values": {
+      "put": {
+        "description": "Replaces the values of the list and bumps its version. The values are uploaded as CSV or newline separated text, every non-empty field is a value. Every flag referencing the list gets a new snapshot.",
+        "consumes": [
+          "text/plain",
+          "text/csv"
+        ],
+        "tags": [
+          "list"
+        ],
+        "operationId": "putListValues",
+        "parameters": [
           {
             "minimum": 1,
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
+            "description": "numeric ID of the list",
+            "name": "listID",
             "in": "path",
             "required": true
           },
           {
-            "minimum": 1,
+            "description": "the values of the list",
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "string"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "values uploaded",
+            "schema": {
+              "$ref": "#/definitions/list"
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tags": {
+      "get": {
+        "tags": [
+          "tag"
+        ],
+        "operationId": "findAllTags",
+        "parameters": [
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "the numbers of tags to return",
+            "name": "limit",
+            "in": "query"
+          },
+          {
+            "type": "integer",
+            "format": "int64",
+            "description": "return tags given the offset, it should usually set together with limit",
+            "name": "offset",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "return tags partially matching given value",
+            "name": "value_like",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "list all the tags",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/tag"
+              }
+            }
+          },
+          "default": {
+            "description": "generic error response",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    }
+  },
+  "definitions": {
+    "activationWindow": {
+      "description": "limits when a flag or a segment is active. startAt and endAt bound the window in absolute time. weekdays, startTime and endTime make it recurring in the timezone, e.g. mon-fri 09:00-17:00 in Europe/Berlin. A startTime after the endTime spans midnight. Empty fields don't limit the window.",
+      "type": "object",
+      "properties": {
+        "endAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "endTime": {
+          "description": "end time of the day in HH:MM",
+          "type": "string",
+          "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]$"
+        },
+        "startAt": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "startTime": {
+          "description": "start time of the day in HH:MM",
+          "type": "string",
+          "pattern": "^([01][0-9]|2[0-3]):[0-5][0-9]$"
+        },
+        "timezone": {
+          "description": "IANA time zone of weekdays, startTime and endTime, UTC if empty",
+          "type": "string"
+        },
+        "weekdays": {
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "sun",
+              "mon",
+              "tue",
+              "wed",
+              "thu",
+              "fri",
+              "sat"
+            ]
+          }
+        }
+      }
+    },
+    "audience": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "audienceExplanation": {
+      "type": "object",
+      "properties": {
+        "audienceID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintExplanation"
+          }
+        },
+        "key": {
+          "type": "string"
+        },
+        "matched": {
+          "type": "boolean"
+        }
+      }
+    },
+    "constraint": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "group": {
+          "description": "The constraint group within the segment. Constraints of the same group are joined by AND, and the groups are joined by OR. Defaults to 0.\n",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "operator": {
+          "type": "string",
+          "minLength": 1,
+          "enum": [
+            "EQ",
+            "NEQ",
+            "LT",
+            "LTE",
+            "GT",
+            "GTE",
+            "EREG",
+            "NEREG",
+            "IN",
+            "NOTIN",
+            "CONTAINS",
+            "NOTCONTAINS",
+            "SEMVER_EQ",
+            "SEMVER_LT",
+            "SEMVER_LTE",
+            "SEMVER_GT",
+            "SEMVER_GTE",
+            "BEFORE",
+            "AFTER",
+            "IN_LIST",
+            "NOT_IN_LIST",
+            "IN_CIDR",
+            "NOT_IN_CIDR",
+            "STARTS_WITH",
+            "ENDS_WITH",
+            "EQ_IGNORE_CASE",
+            "IN_IGNORE_CASE",
+            "CONTAINS_IGNORE_CASE"
+          ]
+        },
+        "property": {
+          "description": "The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., ` + "`" + `user.name` + "`" + `) for nested objects and brackets (e.g., ` + "`" + `users[0]` + "`" + `) for array indices.\n",
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "constraintExplanation": {
+      "type": "object",
+      "properties": {
+        "constraintID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "error": {
+          "type": "string"
+        },
+        "group": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "matched": {
+          "type": "boolean"
+        },
+        "operator": {
+          "type": "string"
+        },
+        "property": {
+          "type": "string"
+        },
+        "resolveError": {
+          "description": "the reason the property could not be resolved",
+          "type": "string"
+        },
+        "resolvedValue": {
+          "description": "the value of the property in the entityContext"
+        },
+        "value": {
+          "type": "string"
+        }
+      }
+    },
+    "createAudienceRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the audience",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createConstraintRequest": {
+      "type": "object",
+      "required": [
+        "property",
+        "operator",
+        "value"
+      ],
+      "properties": {
+        "group": {
+          "description": "The constraint group within the segment. Constraints of the same group are joined by AND, and the groups are joined by OR. Defaults to 0.\n",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "operator": {
+          "type": "string",
+          "minLength": 1
+        },
+        "property": {
+          "description": "The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., ` + "`" + `user.name` + "`" + `) for nested objects and brackets (e.g., ` + "`" + `users[0]` + "`" + `) for array indices.\n",
+          "type": "string",
+          "minLength": 1
+        },
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createFlagRequest": {
+      "type": "object",
+      "required": [
+        "description"
+      ],
+      "properties": {
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string"
+        },
+        "template": {
+          "description": "template for flag creation",
+          "type": "string"
+        }
+      }
+    },
+    "createHoldoutRequest": {
+      "type": "object",
+      "required": [
+        "key",
+        "percent"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the holdout",
+          "type": "string",
+          "minLength": 1
+        },
+        "percent": {
+          "description": "percent of the entities in the holdout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0
+        }
+      }
+    },
+    "createLayerRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the layer",
+          "type": "string",
+          "minLength": 1
+        },
+        "slots": {
+          "description": "number of slots the entities are hashed into",
+          "type": "integer",
+          "format": "int64",
+          "default": 1000,
+          "minimum": 1
+        }
+      }
+    },
+    "createListRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "key": {
+          "description": "unique key representation of the list",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createRolloutScheduleRequest": {
+      "type": "object",
+      "required": [
+        "steps"
+      ],
+      "properties": {
+        "linear": {
+          "description": "interpolate the rollout percent linearly between the steps",
+          "type": "boolean"
+        },
+        "steps": {
+          "description": "steps in chronological order. A linear ramp from start to end is two steps with linear set to true.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/rolloutScheduleStep"
+          }
+        }
+      }
+    },
+    "createSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceIDs": {
+          "description": "IDs of the audiences the entity has to match in addition to the constraints of the segment.",
+          "type": "array",
+          "items": {
             "type": "integer",
             "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
+            "minimum": 1
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "deleted"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "rolloutPercent": {
+          "description": "percent of the matching entities in the rollout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0
+        }
+      }
+    },
+    "createTagRequest": {
+      "type": "object",
+      "required": [
+        "value"
+      ],
+      "properties": {
+        "value": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createTargetRequest": {
+      "type": "object",
+      "required": [
+        "entityID",
+        "variantKey"
+      ],
+      "properties": {
+        "entityID": {
+          "type": "string",
+          "maxLength": 255,
+          "minLength": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "createVariantRequest": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "attachment": {
+          "type": "object"
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "datarDayEntry": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "date": {
+          "type": "string",
+          "format": "date"
+        }
+      }
+    },
+    "datarFlagSummaryResponse": {
+      "type": "object",
+      "properties": {
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "trafficByDay": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarDayEntry"
+          }
+        },
+        "trafficByHoldout": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarHoldoutEntry"
+          }
+        },
+        "trafficBySegment": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarSegmentEntry"
+          }
+        },
+        "trafficByVariant": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarVariantEntry"
           }
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints": {
-      "get": {
-        "tags": [
-          "constraint"
-        ],
-        "operationId": "findConstraints",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "constraints under the segment",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/constraint"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "datarHoldoutEntry": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "holdoutID": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "datarSegmentEntry": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "datarSummaryFlag": {
+      "type": "object",
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "lastEvaluatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "totalEvalCount": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "datarSummaryResponse": {
+      "type": "object",
+      "properties": {
+        "flags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/datarSummaryFlag"
           }
         }
-      },
-      "post": {
-        "tags": [
-          "constraint"
-        ],
-        "operationId": "createConstraint",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a constraint",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "the constraint created",
-            "schema": {
-              "$ref": "#/definitions/constraint"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "datarVariantEntry": {
+      "type": "object",
+      "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}": {
-      "put": {
-        "tags": [
-          "constraint"
-        ],
-        "operationId": "putConstraint",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a constraint",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createConstraintRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "constraint just updated",
-            "schema": {
-              "$ref": "#/definitions/constraint"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "distribution": {
+      "type": "object",
+      "required": [
+        "percent",
+        "variantID",
+        "variantKey"
+      ],
+      "properties": {
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "percent": {
+          "description": "percent of the rolled out entities given the variant, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "minLength": 1
         }
-      },
-      "delete": {
-        "tags": [
-          "constraint"
-        ],
-        "operationId": "deleteConstraint",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the constraint",
-            "name": "constraintID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "deleted"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "entityAttribute": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "allowedValues": {
+          "description": "the values the attribute can take, any value if empty",
+          "type": "array",
+          "items": {}
+        },
+        "name": {
+          "description": "property of the entity context, nested properties like device.os are supported",
+          "type": "string",
+          "minLength": 1
+        },
+        "required": {
+          "type": "boolean"
+        },
+        "type": {
+          "description": "the type of the attribute, any type if empty",
+          "type": "string",
+          "enum": [
+            "string",
+            "number",
+            "boolean",
+            "object",
+            "array"
+          ]
         }
       }
     },
-    "/flags/{flagID}/segments/{segmentID}/distributions": {
-      "get": {
-        "tags": [
-          "distribution"
-        ],
-        "operationId": "findDistributions",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
+    "entityTypeSchema": {
+      "type": "object",
+      "required": [
+        "entityType",
+        "attributes"
+      ],
+      "properties": {
+        "attributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityAttribute"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "distribution under the segment",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
-            }
+        },
+        "entityType": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "evalContext": {
+      "type": "object",
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        },
+        "flagID": {
+          "description": "flagID",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "flagKey": {
+          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
+          "type": "string"
+        },
+        "flagTags": {
+          "description": "flagTags. flagTags looks up flags by tag. Either works.",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "x-omitempty": true
+        },
+        "flagTagsOperator": {
+          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
+        }
+      }
+    },
+    "evalDebugLog": {
+      "type": "object",
+      "properties": {
+        "msg": {
+          "type": "string"
+        },
+        "segmentDebugLogs": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentDebugLog"
           }
         }
-      },
-      "put": {
-        "description": "replace the distribution with the new setting",
-        "tags": [
-          "distribution"
-        ],
-        "operationId": "putDistributions",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the segment",
-            "name": "segmentID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "array of distributions",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putDistributionsRequest"
-            }
+      }
+    },
+    "evalExplanation": {
+      "type": "object",
+      "properties": {
+        "bucketKey": {
+          "description": "the value the entity is bucketed by, see evalResult",
+          "type": "string"
+        },
+        "defaultVariant": {
+          "description": "the variant is the default variant of the flag",
+          "type": "boolean"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "reason": {
+          "description": "the final reason of the evaluation. SEGMENT_MATCH if the entity is rolled out in the first segment it matched, NOT_ROLLED_OUT if it matched a segment but is outside of its rollout.",
+          "type": "string",
+          "enum": [
+            "FLAG_NOT_FOUND",
+            "FLAG_DISABLED",
+            "FLAG_NOT_ACTIVE",
+            "INDIVIDUAL_TARGET",
+            "NO_SEGMENTS",
+            "LAYER_SLOT_NOT_OWNED",
+            "PREREQUISITE_NOT_MET",
+            "HOLDOUT",
+            "SEGMENT_MATCH",
+            "NOT_ROLLED_OUT",
+            "NO_SEGMENT_MATCH"
+          ]
+        },
+        "segmentID": {
+          "description": "the segment the entity matched, 0 if none",
+          "type": "integer",
+          "format": "int64"
+        },
+        "segments": {
+          "description": "the segments of the flag in evaluation order",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segmentExplanation"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "distribution under the segment",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/distribution"
-              }
-            }
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
+        }
+      }
+    },
+    "evalResult": {
+      "type": "object",
+      "properties": {
+        "bucketKey": {
+          "description": "the value the entity is bucketed by. It's the value of the flag's bucketBy attribute, or the entityID if the flag has no bucketBy or the attribute is missing from the entityContext.",
+          "type": "string"
+        },
+        "dataRecordsEnabled": {
+          "description": "flag's data records status.",
+          "type": "boolean",
+          "x-omitempty": false
+        },
+        "defaultVariant": {
+          "description": "the variant is the default variant of the flag, because the flag is disabled or not active, or no segment matched.",
+          "type": "boolean"
+        },
+        "evalContext": {
+          "$ref": "#/definitions/evalContext"
+        },
+        "evalDebugLog": {
+          "$ref": "#/definitions/evalDebugLog"
+        },
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "flagSnapshotID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagTags": {
+          "description": "flagTags. flagTags looks up flags by tag. Either works.",
+          "type": "array",
+          "items": {
+            "type": "string"
           },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+          "x-omitempty": true
+        },
+        "holdoutID": {
+          "description": "the holdout the entity is in. The entity got the holdout variant of the flag instead of being evaluated by its segments.",
+          "type": "integer",
+          "format": "int64"
+        },
+        "holdoutKey": {
+          "type": "string"
+        },
+        "individualTarget": {
+          "description": "the entity is individually targeted to the variant, the segments of the flag were not evaluated.",
+          "type": "boolean"
+        },
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "timestamp": {
+          "type": "string"
+        },
+        "variantAttachment": {
+          "type": "object"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
+          "type": "string"
         }
       }
     },
-    "/flags/{flagID}/snapshots": {
-      "get": {
-        "tags": [
-          "flag"
-        ],
-        "operationId": "getFlagSnapshots",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag to get",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "the number of snapshots to return",
-            "name": "limit",
-            "in": "query"
-          },
-          {
+    "evaluationBatchRequest": {
+      "type": "object",
+      "required": [
+        "entities"
+      ],
+      "properties": {
+        "enableDebug": {
+          "type": "boolean"
+        },
+        "entities": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
+        },
+        "flagIDs": {
+          "description": "flagIDs",
+          "type": "array",
+          "minItems": 1,
+          "items": {
             "type": "integer",
             "format": "int64",
-            "description": "return snapshots given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "enum": [
-              "ASC",
-              "DESC"
-            ],
+            "minimum": 1
+          }
+        },
+        "flagKeys": {
+          "description": "flagKeys. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
             "type": "string",
-            "description": "sort order",
-            "name": "sort",
-            "in": "query"
+            "minLength": 1
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "returns the flag snapshots",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/flagSnapshot"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "flagTags": {
+          "description": "flagTags. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
           }
+        },
+        "flagTagsOperator": {
+          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
+          "type": "string",
+          "default": "ANY",
+          "enum": [
+            "ANY",
+            "ALL"
+          ]
         }
       }
     },
-    "/flags/{flagID}/tags": {
-      "get": {
-        "tags": [
-          "tag"
-        ],
-        "operationId": "findTags",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "tag ordered by tagID",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/tag"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "evaluationBatchResponse": {
+      "type": "object",
+      "required": [
+        "evaluationResults"
+      ],
+      "properties": {
+        "evaluationResults": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/evalResult"
           }
         }
-      },
-      "post": {
-        "tags": [
-          "tag"
-        ],
-        "operationId": "createTag",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a tag",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createTagRequest"
-            }
+      }
+    },
+    "evaluationEntity": {
+      "type": "object",
+      "properties": {
+        "entityContext": {
+          "type": "object"
+        },
+        "entityID": {
+          "type": "string"
+        },
+        "entityType": {
+          "type": "string"
+        }
+      }
+    },
+    "flag": {
+      "type": "object",
+      "required": [
+        "description",
+        "enabled",
+        "dataRecordsEnabled"
+      ],
+      "properties": {
+        "activationWindow": {
+          "$ref": "#/definitions/activationWindow"
+        },
+        "attachmentSchema": {
+          "description": "JSON Schema (draft 4) that the attachments of the variants must conform to. Empty if the attachments are not validated.",
+          "type": "object"
+        },
+        "bucketBy": {
+          "description": "entityContext attribute, e.g. company_id, that is hashed instead of the entityID to bucket the entity. The entityID is used if it's empty or the attribute is missing.",
+          "type": "string"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean"
+        },
+        "defaultVariantID": {
+          "description": "variant returned when the flag is disabled or not active, or no segment matched. 0 if the flag has no default variant.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
+        },
+        "defaultVariantKey": {
+          "type": "string",
+          "readOnly": true
+        },
+        "description": {
+          "type": "string",
+          "minLength": 1
+        },
+        "enabled": {
+          "type": "boolean"
+        },
+        "entityType": {
+          "description": "it will override the entityType in the evaluation logs if it's not empty",
+          "type": "string"
+        },
+        "holdout": {
+          "$ref": "#/definitions/flagHoldout"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the flag",
+          "type": "string",
+          "minLength": 1
+        },
+        "layer": {
+          "$ref": "#/definitions/flagLayer"
+        },
+        "notes": {
+          "description": "flag usage details in markdown format",
+          "type": "string"
+        },
+        "prerequisites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/flagPrerequisite"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "tag just created",
-            "schema": {
-              "$ref": "#/definitions/tag"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "salt": {
+          "description": "salt of the hash that buckets the entities. The flag ID is used if it's empty. Changing it re-randomizes which entities get which variant.",
+          "type": "string",
+          "maxLength": 64
+        },
+        "segments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/segment"
           }
-        }
-      }
-    },
-    "/flags/{flagID}/tags/{tagID}": {
-      "delete": {
-        "tags": [
-          "tag"
-        ],
-        "operationId": "deleteTag",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the tag",
-            "name": "tagID",
-            "in": "path",
-            "required": true
+        },
+        "tags": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/tag"
           }
-        ],
-        "responses": {
-          "200": {
-            "description": "deleted"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+        },
+        "targets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/target"
+          }
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedBy": {
+          "type": "string"
+        },
+        "variants": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/variant"
           }
         }
       }
     },
-    "/flags/{flagID}/variants": {
-      "get": {
-        "tags": [
-          "variant"
-        ],
-        "operationId": "findVariants",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "variant ordered by variantID",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/variant"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "post": {
-        "tags": [
-          "variant"
-        ],
-        "operationId": "createVariant",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "create a variant",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/createVariantRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "variant just created",
-            "schema": {
-              "$ref": "#/definitions/variant"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "flagHoldout": {
+      "description": "the holdout the flag is in, and the variant for the entities in it",
+      "type": "object",
+      "required": [
+        "holdoutID",
+        "variantID"
+      ],
+      "properties": {
+        "holdoutID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "holdoutKey": {
+          "type": "string",
+          "readOnly": true
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "variantKey": {
+          "type": "string",
+          "readOnly": true
         }
       }
     },
-    "/flags/{flagID}/variants/{variantID}": {
-      "put": {
-        "tags": [
-          "variant"
-        ],
-        "operationId": "putVariant",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "description": "update a variant",
-            "name": "body",
-            "in": "body",
-            "required": true,
-            "schema": {
-              "$ref": "#/definitions/putVariantRequest"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "variant just updated",
-            "schema": {
-              "$ref": "#/definitions/variant"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      },
-      "delete": {
-        "tags": [
-          "variant"
-        ],
-        "operationId": "deleteVariant",
-        "parameters": [
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the flag",
-            "name": "flagID",
-            "in": "path",
-            "required": true
-          },
-          {
-            "minimum": 1,
-            "type": "integer",
-            "format": "int64",
-            "description": "numeric ID of the variant",
-            "name": "variantID",
-            "in": "path",
-            "required": true
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "deleted"
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "flagLayer": {
+      "description": "the slot range of a layer owned by the flag",
+      "type": "object",
+      "required": [
+        "layerID",
+        "slotStart",
+        "slotEnd"
+      ],
+      "properties": {
+        "layerID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "layerKey": {
+          "type": "string",
+          "readOnly": true
+        },
+        "slotEnd": {
+          "description": "the slot after the last one owned by the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "slotStart": {
+          "description": "first slot owned by the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         }
       }
     },
-    "/health": {
-      "get": {
-        "description": "Check if Flagr is healthy",
-        "tags": [
-          "health"
-        ],
-        "operationId": "getHealth",
-        "responses": {
-          "200": {
-            "description": "status of health check",
-            "schema": {
-              "$ref": "#/definitions/health"
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "flagPrerequisite": {
+      "type": "object",
+      "required": [
+        "flagKey",
+        "variantKeys"
+      ],
+      "properties": {
+        "flagKey": {
+          "description": "key of the prerequisite flag",
+          "type": "string",
+          "minLength": 1
+        },
+        "variantKeys": {
+          "description": "the prerequisite is met if the prerequisite flag evaluates to any of the variant keys",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
           }
         }
       }
     },
-    "/tags": {
-      "get": {
-        "tags": [
-          "tag"
-        ],
-        "operationId": "findAllTags",
-        "parameters": [
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "the numbers of tags to return",
-            "name": "limit",
-            "in": "query"
-          },
-          {
-            "type": "integer",
-            "format": "int64",
-            "description": "return tags given the offset, it should usually set together with limit",
-            "name": "offset",
-            "in": "query"
-          },
-          {
-            "type": "string",
-            "description": "return tags partially matching given value",
-            "name": "value_like",
-            "in": "query"
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "list all the tags",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/tag"
-              }
-            }
-          },
-          "default": {
-            "description": "generic error response",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "flagSnapshot": {
+      "type": "object",
+      "required": [
+        "id",
+        "flag",
+        "updatedAt"
+      ],
+      "properties": {
+        "flag": {
+          "$ref": "#/definitions/flag"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "updatedAt": {
+          "type": "string",
+          "minLength": 1
+        },
+        "updatedBy": {
+          "type": "string"
         }
       }
-    }
-  },
-  "definitions": {
-    "constraint": {
+    },
+    "flagSnapshotMaxID": {
+      "type": "object",
+      "properties": {
+        "maxID": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "health": {
+      "type": "object",
+      "properties": {
+        "status": {
+          "type": "string"
+        }
+      }
+    },
+    "holdout": {
       "type": "object",
       "required": [
-        "property",
-        "operator",
-        "value"
+        "key",
+        "percent"
       ],
       "properties": {
+        "description": {
+          "type": "string"
+        },
         "id": {
           "type": "integer",
           "format": "int64",
           "minimum": 1,
           "readOnly": true
         },
-        "operator": {
+        "key": {
+          "description": "unique key representation of the holdout",
           "type": "string",
-          "minLength": 1,
-          "enum": [
-            "EQ",
-            "NEQ",
-            "LT",
-            "LTE",
-            "GT",
-            "GTE",
-            "EREG",
-            "NEREG",
-            "IN",
-            "NOTIN",
-            "CONTAINS",
-            "NOTCONTAINS"
-          ]
+          "minLength": 1
         },
-        "property": {
-          "description": "The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., ` + "`" + `user.name` + "`" + `) for nested objects and brackets (e.g., ` + "`" + `users[0]` + "`" + `) for array indices.\n",
+        "percent": {
+          "description": "percent of the entities in the holdout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0
+        }
+      }
+    },
+    "layer": {
+      "type": "object",
+      "required": [
+        "key",
+        "slots"
+      ],
+      "properties": {
+        "allocations": {
+          "description": "the slot ranges owned by the flags in the layer, ordered by slotStart",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/layerAllocation"
+          }
+        },
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the layer",
           "type": "string",
           "minLength": 1
         },
-        "value": {
+        "slots": {
+          "description": "number of slots the entities are hashed into",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        }
+      }
+    },
+    "layerAllocation": {
+      "type": "object",
+      "properties": {
+        "flagID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "flagKey": {
+          "type": "string"
+        },
+        "slotEnd": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "slotStart": {
+          "type": "integer",
+          "format": "int64"
+        }
+      }
+    },
+    "list": {
+      "type": "object",
+      "required": [
+        "key"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
+        },
+        "key": {
+          "description": "unique key representation of the list",
           "type": "string",
           "minLength": 1
+        },
+        "size": {
+          "description": "number of the values in the list",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        },
+        "version": {
+          "description": "bumped on every upload of the values",
+          "type": "integer",
+          "format": "int64",
+          "readOnly": true
+        }
+      }
+    },
+    "putAudienceRequest": {
+      "type": "object",
+      "properties": {
+        "constraints": {
+          "description": "replaces all the constraints of the audience. The constraints are kept as is when it's not set.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/createConstraintRequest"
+          }
+        },
+        "description": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "key": {
+          "type": "string",
+          "minLength": 1,
+          "x-nullable": true
         }
       }
     },
-    "createConstraintRequest": {
+    "putDistributionsRequest": {
       "type": "object",
       "required": [
-        "property",
-        "operator",
-        "value"
+        "distributions"
       ],
       "properties": {
-        "operator": {
-          "type": "string",
-          "minLength": 1
-        },
-        "property": {
-          "description": "The property name from the entity context to evaluate. Supports nested field access: use dots (e.g., ` + "`" + `user.name` + "`" + `) for nested objects and brackets (e.g., ` + "`" + `users[0]` + "`" + `) for array indices.\n",
-          "type": "string",
-          "minLength": 1
-        },
-        "value": {
-          "type": "string",
-          "minLength": 1
+        "distributions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
         }
       }
     },
-    "createFlagRequest": {
+    "putEntityTypeSchemaRequest": {
       "type": "object",
       "required": [
-        "description"
+        "attributes"
       ],
       "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
-        },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string"
-        },
-        "template": {
-          "description": "template for flag creation",
-          "type": "string"
+        "attributes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/entityAttribute"
+          }
         }
       }
     },
-    "createSegmentRequest": {
+    "putFlagHoldoutRequest": {
       "type": "object",
       "required": [
-        "description",
-        "rolloutPercent"
+        "holdoutID",
+        "variantID"
       ],
       "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
+        "holdoutID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         },
-        "rolloutPercent": {
+        "variantID": {
+          "description": "the variant of the flag for the entities in the holdout",
           "type": "integer",
           "format": "int64",
-          "maximum": 100,
-          "minimum": 0
+          "minimum": 1
         }
       }
     },
-    "createTagRequest": {
+    "putFlagLayerRequest": {
       "type": "object",
       "required": [
-        "value"
+        "layerID",
+        "slotStart",
+        "slotEnd"
       ],
       "properties": {
-        "value": {
-          "type": "string",
-          "minLength": 1
+        "layerID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "slotEnd": {
+          "description": "the slot after the last one owned by the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
+        },
+        "slotStart": {
+          "description": "first slot owned by the flag",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         }
       }
     },
-    "createVariantRequest": {
+    "putFlagPrerequisitesRequest": {
       "type": "object",
       "required": [
-        "key"
+        "prerequisites"
       ],
       "properties": {
-        "attachment": {
-          "type": "object"
-        },
-        "key": {
-          "type": "string",
-          "minLength": 1
+        "prerequisites": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/flagPrerequisite"
+          }
         }
       }
     },
-    "datarDayEntry": {
+    "putFlagRequest": {
       "type": "object",
       "properties": {
-        "count": {
+        "attachmentSchema": {
+          "description": "JSON Schema (draft 4) that the attachments of the variants must conform to. The existing variants must conform to it as well. An empty object removes the schema.",
+          "type": "object"
+        },
+        "bucketBy": {
+          "description": "entityContext attribute that is hashed instead of the entityID, empty for the entityID",
+          "type": "string",
+          "x-nullable": true
+        },
+        "dataRecordsEnabled": {
+          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "defaultVariantID": {
+          "description": "variant returned when the flag is disabled or not active, or no segment matched. 0 removes the default variant.",
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "minimum": 0,
+          "x-nullable": true
         },
-        "date": {
+        "description": {
           "type": "string",
-          "format": "date"
+          "minLength": 1,
+          "x-nullable": true
+        },
+        "enabled": {
+          "type": "boolean",
+          "x-nullable": true
+        },
+        "entityType": {
+          "description": "it will overwrite entityType into evaluation logs if it's not empty",
+          "type": "string",
+          "x-nullable": true
+        },
+        "key": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "notes": {
+          "type": "string",
+          "x-nullable": true
+        },
+        "salt": {
+          "description": "salt of the hash that buckets the entities, empty for the flag ID. Set a new value to re-randomize the buckets, e.g. before re-running an experiment.",
+          "type": "string",
+          "maxLength": 64,
+          "x-nullable": true
         }
       }
     },
-    "datarFlagSummaryResponse": {
+    "putHoldoutRequest": {
       "type": "object",
       "properties": {
-        "flagID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "trafficByDay": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/datarDayEntry"
-          }
-        },
-        "trafficBySegment": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/datarSegmentEntry"
-          }
+        "description": {
+          "type": "string",
+          "x-nullable": true
         },
-        "trafficByVariant": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/datarVariantEntry"
-          }
+        "percent": {
+          "description": "percent of the entities in the holdout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0,
+          "x-nullable": true
         }
       }
     },
-    "datarSegmentEntry": {
+    "putLayerRequest": {
       "type": "object",
       "properties": {
-        "count": {
-          "type": "integer",
-          "format": "int64"
+        "description": {
+          "type": "string",
+          "x-nullable": true
         },
-        "segmentID": {
+        "slots": {
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "minimum": 1,
+          "x-nullable": true
         }
       }
     },
-    "datarSummaryFlag": {
+    "putListRequest": {
       "type": "object",
       "properties": {
         "description": {
-          "type": "string"
-        },
-        "enabled": {
-          "type": "boolean"
-        },
-        "flagID": {
-          "type": "integer",
-          "format": "int64"
-        },
-        "flagKey": {
-          "type": "string"
+          "type": "string",
+          "x-nullable": true
+        }
+      }
+    },
+    "putSegmentReorderRequest": {
+      "type": "object",
+      "required": [
+        "segmentIDs"
+      ],
+      "properties": {
+        "segmentIDs": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
+        }
+      }
+    },
+    "putSegmentRequest": {
+      "type": "object",
+      "required": [
+        "description",
+        "rolloutPercent"
+      ],
+      "properties": {
+        "audienceIDs": {
+          "description": "IDs of the audiences the entity has to match in addition to the constraints of the segment. The audiences are kept as is when it's not set, and removed when it's an empty array.",
+          "type": "array",
+          "items": {
+            "type": "integer",
+            "format": "int64",
+            "minimum": 1
+          }
         },
-        "lastEvaluatedAt": {
+        "description": {
           "type": "string",
-          "format": "date-time"
+          "minLength": 1
         },
-        "totalEvalCount": {
-          "type": "integer",
-          "format": "int64"
+        "rolloutPercent": {
+          "description": "percent of the matching entities in the rollout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0
         }
       }
     },
-    "datarSummaryResponse": {
+    "putTargetsRequest": {
       "type": "object",
+      "required": [
+        "targets"
+      ],
       "properties": {
-        "flags": {
+        "targets": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/datarSummaryFlag"
+            "$ref": "#/definitions/target"
           }
         }
       }
     },
-    "datarVariantEntry": {
+    "putVariantRequest": {
       "type": "object",
+      "required": [
+        "key"
+      ],
       "properties": {
-        "count": {
-          "type": "integer",
-          "format": "int64"
+        "attachment": {
+          "type": "object"
         },
-        "variantID": {
-          "type": "integer",
-          "format": "int64"
+        "key": {
+          "type": "string",
+          "minLength": 1
         }
       }
     },
-    "distribution": {
+    "rolloutSchedule": {
       "type": "object",
       "required": [
-        "percent",
-        "variantID",
-        "variantKey"
+        "steps",
+        "linear",
+        "status"
       ],
       "properties": {
+        "appliedPercent": {
+          "description": "the last rollout percent applied by the scheduler",
+          "type": "number",
+          "format": "double",
+          "x-nullable": true
+        },
+        "createdBy": {
+          "type": "string"
+        },
         "id": {
           "type": "integer",
           "format": "int64",
           "minimum": 1,
           "readOnly": true
         },
-        "percent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100,
-          "minimum": 0
+        "linear": {
+          "description": "interpolate the rollout percent linearly between the steps",
+          "type": "boolean"
         },
-        "variantID": {
+        "segmentID": {
           "type": "integer",
           "format": "int64",
           "minimum": 1
         },
-        "variantKey": {
+        "status": {
           "type": "string",
-          "minLength": 1
+          "enum": [
+            "active",
+            "paused",
+            "completed",
+            "canceled"
+          ]
+        },
+        "steps": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/rolloutScheduleStep"
+          }
+        },
+        "updatedAt": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "updatedBy": {
+          "type": "string"
         }
       }
     },
-    "error": {
+    "rolloutScheduleStep": {
       "type": "object",
       "required": [
-        "message"
+        "at",
+        "percent"
       ],
       "properties": {
-        "message": {
+        "at": {
           "type": "string",
-          "minLength": 1
+          "format": "date-time"
+        },
+        "percent": {
+          "description": "rollout percent of the segment from the time of the step on, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0
         }
       }
     },
-    "evalContext": {
+    "segment": {
       "type": "object",
+      "required": [
+        "description",
+        "rank",
+        "rolloutPercent"
+      ],
       "properties": {
-        "enableDebug": {
-          "type": "boolean"
+        "activationWindow": {
+          "$ref": "#/definitions/activationWindow"
         },
-        "entityContext": {
-          "type": "object"
+        "audiences": {
+          "description": "the audiences the entity has to match in addition to the constraints",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audience"
+          }
         },
-        "entityID": {
-          "description": "entityID is used to deterministically at random to evaluate the flag result. If it's empty, flagr will randomly generate one.",
-          "type": "string"
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraint"
+          }
         },
-        "entityType": {
-          "type": "string"
+        "description": {
+          "type": "string",
+          "minLength": 1
         },
-        "flagID": {
-          "description": "flagID",
+        "distributions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/distribution"
+          }
+        },
+        "id": {
           "type": "integer",
           "format": "int64",
-          "minimum": 1
-        },
-        "flagKey": {
-          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
-          "type": "string"
+          "minimum": 1,
+          "readOnly": true
         },
-        "flagTags": {
-          "description": "flagTags. flagTags looks up flags by tag. Either works.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-omitempty": true
+        "rank": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 0
         },
-        "flagTagsOperator": {
-          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
-          "type": "string",
-          "default": "ANY",
-          "enum": [
-            "ANY",
-            "ALL"
-          ]
+        "rolloutPercent": {
+          "description": "percent of the matching entities in the rollout, in steps of 0.1",
+          "type": "number",
+          "format": "double",
+          "maximum": 100,
+          "minimum": 0
         }
       }
     },
-    "evalDebugLog": {
+    "segmentDebugLog": {
       "type": "object",
       "properties": {
         "msg": {
           "type": "string"
         },
-        "segmentDebugLogs": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/segmentDebugLog"
-          }
+        "segmentID": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         }
       }
     },
-    "evalResult": {
+    "segmentExplanation": {
       "type": "object",
       "properties": {
-        "dataRecordsEnabled": {
-          "description": "flag's data records status.",
-          "type": "boolean",
-          "x-omitempty": false
-        },
-        "evalContext": {
-          "$ref": "#/definitions/evalContext"
+        "active": {
+          "description": "the segment is within its activation window",
+          "type": "boolean"
         },
-        "evalDebugLog": {
-          "$ref": "#/definitions/evalDebugLog"
+        "audiences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/audienceExplanation"
+          }
         },
-        "flagID": {
+        "bucketNum": {
+          "description": "the bucket of the entity computed from the bucket key and the salt, set if the segment matched",
           "type": "integer",
-          "format": "int64"
+          "format": "int64",
+          "x-nullable": true
         },
-        "flagKey": {
+        "constraints": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/constraintExplanation"
+          }
+        },
+        "description": {
           "type": "string"
         },
-        "flagSnapshotID": {
+        "evaluated": {
+          "description": "the segment was evaluated. The segments after the first one matched are not evaluated.",
+          "type": "boolean"
+        },
+        "matched": {
+          "description": "the entity matched the constraints and the audiences of the segment",
+          "type": "boolean"
+        },
+        "message": {
+          "type": "string"
+        },
+        "rank": {
           "type": "integer",
           "format": "int64"
         },
-        "flagTags": {
-          "description": "flagTags. flagTags looks up flags by tag. Either works.",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "x-omitempty": true
+        "rolledOut": {
+          "description": "the entity is within the rollout of the segment",
+          "type": "boolean"
+        },
+        "rolloutPercent": {
+          "type": "number",
+          "format": "double"
         },
         "segmentID": {
           "type": "integer",
           "format": "int64"
         },
-        "timestamp": {
-          "type": "string"
-        },
-        "variantAttachment": {
-          "type": "object"
-        },
         "variantID": {
           "type": "integer",
           "format": "int64"
@@ -4541,82 +10523,47 @@ func init() {
         }
       }
     },
-    "evaluationBatchRequest": {
+    "setFlagEnabledRequest": {
       "type": "object",
       "required": [
-        "entities"
+        "enabled"
       ],
       "properties": {
-        "enableDebug": {
+        "enabled": {
           "type": "boolean"
-        },
-        "entities": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "$ref": "#/definitions/evaluationEntity"
-          }
-        },
-        "flagIDs": {
-          "description": "flagIDs",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "integer",
-            "format": "int64",
-            "minimum": 1
-          }
-        },
-        "flagKeys": {
-          "description": "flagKeys. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "string",
-            "minLength": 1
-          }
-        },
-        "flagTags": {
-          "description": "flagTags. Either flagIDs, flagKeys or flagTags works. If pass in multiples, Flagr may return duplicate results.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "string",
-            "minLength": 1
-          }
-        },
-        "flagTagsOperator": {
-          "description": "determine how flagTags is used to filter flags to be evaluated. OR extends the evaluation to those which contains at least one of the provided flagTags or AND limit the evaluation to those which contains all the flagTags.",
-          "type": "string",
-          "default": "ANY",
-          "enum": [
-            "ANY",
-            "ALL"
-          ]
         }
       }
     },
-    "evaluationBatchResponse": {
+    "setRolloutSchedulePausedRequest": {
       "type": "object",
       "required": [
-        "evaluationResults"
+        "paused"
       ],
       "properties": {
-        "evaluationResults": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/evalResult"
-          }
+        "paused": {
+          "type": "boolean"
         }
       }
     },
-    "evaluationEntity": {
+    "simulationGenerate": {
+      "description": "entities with generated entityIDs, added to the sample",
       "type": "object",
+      "required": [
+        "count"
+      ],
       "properties": {
+        "count": {
+          "type": "integer",
+          "format": "int64",
+          "maximum": 100000,
+          "minimum": 1
+        },
         "entityContext": {
+          "description": "entity context of all the generated entities",
           "type": "object"
         },
-        "entityID": {
+        "entityIDPrefix": {
+          "description": "prefix of the generated entityIDs, which are the prefix followed by a sequence number",
           "type": "string"
         },
         "entityType": {
@@ -4624,294 +10571,252 @@ func init() {
         }
       }
     },
-    "flag": {
+    "simulationRequest": {
       "type": "object",
-      "required": [
-        "description",
-        "enabled",
-        "dataRecordsEnabled"
-      ],
       "properties": {
-        "createdBy": {
-          "type": "string"
+        "draft": {
+          "description": "proposed version of the flag, in the JSON flag spec of the evaluation cache export. The live flag is simulated if it's empty.",
+          "type": "object"
         },
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean"
+        "entities": {
+          "description": "the sample of entities to simulate",
+          "type": "array",
+          "maxItems": 10000,
+          "items": {
+            "$ref": "#/definitions/evaluationEntity"
+          }
         },
-        "description": {
-          "type": "string",
-          "minLength": 1
+        "flagID": {
+          "description": "the live flag to compare with",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         },
-        "enabled": {
-          "type": "boolean"
+        "flagKey": {
+          "description": "flagKey. flagID or flagKey will resolve to the same flag. Either works.",
+          "type": "string"
+        },
+        "generate": {
+          "$ref": "#/definitions/simulationGenerate"
+        }
+      }
+    },
+    "simulationResult": {
+      "type": "object",
+      "properties": {
+        "changed": {
+          "description": "the number of entities whose variant differs from the live flag",
+          "type": "integer",
+          "format": "int64"
         },
-        "entityType": {
-          "description": "it will override the entityType in the evaluation logs if it's not empty",
-          "type": "string"
+        "draft": {
+          "description": "a draft was simulated instead of the live flag",
+          "type": "boolean"
         },
-        "id": {
+        "flagID": {
           "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
-        },
-        "key": {
-          "description": "unique key representation of the flag",
-          "type": "string",
-          "minLength": 1
+          "format": "int64"
         },
-        "notes": {
-          "description": "flag usage details in markdown format",
+        "flagKey": {
           "type": "string"
         },
+        "liveUnassigned": {
+          "description": "the number of entities without a variant in the live flag",
+          "type": "integer",
+          "format": "int64"
+        },
         "segments": {
+          "description": "the segments of the simulated flag in evaluation order",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/segment"
+            "$ref": "#/definitions/simulationSegmentCount"
           }
         },
-        "tags": {
+        "total": {
+          "description": "the number of simulated entities",
+          "type": "integer",
+          "format": "int64"
+        },
+        "transitions": {
+          "description": "the number of entities moving from a variant of the live flag to another variant, an empty variantKey means no variant",
           "type": "array",
           "items": {
-            "$ref": "#/definitions/tag"
+            "$ref": "#/definitions/simulationTransition"
           }
         },
-        "updatedAt": {
-          "type": "string",
-          "format": "date-time"
-        },
-        "updatedBy": {
-          "type": "string"
+        "unassigned": {
+          "description": "the number of entities without a variant",
+          "type": "integer",
+          "format": "int64"
         },
         "variants": {
           "type": "array",
           "items": {
-            "$ref": "#/definitions/variant"
+            "$ref": "#/definitions/simulationVariantCount"
           }
         }
       }
     },
-    "flagSnapshot": {
+    "simulationSegmentCount": {
       "type": "object",
-      "required": [
-        "id",
-        "flag",
-        "updatedAt"
-      ],
       "properties": {
-        "flag": {
-          "$ref": "#/definitions/flag"
+        "description": {
+          "type": "string"
         },
-        "id": {
+        "matched": {
+          "description": "the number of entities for which the segment is the first one that matched",
           "type": "integer",
-          "format": "int64",
-          "minimum": 1,
-          "readOnly": true
+          "format": "int64"
         },
-        "updatedAt": {
-          "type": "string",
-          "minLength": 1
+        "rolledOut": {
+          "description": "the number of matched entities that are in the rollout of the segment",
+          "type": "integer",
+          "format": "int64"
         },
-        "updatedBy": {
-          "type": "string"
+        "segmentID": {
+          "type": "integer",
+          "format": "int64"
         }
       }
     },
-    "flagSnapshotMaxID": {
+    "simulationTransition": {
       "type": "object",
       "properties": {
-        "maxID": {
+        "count": {
           "type": "integer",
           "format": "int64"
+        },
+        "fromVariantKey": {
+          "type": "string"
+        },
+        "toVariantKey": {
+          "type": "string"
         }
       }
     },
-    "health": {
+    "simulationVariantCount": {
       "type": "object",
       "properties": {
-        "status": {
+        "count": {
+          "description": "the number of entities that get the variant",
+          "type": "integer",
+          "format": "int64"
+        },
+        "liveCount": {
+          "description": "the number of entities that get the variant in the live flag",
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantID": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "variantKey": {
           "type": "string"
         }
       }
     },
-    "putDistributionsRequest": {
+    "snapshotEvaluationRequest": {
       "type": "object",
       "required": [
-        "distributions"
+        "entityID"
       ],
       "properties": {
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
-          }
-        }
-      }
-    },
-    "putFlagRequest": {
-      "type": "object",
-      "properties": {
-        "dataRecordsEnabled": {
-          "description": "enabled data records will get data logging in the metrics pipeline, for example, kafka.",
-          "type": "boolean",
-          "x-nullable": true
+        "enableDebug": {
+          "type": "boolean"
         },
-        "description": {
-          "type": "string",
-          "minLength": 1,
-          "x-nullable": true
+        "entityContext": {
+          "type": "object"
         },
-        "enabled": {
-          "type": "boolean",
-          "x-nullable": true
+        "entityID": {
+          "type": "string",
+          "minLength": 1
         },
         "entityType": {
-          "description": "it will overwrite entityType into evaluation logs if it's not empty",
-          "type": "string",
-          "x-nullable": true
+          "type": "string"
         },
-        "key": {
-          "type": "string",
-          "x-nullable": true
+        "flagSnapshotID": {
+          "description": "the snapshot to evaluate. Either flagSnapshotID or timestamp works.",
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1
         },
-        "notes": {
+        "timestamp": {
+          "description": "evaluates the latest snapshot of the flag taken at or before the timestamp",
           "type": "string",
-          "x-nullable": true
-        }
-      }
-    },
-    "putSegmentReorderRequest": {
-      "type": "object",
-      "required": [
-        "segmentIDs"
-      ],
-      "properties": {
-        "segmentIDs": {
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "type": "integer",
-            "format": "int64",
-            "minimum": 1
-          }
+          "format": "date-time"
         }
       }
     },
-    "putSegmentRequest": {
+    "snapshotEvaluationResult": {
       "type": "object",
-      "required": [
-        "description",
-        "rolloutPercent"
-      ],
       "properties": {
-        "description": {
-          "type": "string",
-          "minLength": 1
+        "currentResult": {
+          "description": "the evaluation against the current flag in the evaluation cache",
+          "$ref": "#/definitions/evalResult"
         },
-        "rolloutPercent": {
+        "flagSnapshotID": {
           "type": "integer",
-          "format": "int64",
-          "maximum": 100,
-          "minimum": 0
+          "format": "int64"
+        },
+        "snapshotResult": {
+          "description": "the evaluation against the flag of the snapshot",
+          "$ref": "#/definitions/evalResult"
+        },
+        "snapshotUpdatedAt": {
+          "type": "string"
+        },
+        "snapshotUpdatedBy": {
+          "type": "string"
+        },
+        "variantChanged": {
+          "description": "the entity gets a different variant from the current flag",
+          "type": "boolean"
         }
       }
     },
-    "putVariantRequest": {
+    "tag": {
       "type": "object",
       "required": [
-        "key"
+        "value"
       ],
       "properties": {
-        "attachment": {
-          "type": "object"
+        "id": {
+          "type": "integer",
+          "format": "int64",
+          "minimum": 1,
+          "readOnly": true
         },
-        "key": {
+        "value": {
           "type": "string",
           "minLength": 1
         }
       }
     },
-    "segment": {
+    "target": {
       "type": "object",
       "required": [
-        "description",
-        "rank",
-        "rolloutPercent"
+        "entityID",
+        "variantKey"
       ],
       "properties": {
-        "constraints": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/constraint"
-          }
-        },
-        "description": {
+        "entityID": {
           "type": "string",
+          "maxLength": 255,
           "minLength": 1
         },
-        "distributions": {
-          "type": "array",
-          "items": {
-            "$ref": "#/definitions/distribution"
-          }
-        },
         "id": {
           "type": "integer",
           "format": "int64",
           "minimum": 1,
           "readOnly": true
         },
-        "rank": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 0
-        },
-        "rolloutPercent": {
-          "type": "integer",
-          "format": "int64",
-          "maximum": 100,
-          "minimum": 0
-        }
-      }
-    },
-    "segmentDebugLog": {
-      "type": "object",
-      "properties": {
-        "msg": {
-          "type": "string"
-        },
-        "segmentID": {
-          "type": "integer",
-          "format": "int64",
-          "minimum": 1
-        }
-      }
-    },
-    "setFlagEnabledRequest": {
-      "type": "object",
-      "required": [
-        "enabled"
-      ],
-      "properties": {
-        "enabled": {
-          "type": "boolean"
-        }
-      }
-    },
-    "tag": {
-      "type": "object",
-      "required": [
-        "value"
-      ],
-      "properties": {
-        "id": {
+        "variantID": {
           "type": "integer",
           "format": "int64",
-          "minimum": 1,
           "readOnly": true
         },
-        "value": {
+        "variantKey": {
           "type": "string",
           "minLength": 1
         }
@@ -4952,6 +10857,22 @@ func init() {
       "description": "Constraint is the unit of defining a small subset of users",
       "name": "constraint"
     },
+    {
+      "description": "Audience is a named set of constraints shared by segments of different flags",
+      "name": "audience"
+    },
+    {
+      "description": "Layer is a bucket space shared by mutually exclusive flags",
+      "name": "layer"
+    },
+    {
+      "description": "Holdout is a share of the entities excluded from the experiments of all its flags",
+      "name": "holdout"
+    },
+    {
+      "description": "List is an uploaded set of values referenced by the IN_LIST and NOT_IN_LIST constraints",
+      "name": "list"
+    },
     {
       "description": "Distribution is the percent distribution of variants within that segment",
       "name": "distribution"
@@ -4960,6 +10881,10 @@ func init() {
       "description": "Variants are the possible outcomes of flag evaluation",
       "name": "variant"
     },
+    {
+      "description": "Target pins an entity to a variant of the flag",
+      "name": "target"
+    },
     {
       "description": "Evaluation is the process of evaluating a flag given the entity context",
       "name": "evaluation"
@@ -4982,7 +10907,12 @@ func init() {
         "constraint",
         "distribution",
         "variant",
-        "tag"
+        "target",
+        "tag",
+        "audience",
+        "layer",
+        "holdout",
+        "list"
       ]
     },
     {
@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"strings"
 
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/audience"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/constraint"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/datar"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/distribution"
@@ -14,8 +15,12 @@ import (
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/export"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/flag"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/health"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/holdout"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/layer"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/list"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/segment"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/tag"
+	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/target"
 	"github.com/foxdalas/flagr/swagger_gen/restapi/operations/variant"
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/loads"
@@ -45,11 +50,25 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 		APIKeyAuthenticator: security.APIKeyAuth,
 		BearerAuthenticator: security.BearerAuth,
 
+		CsvConsumer:  runtime.CSVConsumer(),
 		JSONConsumer: runtime.JSONConsumer(),
+		TxtConsumer:  runtime.TextConsumer(),
 
 		BinProducer:  runtime.ByteStreamProducer(),
 		JSONProducer: runtime.JSONProducer(),
 
+		SegmentCancelRolloutScheduleHandler: segment.CancelRolloutScheduleHandlerFunc(func(params segment.CancelRolloutScheduleParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation segment.CancelRolloutSchedule has not yet been implemented")
+		}),
+
+		AudienceCreateAudienceHandler: audience.CreateAudienceHandlerFunc(func(params audience.CreateAudienceParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation audience.CreateAudience has not yet been implemented")
+		}),
+
 		ConstraintCreateConstraintHandler: constraint.CreateConstraintHandlerFunc(func(params constraint.CreateConstraintParams) middleware.Responder {
 			_ = params
 
@@ -62,6 +81,30 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation flag.CreateFlag has not yet been implemented")
 		}),
 
+		HoldoutCreateHoldoutHandler: holdout.CreateHoldoutHandlerFunc(func(params holdout.CreateHoldoutParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation holdout.CreateHoldout has not yet been implemented")
+		}),
+
+		LayerCreateLayerHandler: layer.CreateLayerHandlerFunc(func(params layer.CreateLayerParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation layer.CreateLayer has not yet been implemented")
+		}),
+
+		ListCreateListHandler: list.CreateListHandlerFunc(func(params list.CreateListParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation list.CreateList has not yet been implemented")
+		}),
+
+		SegmentCreateRolloutScheduleHandler: segment.CreateRolloutScheduleHandlerFunc(func(params segment.CreateRolloutScheduleParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation segment.CreateRolloutSchedule has not yet been implemented")
+		}),
+
 		SegmentCreateSegmentHandler: segment.CreateSegmentHandlerFunc(func(params segment.CreateSegmentParams) middleware.Responder {
 			_ = params
 
@@ -74,12 +117,24 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation tag.CreateTag has not yet been implemented")
 		}),
 
+		TargetCreateTargetHandler: target.CreateTargetHandlerFunc(func(params target.CreateTargetParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation target.CreateTarget has not yet been implemented")
+		}),
+
 		VariantCreateVariantHandler: variant.CreateVariantHandlerFunc(func(params variant.CreateVariantParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation variant.CreateVariant has not yet been implemented")
 		}),
 
+		AudienceDeleteAudienceHandler: audience.DeleteAudienceHandlerFunc(func(params audience.DeleteAudienceParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation audience.DeleteAudience has not yet been implemented")
+		}),
+
 		ConstraintDeleteConstraintHandler: constraint.DeleteConstraintHandlerFunc(func(params constraint.DeleteConstraintParams) middleware.Responder {
 			_ = params
 
@@ -92,18 +147,66 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation flag.DeleteFlag has not yet been implemented")
 		}),
 
+		FlagDeleteFlagActivationWindowHandler: flag.DeleteFlagActivationWindowHandlerFunc(func(params flag.DeleteFlagActivationWindowParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.DeleteFlagActivationWindow has not yet been implemented")
+		}),
+
+		FlagDeleteFlagHoldoutHandler: flag.DeleteFlagHoldoutHandlerFunc(func(params flag.DeleteFlagHoldoutParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.DeleteFlagHoldout has not yet been implemented")
+		}),
+
+		FlagDeleteFlagLayerHandler: flag.DeleteFlagLayerHandlerFunc(func(params flag.DeleteFlagLayerParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.DeleteFlagLayer has not yet been implemented")
+		}),
+
+		HoldoutDeleteHoldoutHandler: holdout.DeleteHoldoutHandlerFunc(func(params holdout.DeleteHoldoutParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation holdout.DeleteHoldout has not yet been implemented")
+		}),
+
+		LayerDeleteLayerHandler: layer.DeleteLayerHandlerFunc(func(params layer.DeleteLayerParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation layer.DeleteLayer has not yet been implemented")
+		}),
+
+		ListDeleteListHandler: list.DeleteListHandlerFunc(func(params list.DeleteListParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation list.DeleteList has not yet been implemented")
+		}),
+
 		SegmentDeleteSegmentHandler: segment.DeleteSegmentHandlerFunc(func(params segment.DeleteSegmentParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation segment.DeleteSegment has not yet been implemented")
 		}),
 
+		SegmentDeleteSegmentActivationWindowHandler: segment.DeleteSegmentActivationWindowHandlerFunc(func(params segment.DeleteSegmentActivationWindowParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation segment.DeleteSegmentActivationWindow has not yet been implemented")
+		}),
+
 		TagDeleteTagHandler: tag.DeleteTagHandlerFunc(func(params tag.DeleteTagParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation tag.DeleteTag has not yet been implemented")
 		}),
 
+		TargetDeleteTargetHandler: target.DeleteTargetHandlerFunc(func(params target.DeleteTargetParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation target.DeleteTarget has not yet been implemented")
+		}),
+
 		VariantDeleteVariantHandler: variant.DeleteVariantHandlerFunc(func(params variant.DeleteVariantParams) middleware.Responder {
 			_ = params
 
@@ -116,6 +219,12 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation tag.FindAllTags has not yet been implemented")
 		}),
 
+		AudienceFindAudiencesHandler: audience.FindAudiencesHandlerFunc(func(params audience.FindAudiencesParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation audience.FindAudiences has not yet been implemented")
+		}),
+
 		ConstraintFindConstraintsHandler: constraint.FindConstraintsHandlerFunc(func(params constraint.FindConstraintsParams) middleware.Responder {
 			_ = params
 
@@ -134,6 +243,24 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation flag.FindFlags has not yet been implemented")
 		}),
 
+		HoldoutFindHoldoutsHandler: holdout.FindHoldoutsHandlerFunc(func(params holdout.FindHoldoutsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation holdout.FindHoldouts has not yet been implemented")
+		}),
+
+		LayerFindLayersHandler: layer.FindLayersHandlerFunc(func(params layer.FindLayersParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation layer.FindLayers has not yet been implemented")
+		}),
+
+		ListFindListsHandler: list.FindListsHandlerFunc(func(params list.FindListsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation list.FindLists has not yet been implemented")
+		}),
+
 		SegmentFindSegmentsHandler: segment.FindSegmentsHandlerFunc(func(params segment.FindSegmentsParams) middleware.Responder {
 			_ = params
 
@@ -146,12 +273,24 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation tag.FindTags has not yet been implemented")
 		}),
 
+		TargetFindTargetsHandler: target.FindTargetsHandlerFunc(func(params target.FindTargetsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation target.FindTargets has not yet been implemented")
+		}),
+
 		VariantFindVariantsHandler: variant.FindVariantsHandlerFunc(func(params variant.FindVariantsParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation variant.FindVariants has not yet been implemented")
 		}),
 
+		AudienceGetAudienceHandler: audience.GetAudienceHandlerFunc(func(params audience.GetAudienceParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation audience.GetAudience has not yet been implemented")
+		}),
+
 		DatarGetDatarFlagSummaryHandler: datar.GetDatarFlagSummaryHandlerFunc(func(params datar.GetDatarFlagSummaryParams) middleware.Responder {
 			_ = params
 
@@ -164,6 +303,12 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation datar.GetDatarSummary has not yet been implemented")
 		}),
 
+		FlagGetEntityTypeSchemaHandler: flag.GetEntityTypeSchemaHandlerFunc(func(params flag.GetEntityTypeSchemaParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.GetEntityTypeSchema has not yet been implemented")
+		}),
+
 		EvaluationGetEvaluationBatchHandler: evaluation.GetEvaluationBatchHandlerFunc(func(params evaluation.GetEvaluationBatchParams) middleware.Responder {
 			_ = params
 
@@ -212,6 +357,30 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation health.GetHealth has not yet been implemented")
 		}),
 
+		HoldoutGetHoldoutHandler: holdout.GetHoldoutHandlerFunc(func(params holdout.GetHoldoutParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation holdout.GetHoldout has not yet been implemented")
+		}),
+
+		LayerGetLayerHandler: layer.GetLayerHandlerFunc(func(params layer.GetLayerParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation layer.GetLayer has not yet been implemented")
+		}),
+
+		ListGetListHandler: list.GetListHandlerFunc(func(params list.GetListParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation list.GetList has not yet been implemented")
+		}),
+
+		SegmentGetRolloutScheduleHandler: segment.GetRolloutScheduleHandlerFunc(func(params segment.GetRolloutScheduleParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation segment.GetRolloutSchedule has not yet been implemented")
+		}),
+
 		EvaluationPostEvaluationHandler: evaluation.PostEvaluationHandlerFunc(func(params evaluation.PostEvaluationParams) middleware.Responder {
 			_ = params
 
@@ -224,6 +393,30 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation evaluation.PostEvaluationBatch has not yet been implemented")
 		}),
 
+		EvaluationPostEvaluationExplainHandler: evaluation.PostEvaluationExplainHandlerFunc(func(params evaluation.PostEvaluationExplainParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation evaluation.PostEvaluationExplain has not yet been implemented")
+		}),
+
+		EvaluationPostEvaluationSimulateHandler: evaluation.PostEvaluationSimulateHandlerFunc(func(params evaluation.PostEvaluationSimulateParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation evaluation.PostEvaluationSimulate has not yet been implemented")
+		}),
+
+		FlagPostFlagSnapshotEvaluationHandler: flag.PostFlagSnapshotEvaluationHandlerFunc(func(params flag.PostFlagSnapshotEvaluationParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.PostFlagSnapshotEvaluation has not yet been implemented")
+		}),
+
+		AudiencePutAudienceHandler: audience.PutAudienceHandlerFunc(func(params audience.PutAudienceParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation audience.PutAudience has not yet been implemented")
+		}),
+
 		ConstraintPutConstraintHandler: constraint.PutConstraintHandlerFunc(func(params constraint.PutConstraintParams) middleware.Responder {
 			_ = params
 
@@ -236,24 +429,90 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 			return middleware.NotImplemented("operation distribution.PutDistributions has not yet been implemented")
 		}),
 
+		FlagPutEntityTypeSchemaHandler: flag.PutEntityTypeSchemaHandlerFunc(func(params flag.PutEntityTypeSchemaParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.PutEntityTypeSchema has not yet been implemented")
+		}),
+
 		FlagPutFlagHandler: flag.PutFlagHandlerFunc(func(params flag.PutFlagParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation flag.PutFlag has not yet been implemented")
 		}),
 
+		FlagPutFlagActivationWindowHandler: flag.PutFlagActivationWindowHandlerFunc(func(params flag.PutFlagActivationWindowParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.PutFlagActivationWindow has not yet been implemented")
+		}),
+
+		FlagPutFlagHoldoutHandler: flag.PutFlagHoldoutHandlerFunc(func(params flag.PutFlagHoldoutParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.PutFlagHoldout has not yet been implemented")
+		}),
+
+		FlagPutFlagLayerHandler: flag.PutFlagLayerHandlerFunc(func(params flag.PutFlagLayerParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.PutFlagLayer has not yet been implemented")
+		}),
+
+		FlagPutFlagPrerequisitesHandler: flag.PutFlagPrerequisitesHandlerFunc(func(params flag.PutFlagPrerequisitesParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation flag.PutFlagPrerequisites has not yet been implemented")
+		}),
+
+		HoldoutPutHoldoutHandler: holdout.PutHoldoutHandlerFunc(func(params holdout.PutHoldoutParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation holdout.PutHoldout has not yet been implemented")
+		}),
+
+		LayerPutLayerHandler: layer.PutLayerHandlerFunc(func(params layer.PutLayerParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation layer.PutLayer has not yet been implemented")
+		}),
+
+		ListPutListHandler: list.PutListHandlerFunc(func(params list.PutListParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation list.PutList has not yet been implemented")
+		}),
+
+		ListPutListValuesHandler: list.PutListValuesHandlerFunc(func(params list.PutListValuesParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation list.PutListValues has not yet been implemented")
+		}),
+
 		SegmentPutSegmentHandler: segment.PutSegmentHandlerFunc(func(params segment.PutSegmentParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation segment.PutSegment has not yet been implemented")
 		}),
 
+		SegmentPutSegmentActivationWindowHandler: segment.PutSegmentActivationWindowHandlerFunc(func(params segment.PutSegmentActivationWindowParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation segment.PutSegmentActivationWindow has not yet been implemented")
+		}),
+
 		SegmentPutSegmentsReorderHandler: segment.PutSegmentsReorderHandlerFunc(func(params segment.PutSegmentsReorderParams) middleware.Responder {
 			_ = params
 
 			return middleware.NotImplemented("operation segment.PutSegmentsReorder has not yet been implemented")
 		}),
 
+		TargetPutTargetsHandler: target.PutTargetsHandlerFunc(func(params target.PutTargetsParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation target.PutTargets has not yet been implemented")
+		}),
+
 		VariantPutVariantHandler: variant.PutVariantHandlerFunc(func(params variant.PutVariantParams) middleware.Responder {
 			_ = params
 
@@ -271,6 +530,12 @@ func NewFlagrAPI(spec *loads.Document) *FlagrAPI {
 
 			return middleware.NotImplemented("operation flag.SetFlagEnabled has not yet been implemented")
 		}),
+
+		SegmentSetRolloutSchedulePausedHandler: segment.SetRolloutSchedulePausedHandlerFunc(func(params segment.SetRolloutSchedulePausedParams) middleware.Responder {
+			_ = params
+
+			return middleware.NotImplemented("operation segment.SetRolloutSchedulePaused has not yet been implemented")
+		}),
 	}
 }
 
@@ -300,9 +565,15 @@ type FlagrAPI struct {
 	// It has a default implementation in the security package, however you can replace it for your particular usage.
 	BearerAuthenticator func(string, security.ScopedTokenAuthentication) runtime.Authenticator
 
+	// CsvConsumer registers a consumer for the following mime types:
+	//   - text/csv
+	CsvConsumer runtime.Consumer
 	// JSONConsumer registers a consumer for the following mime types:
 	//   - application/json
 	JSONConsumer runtime.Consumer
+	// TxtConsumer registers a consumer for the following mime types:
+	//   - text/plain
+	TxtConsumer runtime.Consumer
 
 	// BinProducer registers a producer for the following mime types:
 	//   - application/octet-stream
@@ -311,44 +582,90 @@ type FlagrAPI struct {
 	//   - application/json
 	JSONProducer runtime.Producer
 
+	// SegmentCancelRolloutScheduleHandler sets the operation handler for the cancel rollout schedule operation
+	SegmentCancelRolloutScheduleHandler segment.CancelRolloutScheduleHandler
+	// AudienceCreateAudienceHandler sets the operation handler for the create audience operation
+	AudienceCreateAudienceHandler audience.CreateAudienceHandler
 	// ConstraintCreateConstraintHandler sets the operation handler for the create constraint operation
 	ConstraintCreateConstraintHandler constraint.CreateConstraintHandler
 	// FlagCreateFlagHandler sets the operation handler for the create flag operation
 	FlagCreateFlagHandler flag.CreateFlagHandler
+	// HoldoutCreateHoldoutHandler sets the operation handler for the create holdout operation
+	HoldoutCreateHoldoutHandler holdout.CreateHoldoutHandler
+	// LayerCreateLayerHandler sets the operation handler for the create layer operation
+	LayerCreateLayerHandler layer.CreateLayerHandler
+	// ListCreateListHandler sets the operation handler for the create list operation
+	ListCreateListHandler list.CreateListHandler
+	// SegmentCreateRolloutScheduleHandler sets the operation handler for the create rollout schedule operation
+	SegmentCreateRolloutScheduleHandler segment.CreateRolloutScheduleHandler
 	// SegmentCreateSegmentHandler sets the operation handler for the create segment operation
 	SegmentCreateSegmentHandler segment.CreateSegmentHandler
 	// TagCreateTagHandler sets the operation handler for the create tag operation
 	TagCreateTagHandler tag.CreateTagHandler
+	// TargetCreateTargetHandler sets the operation handler for the create target operation
+	TargetCreateTargetHandler target.CreateTargetHandler
 	// VariantCreateVariantHandler sets the operation handler for the create variant operation
 	VariantCreateVariantHandler variant.CreateVariantHandler
+	// AudienceDeleteAudienceHandler sets the operation handler for the delete audience operation
+	AudienceDeleteAudienceHandler audience.DeleteAudienceHandler
 	// ConstraintDeleteConstraintHandler sets the operation handler for the delete constraint operation
 	ConstraintDeleteConstraintHandler constraint.DeleteConstraintHandler
 	// FlagDeleteFlagHandler sets the operation handler for the delete flag operation
 	FlagDeleteFlagHandler flag.DeleteFlagHandler
+	// FlagDeleteFlagActivationWindowHandler sets the operation handler for the delete flag activation window operation
+	FlagDeleteFlagActivationWindowHandler flag.DeleteFlagActivationWindowHandler
+	// FlagDeleteFlagHoldoutHandler sets the operation handler for the delete flag holdout operation
+	FlagDeleteFlagHoldoutHandler flag.DeleteFlagHoldoutHandler
+	// FlagDeleteFlagLayerHandler sets the operation handler for the delete flag layer operation
+	FlagDeleteFlagLayerHandler flag.DeleteFlagLayerHandler
+	// HoldoutDeleteHoldoutHandler sets the operation handler for the delete holdout operation
+	HoldoutDeleteHoldoutHandler holdout.DeleteHoldoutHandler
+	// LayerDeleteLayerHandler sets the operation handler for the delete layer operation
+	LayerDeleteLayerHandler layer.DeleteLayerHandler
+	// ListDeleteListHandler sets the operation handler for the delete list operation
+	ListDeleteListHandler list.DeleteListHandler
 	// SegmentDeleteSegmentHandler sets the operation handler for the delete segment operation
 	SegmentDeleteSegmentHandler segment.DeleteSegmentHandler
+	// SegmentDeleteSegmentActivationWindowHandler sets the operation handler for the delete segment activation window operation
+	SegmentDeleteSegmentActivationWindowHandler segment.DeleteSegmentActivationWindowHandler
 	// TagDeleteTagHandler sets the operation handler for the delete tag operation
 	TagDeleteTagHandler tag.DeleteTagHandler
+	// TargetDeleteTargetHandler sets the operation handler for the delete target operation
+	TargetDeleteTargetHandler target.DeleteTargetHandler
 	// VariantDeleteVariantHandler sets the operation handler for the delete variant operation
 	VariantDeleteVariantHandler variant.DeleteVariantHandler
 	// TagFindAllTagsHandler sets the operation handler for the find all tags operation
 	TagFindAllTagsHandler tag.FindAllTagsHandler
+	// AudienceFindAudiencesHandler sets the operation handler for the find audiences operation
+	AudienceFindAudiencesHandler audience.FindAudiencesHandler
 	// ConstraintFindConstraintsHandler sets the operation handler for the find constraints operation
 	ConstraintFindConstraintsHandler constraint.FindConstraintsHandler
 	// DistributionFindDistributionsHandler sets the operation handler for the find distributions operation
 	DistributionFindDistributionsHandler distribution.FindDistributionsHandler
 	// FlagFindFlagsHandler sets the operation handler for the find flags operation
 	FlagFindFlagsHandler flag.FindFlagsHandler
+	// HoldoutFindHoldoutsHandler sets the operation handler for the find holdouts operation
+	HoldoutFindHoldoutsHandler holdout.FindHoldoutsHandler
+	// LayerFindLayersHandler sets the operation handler for the find layers operation
+	LayerFindLayersHandler layer.FindLayersHandler
+	// ListFindListsHandler sets the operation handler for the find lists operation
+	ListFindListsHandler list.FindListsHandler
 	// SegmentFindSegmentsHandler sets the operation handler for the find segments operation
 	SegmentFindSegmentsHandler segment.FindSegmentsHandler
 	// TagFindTagsHandler sets the operation handler for the find tags operation
 	TagFindTagsHandler tag.FindTagsHandler
+	// TargetFindTargetsHandler sets the operation handler for the find targets operation
+	TargetFindTargetsHandler target.FindTargetsHandler
 	// VariantFindVariantsHandler sets the operation handler for the find variants operation
 	VariantFindVariantsHandler variant.FindVariantsHandler
+	// AudienceGetAudienceHandler sets the operation handler for the get audience operation
+	AudienceGetAudienceHandler audience.GetAudienceHandler
 	// DatarGetDatarFlagSummaryHandler sets the operation handler for the get datar flag summary operation
 	DatarGetDatarFlagSummaryHandler datar.GetDatarFlagSummaryHandler
 	// DatarGetDatarSummaryHandler sets the operation handler for the get datar summary operation
 	DatarGetDatarSummaryHandler datar.GetDatarSummaryHandler
+	// FlagGetEntityTypeSchemaHandler sets the operation handler for the get entity type schema operation
+	FlagGetEntityTypeSchemaHandler flag.GetEntityTypeSchemaHandler
 	// EvaluationGetEvaluationBatchHandler sets the operation handler for the get evaluation batch operation
 	EvaluationGetEvaluationBatchHandler evaluation.GetEvaluationBatchHandler
 	// ExportGetExportEvalCacheJSONHandler sets the operation handler for the get export eval cache JSON operation
@@ -365,26 +682,66 @@ type FlagrAPI struct {
 	FlagGetFlagSnapshotsHandler flag.GetFlagSnapshotsHandler
 	// HealthGetHealthHandler sets the operation handler for the get health operation
 	HealthGetHealthHandler health.GetHealthHandler
+	// HoldoutGetHoldoutHandler sets the operation handler for the get holdout operation
+	HoldoutGetHoldoutHandler holdout.GetHoldoutHandler
+	// LayerGetLayerHandler sets the operation handler for the get layer operation
+	LayerGetLayerHandler layer.GetLayerHandler
+	// ListGetListHandler sets the operation handler for the get list operation
+	ListGetListHandler list.GetListHandler
+	// SegmentGetRolloutScheduleHandler sets the operation handler for the get rollout schedule operation
+	SegmentGetRolloutScheduleHandler segment.GetRolloutScheduleHandler
 	// EvaluationPostEvaluationHandler sets the operation handler for the post evaluation operation
 	EvaluationPostEvaluationHandler evaluation.PostEvaluationHandler
 	// EvaluationPostEvaluationBatchHandler sets the operation handler for the post evaluation batch operation
 	EvaluationPostEvaluationBatchHandler evaluation.PostEvaluationBatchHandler
+	// EvaluationPostEvaluationExplainHandler sets the operation handler for the post evaluation explain operation
+	EvaluationPostEvaluationExplainHandler evaluation.PostEvaluationExplainHandler
+	// EvaluationPostEvaluationSimulateHandler sets the operation handler for the post evaluation simulate operation
+	EvaluationPostEvaluationSimulateHandler evaluation.PostEvaluationSimulateHandler
+	// FlagPostFlagSnapshotEvaluationHandler sets the operation handler for the post flag snapshot evaluation operation
+	FlagPostFlagSnapshotEvaluationHandler flag.PostFlagSnapshotEvaluationHandler
+	// AudiencePutAudienceHandler sets the operation handler for the put audience operation
+	AudiencePutAudienceHandler audience.PutAudienceHandler
 	// ConstraintPutConstraintHandler sets the operation handler for the put constraint operation
 	ConstraintPutConstraintHandler constraint.PutConstraintHandler
 	// DistributionPutDistributionsHandler sets the operation handler for the put distributions operation
 	DistributionPutDistributionsHandler distribution.PutDistributionsHandler
+	// FlagPutEntityTypeSchemaHandler sets the operation handler for the put entity type schema operation
+	FlagPutEntityTypeSchemaHandler flag.PutEntityTypeSchemaHandler
 	// FlagPutFlagHandler sets the operation handler for the put flag operation
 	FlagPutFlagHandler flag.PutFlagHandler
+	// FlagPutFlagActivationWindowHandler sets the operation handler for the put flag activation window operation
+	FlagPutFlagActivationWindowHandler flag.PutFlagActivationWindowHandler
+	// FlagPutFlagHoldoutHandler sets the operation handler for the put flag holdout operation
+	FlagPutFlagHoldoutHandler flag.PutFlagHoldoutHandler
+	// FlagPutFlagLayerHandler sets the operation handler for the put flag layer operation
+	FlagPutFlagLayerHandler flag.PutFlagLayerHandler
+	// FlagPutFlagPrerequisitesHandler sets the operation handler for the put flag prerequisites operation
+	FlagPutFlagPrerequisitesHandler flag.PutFlagPrerequisitesHandler
+	// HoldoutPutHoldoutHandler sets the operation handler for the put holdout operation
+	HoldoutPutHoldoutHandler holdout.PutHoldoutHandler
+	// LayerPutLayerHandler sets the operation handler for the put layer operation
+	LayerPutLayerHandler layer.PutLayerHandler
+	// ListPutListHandler sets the operation handler for the put list operation
+	ListPutListHandler list.PutListHandler
+	// ListPutListValuesHandler sets the operation handler for the put list values operation
+	ListPutListValuesHandler list.PutListValuesHandler
 	// SegmentPutSegmentHandler sets the operation handler for the put segment operation
 	SegmentPutSegmentHandler segment.PutSegmentHandler
+	// SegmentPutSegmentActivationWindowHandler sets the operation handler for the put segment activation window operation
+	SegmentPutSegmentActivationWindowHandler segment.PutSegmentActivationWindowHandler
 	// SegmentPutSegmentsReorderHandler sets the operation handler for the put segments reorder operation
 	SegmentPutSegmentsReorderHandler segment.PutSegmentsReorderHandler
+	// TargetPutTargetsHandler sets the operation handler for the put targets operation
+	TargetPutTargetsHandler target.PutTargetsHandler
 	// VariantPutVariantHandler sets the operation handler for the put variant operation
 	VariantPutVariantHandler variant.PutVariantHandler
 	// FlagRestoreFlagHandler sets the operation handler for the restore flag operation
 	FlagRestoreFlagHandler flag.RestoreFlagHandler
 	// FlagSetFlagEnabledHandler sets the operation handler for the set flag enabled operation
 	FlagSetFlagEnabledHandler flag.SetFlagEnabledHandler
+	// SegmentSetRolloutSchedulePausedHandler sets the operation handler for the set rollout schedule paused operation
+	SegmentSetRolloutSchedulePausedHandler segment.SetRolloutSchedulePausedHandler
 
 	// ServeError is called when an error is received, there is a default handler
 	// but you can set your own with this
@@ -454,9 +811,15 @@ func (o *FlagrAPI) RegisterFormat(name string, format strfmt.Format, validator s
 func (o *FlagrAPI) Validate() error {
 	var unregistered []string
 
+	if o.CsvConsumer == nil {
+		unregistered = append(unregistered, "CsvConsumer")
+	}
 	if o.JSONConsumer == nil {
 		unregistered = append(unregistered, "JSONConsumer")
 	}
+	if o.TxtConsumer == nil {
+		unregistered = append(unregistered, "TxtConsumer")
+	}
 
 	if o.BinProducer == nil {
 		unregistered = append(unregistered, "BinProducer")
@@ -465,39 +828,90 @@ func (o *FlagrAPI) Validate() error {
 		unregistered = append(unregistered, "JSONProducer")
 	}
 
+	if o.SegmentCancelRolloutScheduleHandler == nil {
+		unregistered = append(unregistered, "segment.CancelRolloutScheduleHandler")
+	}
+	if o.AudienceCreateAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.CreateAudienceHandler")
+	}
 	if o.ConstraintCreateConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.CreateConstraintHandler")
 	}
 	if o.FlagCreateFlagHandler == nil {
 		unregistered = append(unregistered, "flag.CreateFlagHandler")
 	}
+	if o.HoldoutCreateHoldoutHandler == nil {
+		unregistered = append(unregistered, "holdout.CreateHoldoutHandler")
+	}
+	if o.LayerCreateLayerHandler == nil {
+		unregistered = append(unregistered, "layer.CreateLayerHandler")
+	}
+	if o.ListCreateListHandler == nil {
+		unregistered = append(unregistered, "list.CreateListHandler")
+	}
+	if o.SegmentCreateRolloutScheduleHandler == nil {
+		unregistered = append(unregistered, "segment.CreateRolloutScheduleHandler")
+	}
 	if o.SegmentCreateSegmentHandler == nil {
 		unregistered = append(unregistered, "segment.CreateSegmentHandler")
 	}
 	if o.TagCreateTagHandler == nil {
 		unregistered = append(unregistered, "tag.CreateTagHandler")
 	}
+	if o.TargetCreateTargetHandler == nil {
+		unregistered = append(unregistered, "target.CreateTargetHandler")
+	}
 	if o.VariantCreateVariantHandler == nil {
 		unregistered = append(unregistered, "variant.CreateVariantHandler")
 	}
+	if o.AudienceDeleteAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.DeleteAudienceHandler")
+	}
 	if o.ConstraintDeleteConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.DeleteConstraintHandler")
 	}
 	if o.FlagDeleteFlagHandler == nil {
 		unregistered = append(unregistered, "flag.DeleteFlagHandler")
 	}
+	if o.FlagDeleteFlagActivationWindowHandler == nil {
+		unregistered = append(unregistered, "flag.DeleteFlagActivationWindowHandler")
+	}
+	if o.FlagDeleteFlagHoldoutHandler == nil {
+		unregistered = append(unregistered, "flag.DeleteFlagHoldoutHandler")
+	}
+	if o.FlagDeleteFlagLayerHandler == nil {
+		unregistered = append(unregistered, "flag.DeleteFlagLayerHandler")
+	}
+	if o.HoldoutDeleteHoldoutHandler == nil {
+		unregistered = append(unregistered, "holdout.DeleteHoldoutHandler")
+	}
+	if o.LayerDeleteLayerHandler == nil {
+		unregistered = append(unregistered, "layer.DeleteLayerHandler")
+	}
+	if o.ListDeleteListHandler == nil {
+		unregistered = append(unregistered, "list.DeleteListHandler")
+	}
 	if o.SegmentDeleteSegmentHandler == nil {
 		unregistered = append(unregistered, "segment.DeleteSegmentHandler")
 	}
+	if o.SegmentDeleteSegmentActivationWindowHandler == nil {
+		unregistered = append(unregistered, "segment.DeleteSegmentActivationWindowHandler")
+	}
 	if o.TagDeleteTagHandler == nil {
 		unregistered = append(unregistered, "tag.DeleteTagHandler")
 	}
+	if o.TargetDeleteTargetHandler == nil {
+		unregistered = append(unregistered, "target.DeleteTargetHandler")
+	}
 	if o.VariantDeleteVariantHandler == nil {
 		unregistered = append(unregistered, "variant.DeleteVariantHandler")
 	}
 	if o.TagFindAllTagsHandler == nil {
 		unregistered = append(unregistered, "tag.FindAllTagsHandler")
 	}
+	if o.AudienceFindAudiencesHandler == nil {
+		unregistered = append(unregistered, "audience.FindAudiencesHandler")
+	}
 	if o.ConstraintFindConstraintsHandler == nil {
 		unregistered = append(unregistered, "constraint.FindConstraintsHandler")
 	}
@@ -507,21 +921,39 @@ func (o *FlagrAPI) Validate() error {
 	if o.FlagFindFlagsHandler == nil {
 		unregistered = append(unregistered, "flag.FindFlagsHandler")
 	}
+	if o.HoldoutFindHoldoutsHandler == nil {
+		unregistered = append(unregistered, "holdout.FindHoldoutsHandler")
+	}
+	if o.LayerFindLayersHandler == nil {
+		unregistered = append(unregistered, "layer.FindLayersHandler")
+	}
+	if o.ListFindListsHandler == nil {
+		unregistered = append(unregistered, "list.FindListsHandler")
+	}
 	if o.SegmentFindSegmentsHandler == nil {
 		unregistered = append(unregistered, "segment.FindSegmentsHandler")
 	}
 	if o.TagFindTagsHandler == nil {
 		unregistered = append(unregistered, "tag.FindTagsHandler")
 	}
+	if o.TargetFindTargetsHandler == nil {
+		unregistered = append(unregistered, "target.FindTargetsHandler")
+	}
 	if o.VariantFindVariantsHandler == nil {
 		unregistered = append(unregistered, "variant.FindVariantsHandler")
 	}
+	if o.AudienceGetAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.GetAudienceHandler")
+	}
 	if o.DatarGetDatarFlagSummaryHandler == nil {
 		unregistered = append(unregistered, "datar.GetDatarFlagSummaryHandler")
 	}
 	if o.DatarGetDatarSummaryHandler == nil {
 		unregistered = append(unregistered, "datar.GetDatarSummaryHandler")
 	}
+	if o.FlagGetEntityTypeSchemaHandler == nil {
+		unregistered = append(unregistered, "flag.GetEntityTypeSchemaHandler")
+	}
 	if o.EvaluationGetEvaluationBatchHandler == nil {
 		unregistered = append(unregistered, "evaluation.GetEvaluationBatchHandler")
 	}
@@ -546,27 +978,84 @@ func (o *FlagrAPI) Validate() error {
 	if o.HealthGetHealthHandler == nil {
 		unregistered = append(unregistered, "health.GetHealthHandler")
 	}
+	if o.HoldoutGetHoldoutHandler == nil {
+		unregistered = append(unregistered, "holdout.GetHoldoutHandler")
+	}
+	if o.LayerGetLayerHandler == nil {
+		unregistered = append(unregistered, "layer.GetLayerHandler")
+	}
+	if o.ListGetListHandler == nil {
+		unregistered = append(unregistered, "list.GetListHandler")
+	}
+	if o.SegmentGetRolloutScheduleHandler == nil {
+		unregistered = append(unregistered, "segment.GetRolloutScheduleHandler")
+	}
 	if o.EvaluationPostEvaluationHandler == nil {
 		unregistered = append(unregistered, "evaluation.PostEvaluationHandler")
 	}
 	if o.EvaluationPostEvaluationBatchHandler == nil {
 		unregistered = append(unregistered, "evaluation.PostEvaluationBatchHandler")
 	}
+	if o.EvaluationPostEvaluationExplainHandler == nil {
+		unregistered = append(unregistered, "evaluation.PostEvaluationExplainHandler")
+	}
+	if o.EvaluationPostEvaluationSimulateHandler == nil {
+		unregistered = append(unregistered, "evaluation.PostEvaluationSimulateHandler")
+	}
+	if o.FlagPostFlagSnapshotEvaluationHandler == nil {
+		unregistered = append(unregistered, "flag.PostFlagSnapshotEvaluationHandler")
+	}
+	if o.AudiencePutAudienceHandler == nil {
+		unregistered = append(unregistered, "audience.PutAudienceHandler")
+	}
 	if o.ConstraintPutConstraintHandler == nil {
 		unregistered = append(unregistered, "constraint.PutConstraintHandler")
 	}
 	if o.DistributionPutDistributionsHandler == nil {
 		unregistered = append(unregistered, "distribution.PutDistributionsHandler")
 	}
+	if o.FlagPutEntityTypeSchemaHandler == nil {
+		unregistered = append(unregistered, "flag.PutEntityTypeSchemaHandler")
+	}
 	if o.FlagPutFlagHandler == nil {
 		unregistered = append(unregistered, "flag.PutFlagHandler")
 	}
+	if o.FlagPutFlagActivationWindowHandler == nil {
+		unregistered = append(unregistered, "flag.PutFlagActivationWindowHandler")
+	}
+	if o.FlagPutFlagHoldoutHandler == nil {
+		unregistered = append(unregistered, "flag.PutFlagHoldoutHandler")
+	}
+	if o.FlagPutFlagLayerHandler == nil {
+		unregistered = append(unregistered, "flag.PutFlagLayerHandler")
+	}
+	if o.FlagPutFlagPrerequisitesHandler == nil {
+		unregistered = append(unregistered, "flag.PutFlagPrerequisitesHandler")
+	}
+	if o.HoldoutPutHoldoutHandler == nil {
+		unregistered = append(unregistered, "holdout.PutHoldoutHandler")
+	}
+	if o.LayerPutLayerHandler == nil {
+		unregistered = append(unregistered, "layer.PutLayerHandler")
+	}
+	if o.ListPutListHandler == nil {
+		unregistered = append(unregistered, "list.PutListHandler")
+	}
+	if o.ListPutListValuesHandler == nil {
+		unregistered = append(unregistered, "list.PutListValuesHandler")
+	}
 	if o.SegmentPutSegmentHandler == nil {
 		unregistered = append(unregistered, "segment.PutSegmentHandler")
 	}
+	if o.SegmentPutSegmentActivationWindowHandler == nil {
+		unregistered = append(unregistered, "segment.PutSegmentActivationWindowHandler")
+	}
 	if o.SegmentPutSegmentsReorderHandler == nil {
 		unregistered = append(unregistered, "segment.PutSegmentsReorderHandler")
 	}
+	if o.TargetPutTargetsHandler == nil {
+		unregistered = append(unregistered, "target.PutTargetsHandler")
+	}
 	if o.VariantPutVariantHandler == nil {
 		unregistered = append(unregistered, "variant.PutVariantHandler")
 	}
@@ -576,6 +1065,9 @@ func (o *FlagrAPI) Validate() error {
 	if o.FlagSetFlagEnabledHandler == nil {
 		unregistered = append(unregistered, "flag.SetFlagEnabledHandler")
 	}
+	if o.SegmentSetRolloutSchedulePausedHandler == nil {
+		unregistered = append(unregistered, "segment.SetRolloutSchedulePausedHandler")
+	}
 
 	if len(unregistered) > 0 {
 		return fmt.Errorf("missing registration: %s", strings.Join(unregistered, ", "))
@@ -605,9 +1097,15 @@ func (o *FlagrAPI) Authorizer() runtime.Authorizer {
 func (o *FlagrAPI) ConsumersFor(mediaTypes []string) map[string]runtime.Consumer {
 	result := make(map[string]runtime.Consumer, len(mediaTypes))
 	for _, mt := range mediaTypes {
+		if mt == "text/csv" {
+			result["text/csv"] = o.CsvConsumer
+		}
 		if mt == "application/json" {
 			result["application/json"] = o.JSONConsumer
 		}
+		if mt == "text/plain" {
+			result["text/plain"] = o.TxtConsumer
+		}
 
 		if c, ok := o.customConsumers[mt]; ok {
 			result[mt] = c
@@ -669,6 +1167,14 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/flags/{flagID}/segments/{segmentID}/rollout_schedule"] = segment.NewCancelRolloutSchedule(o.context, o.SegmentCancelRolloutScheduleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/audiences"] = audience.NewCreateAudience(o.context, o.AudienceCreateAudienceHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -680,6 +1186,22 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/holdouts"] = holdout.NewCreateHoldout(o.context, o.HoldoutCreateHoldoutHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/layers"] = layer.NewCreateLayer(o.context, o.LayerCreateLayerHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/lists"] = list.NewCreateList(o.context, o.ListCreateListHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/flags/{flagID}/segments/{segmentID}/rollout_schedule"] = segment.NewCreateRolloutSchedule(o.context, o.SegmentCreateRolloutScheduleHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/flags/{flagID}/segments"] = segment.NewCreateSegment(o.context, o.SegmentCreateSegmentHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
@@ -688,10 +1210,18 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
+	o.handlers["POST"]["/flags/{flagID}/targets"] = target.NewCreateTarget(o.context, o.TargetCreateTargetHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
 	o.handlers["POST"]["/flags/{flagID}/variants"] = variant.NewCreateVariant(o.context, o.VariantCreateVariantHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/audiences/{audienceID}"] = audience.NewDeleteAudience(o.context, o.AudienceDeleteAudienceHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/flags/{flagID}/segments/{segmentID}/constraints/{constraintID}"] = constraint.NewDeleteConstraint(o.context, o.ConstraintDeleteConstraintHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
@@ -700,14 +1230,46 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/flags/{flagID}/activation_window"] = flag.NewDeleteFlagActivationWindow(o.context, o.FlagDeleteFlagActivationWindowHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/flags/{flagID}/holdout"] = flag.NewDeleteFlagHoldout(o.context, o.FlagDeleteFlagHoldoutHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/flags/{flagID}/layer"] = flag.NewDeleteFlagLayer(o.context, o.FlagDeleteFlagLayerHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/holdouts/{holdoutID}"] = holdout.NewDeleteHoldout(o.context, o.HoldoutDeleteHoldoutHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/layers/{layerID}"] = layer.NewDeleteLayer(o.context, o.LayerDeleteLayerHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/lists/{listID}"] = list.NewDeleteList(o.context, o.ListDeleteListHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/flags/{flagID}/segments/{segmentID}"] = segment.NewDeleteSegment(o.context, o.SegmentDeleteSegmentHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/flags/{flagID}/segments/{segmentID}/activation_window"] = segment.NewDeleteSegmentActivationWindow(o.context, o.SegmentDeleteSegmentActivationWindowHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/flags/{flagID}/tags/{tagID}"] = tag.NewDeleteTag(o.context, o.TagDeleteTagHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
+	o.handlers["DELETE"]["/flags/{flagID}/targets/{targetID}"] = target.NewDeleteTarget(o.context, o.TargetDeleteTargetHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
 	o.handlers["DELETE"]["/flags/{flagID}/variants/{variantID}"] = variant.NewDeleteVariant(o.context, o.VariantDeleteVariantHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -716,6 +1278,10 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/audiences"] = audience.NewFindAudiences(o.context, o.AudienceFindAudiencesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/flags/{flagID}/segments/{segmentID}/constraints"] = constraint.NewFindConstraints(o.context, o.ConstraintFindConstraintsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -728,6 +1294,18 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/holdouts"] = holdout.NewFindHoldouts(o.context, o.HoldoutFindHoldoutsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/layers"] = layer.NewFindLayers(o.context, o.LayerFindLayersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/lists"] = list.NewFindLists(o.context, o.ListFindListsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/flags/{flagID}/segments"] = segment.NewFindSegments(o.context, o.SegmentFindSegmentsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -736,10 +1314,18 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/flags/{flagID}/targets"] = target.NewFindTargets(o.context, o.TargetFindTargetsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/flags/{flagID}/variants"] = variant.NewFindVariants(o.context, o.VariantFindVariantsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/audiences/{audienceID}"] = audience.NewGetAudience(o.context, o.AudienceGetAudienceHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/datar/flags/{flagID}/summary"] = datar.NewGetDatarFlagSummary(o.context, o.DatarGetDatarFlagSummaryHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -748,6 +1334,10 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/entity_types/{entityType}/schema"] = flag.NewGetEntityTypeSchema(o.context, o.FlagGetEntityTypeSchemaHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/evaluation/batch"] = evaluation.NewGetEvaluationBatch(o.context, o.EvaluationGetEvaluationBatchHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -777,6 +1367,22 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
 	o.handlers["GET"]["/health"] = health.NewGetHealth(o.context, o.HealthGetHealthHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/holdouts/{holdoutID}"] = holdout.NewGetHoldout(o.context, o.HoldoutGetHoldoutHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/layers/{layerID}"] = layer.NewGetLayer(o.context, o.LayerGetLayerHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/lists/{listID}"] = list.NewGetList(o.context, o.ListGetListHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/flags/{flagID}/segments/{segmentID}/rollout_schedule"] = segment.NewGetRolloutSchedule(o.context, o.SegmentGetRolloutScheduleHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -785,6 +1391,22 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/evaluation/batch"] = evaluation.NewPostEvaluationBatch(o.context, o.EvaluationPostEvaluationBatchHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/evaluation/explain"] = evaluation.NewPostEvaluationExplain(o.context, o.EvaluationPostEvaluationExplainHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/evaluation/simulate"] = evaluation.NewPostEvaluationSimulate(o.context, o.EvaluationPostEvaluationSimulateHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/flags/{flagID}/snapshots/evaluation"] = flag.NewPostFlagSnapshotEvaluation(o.context, o.FlagPostFlagSnapshotEvaluationHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/audiences/{audienceID}"] = audience.NewPutAudience(o.context, o.AudiencePutAudienceHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -796,18 +1418,62 @@ func (o *FlagrAPI) initHandlerCache() {
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/entity_types/{entityType}/schema"] = flag.NewPutEntityTypeSchema(o.context, o.FlagPutEntityTypeSchemaHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/flags/{flagID}"] = flag.NewPutFlag(o.context, o.FlagPutFlagHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/flags/{flagID}/activation_window"] = flag.NewPutFlagActivationWindow(o.context, o.FlagPutFlagActivationWindowHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flags/{flagID}/holdout"] = flag.NewPutFlagHoldout(o.context, o.FlagPutFlagHoldoutHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flags/{flagID}/layer"] = flag.NewPutFlagLayer(o.context, o.FlagPutFlagLayerHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flags/{flagID}/prerequisites"] = flag.NewPutFlagPrerequisites(o.context, o.FlagPutFlagPrerequisitesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/holdouts/{holdoutID}"] = holdout.NewPutHoldout(o.context, o.HoldoutPutHoldoutHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/layers/{layerID}"] = layer.NewPutLayer(o.context, o.LayerPutLayerHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/lists/{listID}"] = list.NewPutList(o.context, o.ListPutListHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/lists/{listID}/values"] = list.NewPutListValues(o.context, o.ListPutListValuesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/flags/{flagID}/segments/{segmentID}"] = segment.NewPutSegment(o.context, o.SegmentPutSegmentHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/flags/{flagID}/segments/{segmentID}/activation_window"] = segment.NewPutSegmentActivationWindow(o.context, o.SegmentPutSegmentActivationWindowHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/flags/{flagID}/segments/reorder"] = segment.NewPutSegmentsReorder(o.context, o.SegmentPutSegmentsReorderHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
+	o.handlers["PUT"]["/flags/{flagID}/targets"] = target.NewPutTargets(o.context, o.TargetPutTargetsHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
 	o.handlers["PUT"]["/flags/{flagID}/variants/{variantID}"] = variant.NewPutVariant(o.context, o.VariantPutVariantHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
@@ -817,6 +1483,10 @@ func (o *FlagrAPI) initHandlerCache() {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
 	o.handlers["PUT"]["/flags/{flagID}/enabled"] = flag.NewSetFlagEnabled(o.context, o.FlagSetFlagEnabledHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/flags/{flagID}/segments/{segmentID}/rollout_schedule/paused"] = segment.NewSetRolloutSchedulePaused(o.context, o.SegmentSetRolloutSchedulePausedHandler)
 }
 
 // Serve creates a http handler to serve the API over HTTP